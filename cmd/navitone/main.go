@@ -0,0 +1,53 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"navitone-cli/internal/controllers"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func main() {
+	var opts controllers.Options
+	flag.StringVar(&opts.ConfigPath, "config", "", "path to config.toml (default: ~/.config/navitone-cli/config.toml)")
+	flag.StringVar(&opts.ServerURL, "server", "", "Navidrome server URL (overrides config and NAVITONE_SERVER)")
+	flag.StringVar(&opts.Username, "username", "", "Navidrome username (overrides config and NAVITONE_USERNAME)")
+	flag.StringVar(&opts.Password, "password", "", "Navidrome password (overrides config)")
+	flag.BoolVar(&opts.NoAudio, "no-audio", false, "browse the library without starting the audio backend")
+	checkConfig := flag.Bool("check-config", false, "check the config file, paths and audio setup, then exit (doesn't contact the server)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: navitone [flags] [command]\n\nWithout a command navitone starts the TUI.\n\n%s\n\nflags:\n", controllers.CommandUsage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *checkConfig {
+		if err := controllers.CheckConfig(opts, os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "navitone: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Subcommands run without the TUI
+	if flag.NArg() > 0 {
+		if err := controllers.RunCommand(opts, flag.Args(), os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "navitone: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	app := controllers.NewAppWithOptions(opts)
+	p := tea.NewProgram(app, tea.WithAltScreen())
+	_, err := p.Run()
+	// Quitting already cleaned up; this covers the program ending any other way
+	app.Cleanup()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "navitone: %v\n", err)
+		os.Exit(1)
+	}
+}
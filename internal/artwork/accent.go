@@ -0,0 +1,116 @@
+package artwork
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg" // Cover art formats served by Navidrome
+	_ "image/png"
+	"math"
+	"net/http"
+)
+
+// accentCoverSize is the cover art size requested for color extraction; a small
+// thumbnail is plenty to find the dominant color
+const accentCoverSize = 64
+
+// DominantColor returns the most common saturated color of a cover (by Navidrome
+// cover art ID) as "#rrggbb", adjusted to work as an accent on dark and light
+// terminals. Colors are remembered per cover, so replaying an album is free.
+func (m *Manager) DominantColor(ctx context.Context, coverArtID string) (string, error) {
+	m.mu.RLock()
+	color, found := m.accents[coverArtID]
+	coverURL := m.buildNavidromeCoverArtURL(coverArtID)
+	m.mu.RUnlock()
+	if found {
+		return color, nil
+	}
+	if coverURL == "" {
+		return "", fmt.Errorf("no cover art")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s&size=%d", coverURL, accentCoverSize), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch cover art: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch cover art: %s", resp.Status)
+	}
+
+	img, _, err := image.Decode(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode cover art: %w", err)
+	}
+	color, err = dominantColor(img)
+	if err != nil {
+		return "", err
+	}
+
+	m.mu.Lock()
+	m.accents[coverArtID] = color
+	m.mu.Unlock()
+	return color, nil
+}
+
+// dominantColor buckets the image's saturated pixels into a coarse palette and
+// returns the average color of the fullest bucket. Near-grey pixels are ignored,
+// since a grey accent would be indistinguishable from the rest of the UI.
+func dominantColor(img image.Image) (string, error) {
+	type bucket struct {
+		r, g, b, n int
+	}
+	buckets := make(map[int]*bucket)
+	var best *bucket
+
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r16, g16, b16, a16 := img.At(x, y).RGBA()
+			if a16 < 0x8000 {
+				continue
+			}
+			r, g, b := int(r16>>8), int(g16>>8), int(b16>>8)
+			hi, lo := max(r, g, b), min(r, g, b)
+			if hi < 40 || hi-lo < 48 {
+				continue // Too dark or too grey
+			}
+
+			key := r>>5<<6 | g>>5<<3 | b>>5
+			bk := buckets[key]
+			if bk == nil {
+				bk = &bucket{}
+				buckets[key] = bk
+			}
+			bk.r += r
+			bk.g += g
+			bk.b += b
+			bk.n++
+			if best == nil || bk.n > best.n {
+				best = bk
+			}
+		}
+	}
+
+	if best == nil {
+		return "", fmt.Errorf("cover art has no distinct color")
+	}
+	r, g, b := adjustLightness(float64(best.r)/float64(best.n), float64(best.g)/float64(best.n), float64(best.b)/float64(best.n))
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b), nil
+}
+
+// adjustLightness scales a color so its brightest channel sits between 55% and
+// 85%: dark enough for light text on it, bright enough to stand out on black
+func adjustLightness(r, g, b float64) (int, int, int) {
+	hi := math.Max(r, math.Max(g, b))
+	target := math.Min(math.Max(hi, 0.55*255), 0.85*255)
+	scale := target / hi
+	clamp := func(v float64) int {
+		return int(math.Min(255, math.Round(v*scale)))
+	}
+	return clamp(r), clamp(g), clamp(b)
+}
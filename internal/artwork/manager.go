@@ -20,6 +20,7 @@ type Manager struct {
 	config           *config.Config
 	mbClient         *MusicBrainzClient
 	navidromeBaseURL string // Store base URL for constructing cover art URLs
+	accents          map[string]string // Dominant colors by cover art ID
 	mu               sync.RWMutex
 }
 
@@ -38,6 +39,7 @@ func NewManager(cfg *config.Config) (*Manager, error) {
 		config:           cfg,
 		mbClient:         NewMusicBrainzClient(),
 		navidromeBaseURL: cfg.Navidrome.ServerURL,
+		accents:          make(map[string]string),
 	}, nil
 }
 
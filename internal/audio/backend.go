@@ -0,0 +1,162 @@
+package audio
+
+import (
+	"fmt"
+	"os/exec"
+	"time"
+
+	legacy "navitone-cli/internal/audio/legacy"
+	"navitone-cli/internal/audio/mpv"
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+	"navitone-cli/pkg/scrobbling"
+)
+
+// Backend names accepted by config.Audio.Backend
+const (
+	BackendAuto = "auto"
+	BackendMPV  = "mpv"
+	BackendOto  = "oto"
+)
+
+// AudioBackend is the playback interface the controller drives; both the MPV
+// manager and the legacy oto manager implement it
+type AudioBackend interface {
+	// Events reports state changes and log messages; the channel is never closed
+	Events() <-chan models.PlaybackEvent
+	// SetTrackHook sets a function called with each track as it starts; it must not block
+	SetTrackHook(hook func(models.Track))
+	// SetStartPaused sets a function deciding whether a track starts paused, so the
+	// controller can ask where to resume it first; it must not block
+	SetStartPaused(hold func(models.Track) bool)
+
+	AddToQueue(track models.Track)
+	AddTracksToQueue(tracks []models.Track)
+	InsertTracksNext(tracks []models.Track)
+	RemoveFromQueue(index int)
+	ClearQueue()
+	ReplaceQueue(tracks []models.Track, play bool) error
+	// PreviousQueue returns (and forgets) the queue from before the last clear,
+	// replace or trim, and the index that was current in it; nil when there is none
+	PreviousQueue() ([]models.Track, int)
+	ClearBeforeCurrent() int
+	ClearAfterCurrent() int
+
+	PlayTrackAtIndex(index int) error
+	PlayCurrent() error
+	Pause()
+	Resume()
+	Stop()
+	TogglePlayPause() error
+	NextTrack() error
+	PreviousTrack() error
+	SeekForward(seconds int) error
+	SeekBackward(seconds int) error
+
+	SetVolume(volume float64)
+	GetVolume() float64
+	SetSpeed(multiplier float64) (float64, error)
+	GetSpeed() float64
+	SetAudioDevice(device string) error
+	ListAudioDevices() ([]models.AudioDevice, error)
+	// SetEqualizer applies one of config.EqualizerPresets; MPV only
+	SetEqualizer(preset string) error
+	// SetEqualizerBands sets the gain in dB of each config.EqualizerFrequencies band; MPV only
+	SetEqualizerBands(bands []float64) error
+	// SetSkipSilence trims silence at the start and end of tracks from the next one on; MPV only
+	SetSkipSilence(enabled bool) error
+
+	GetQueue() []models.Track
+	GetCurrentTrack() *models.Track
+	GetCurrentIndex() int
+	IsPlaying() bool
+	GetPosition() time.Duration
+	GetDuration() time.Duration
+	GetStreamInfo() models.StreamInfo
+
+	ToggleShuffle()
+	ReshuffleQueue() error
+	IsShuffleEnabled() bool
+	SetStopAfterCurrent(enabled bool)
+	IsStopAfterCurrent() bool
+
+	SetLocalTrackResolver(resolve func(trackID string) (string, bool))
+	SetOffline(offline bool)
+
+	CheckStreamingPermissions() error
+	Close() error
+}
+
+var (
+	_ AudioBackend = (*Manager)(nil)
+	_ AudioBackend = (*legacy.Manager)(nil)
+)
+
+// ResolveBackend maps a configured backend name to the one that will be used:
+// "auto" (or empty) picks MPV when the mpv binary is on PATH and oto otherwise
+func ResolveBackend(name string) (string, error) {
+	switch name {
+	case BackendMPV, BackendOto:
+		return name, nil
+	case BackendAuto, "":
+		if _, err := exec.LookPath("mpv"); err == nil {
+			return BackendMPV, nil
+		}
+		return BackendOto, nil
+	default:
+		return "", fmt.Errorf("unknown audio backend %q (expected auto, mpv or oto)", name)
+	}
+}
+
+// MPVVersion returns the version of the installed mpv, or an error when mpv is
+// not on PATH
+func MPVVersion() (string, error) {
+	return mpv.Version()
+}
+
+// MPVDeviceNames lists the audio outputs the installed mpv detects, without
+// starting it as a player
+func MPVDeviceNames() ([]string, error) {
+	return mpv.DeviceNames()
+}
+
+// BackendOptions configures NewBackend
+type BackendOptions struct {
+	Backend    string        // "auto", "mpv" or "oto" (see ResolveBackend)
+	Device     string        // Output device; MPV only, the oto backend uses the system default
+	BufferSize time.Duration // Output buffer; oto only, MPV manages its own
+	Prebuffer  int           // Bytes read ahead before a track starts; oto only
+}
+
+// NewBackend creates the audio backend selected by opts.Backend and returns it along
+// with the resolved backend name, which is oto when "mpv" was asked for but the
+// mpv binary is missing
+func NewBackend(opts BackendOptions, navidromeClient *navidrome.Client, scrobbler *scrobbling.Manager) (AudioBackend, string, error) {
+	resolved, err := ResolveBackend(opts.Backend)
+	if err != nil {
+		return nil, "", err
+	}
+	if resolved == BackendMPV {
+		if _, err := exec.LookPath("mpv"); err != nil {
+			// MPV was asked for but isn't installed: play through oto rather than not at all
+			resolved = BackendOto
+		}
+	}
+
+	if resolved == BackendOto {
+		manager, err := legacy.NewManager(navidromeClient, scrobbler, legacy.PlayerOptions{
+			BufferSize:    opts.BufferSize,
+			PrebufferSize: opts.Prebuffer,
+		})
+		if err != nil {
+			return nil, resolved, err
+		}
+		return manager, resolved, nil
+	}
+
+	manager, err := NewManager(navidromeClient, scrobbler, opts.Device)
+	if err != nil {
+		return nil, resolved, err
+	}
+	return manager, resolved, nil
+}
@@ -1,6 +1,7 @@
 package audio
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io"
 	"strings"
@@ -36,6 +37,26 @@ func NewDecoder(format string) (Decoder, error) {
 	}
 }
 
+// transcodeFormat is requested from the server for sources we cannot decode (AAC/M4A, Opus, ...)
+const transcodeFormat = "mp3"
+
+// StreamFormat returns the format the player should decode for a track suffix and
+// whether the server must transcode to it first
+func StreamFormat(suffix string) (format string, transcode bool) {
+	switch strings.ToLower(suffix) {
+	case "mp3", "mpeg":
+		return "mp3", false
+	case "flac":
+		return "flac", false
+	case "ogg", "oga", "vorbis":
+		return "ogg", false
+	case "wav", "wave":
+		return "wav", false
+	default:
+		return transcodeFormat, true
+	}
+}
+
 // MP3Decoder handles MP3 format
 type MP3Decoder struct {
 	sampleRate int
@@ -276,15 +297,177 @@ type WAVDecoder struct {
 	duration   int64
 }
 
+// WAV format tags for PCM data
+const (
+	wavFormatPCM        = 1
+	wavFormatExtensible = 0xFFFE
+)
+
 func (d *WAVDecoder) Decode(r io.Reader) (io.Reader, error) {
-	// For WAV files, we need to parse the header and skip to the data
-	// For now, return an error since WAV parsing is complex and can cause issues
-	return nil, fmt.Errorf("WAV format not properly supported yet - use MP3/FLAC/OGG instead")
-	
-	// Note: This is disabled to prevent static noise from malformed WAV handling
-	// A full implementation would parse the WAV header properly and validate format
+	// RIFF header: "RIFF" <size> "WAVE"
+	var riff [12]byte
+	if _, err := io.ReadFull(r, riff[:]); err != nil {
+		return nil, fmt.Errorf("reading WAV header: %w", err)
+	}
+	if string(riff[0:4]) != "RIFF" || string(riff[8:12]) != "WAVE" {
+		return nil, fmt.Errorf("not a RIFF/WAVE stream")
+	}
+
+	var bitsPerSample, blockAlign int
+	haveFormat := false
+
+	// Walk chunks until we reach the PCM data
+	for {
+		var header [8]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, fmt.Errorf("reading WAV chunk header: %w", err)
+		}
+		chunkID := string(header[0:4])
+		chunkSize := int64(binary.LittleEndian.Uint32(header[4:8]))
+
+		switch chunkID {
+		case "fmt ":
+			if chunkSize < 16 {
+				return nil, fmt.Errorf("WAV fmt chunk too short: %d bytes", chunkSize)
+			}
+			fmtChunk := make([]byte, chunkSize)
+			if _, err := io.ReadFull(r, fmtChunk); err != nil {
+				return nil, fmt.Errorf("reading WAV fmt chunk: %w", err)
+			}
+
+			audioFormat := binary.LittleEndian.Uint16(fmtChunk[0:2])
+			if audioFormat == wavFormatExtensible && len(fmtChunk) >= 26 {
+				// The sub-format GUID starts with the actual format tag
+				audioFormat = binary.LittleEndian.Uint16(fmtChunk[24:26])
+			}
+			if audioFormat != wavFormatPCM {
+				return nil, fmt.Errorf("unsupported WAV encoding (format tag %d) - only PCM is supported", audioFormat)
+			}
+
+			d.channels = int(binary.LittleEndian.Uint16(fmtChunk[2:4]))
+			d.sampleRate = int(binary.LittleEndian.Uint32(fmtChunk[4:8]))
+			blockAlign = int(binary.LittleEndian.Uint16(fmtChunk[12:14]))
+			bitsPerSample = int(binary.LittleEndian.Uint16(fmtChunk[14:16]))
+
+			if d.channels < 1 || d.channels > 2 {
+				return nil, fmt.Errorf("unsupported WAV channel count: %d", d.channels)
+			}
+			if bitsPerSample != 8 && bitsPerSample != 16 && bitsPerSample != 24 {
+				return nil, fmt.Errorf("unsupported WAV bit depth: %d", bitsPerSample)
+			}
+			if blockAlign != d.channels*bitsPerSample/8 {
+				return nil, fmt.Errorf("invalid WAV block alignment: %d", blockAlign)
+			}
+			haveFormat = true
+
+			// Chunks are padded to an even size
+			if chunkSize%2 == 1 {
+				if _, err := io.CopyN(io.Discard, r, 1); err != nil {
+					return nil, fmt.Errorf("reading WAV fmt chunk: %w", err)
+				}
+			}
+
+		case "data":
+			if !haveFormat {
+				return nil, fmt.Errorf("WAV data chunk before fmt chunk")
+			}
+
+			data := r
+			// Streamed WAVs may leave the size as 0 or 0xFFFFFFFF; read to EOF then
+			if chunkSize > 0 && chunkSize != 0xFFFFFFFF {
+				data = io.LimitReader(r, chunkSize)
+				d.duration = chunkSize / int64(blockAlign)
+			}
+
+			return &WAVReader{
+				reader:        data,
+				channels:      d.channels,
+				bitsPerSample: bitsPerSample,
+				blockAlign:    blockAlign,
+			}, nil
+
+		default:
+			// Skip LIST, fact, and other metadata chunks
+			if _, err := io.CopyN(io.Discard, r, chunkSize+chunkSize%2); err != nil {
+				return nil, fmt.Errorf("skipping WAV %q chunk: %w", chunkID, err)
+			}
+		}
+	}
 }
 
 func (d *WAVDecoder) SampleRate() int { return d.sampleRate }
 func (d *WAVDecoder) Channels() int   { return d.channels }
 func (d *WAVDecoder) Duration() int64 { return d.duration }
+
+// WAVReader converts raw PCM frames to 16-bit stereo like the FLAC/OGG readers
+type WAVReader struct {
+	reader        io.Reader
+	channels      int
+	bitsPerSample int
+	blockAlign    int
+	raw           []byte
+	buffer        []byte
+	bufPos        int
+}
+
+func (w *WAVReader) Read(p []byte) (n int, err error) {
+	for n < len(p) {
+		// If we have buffered data, use it first
+		if w.bufPos < len(w.buffer) {
+			copied := copy(p[n:], w.buffer[w.bufPos:])
+			w.bufPos += copied
+			n += copied
+			continue
+		}
+
+		// Need to read more data - read in chunks of whole frames
+		if w.raw == nil {
+			w.raw = make([]byte, 1024*w.blockAlign)
+		}
+		read, err := io.ReadFull(w.reader, w.raw)
+		frames := read / w.blockAlign
+		if frames == 0 {
+			if err == io.ErrUnexpectedEOF {
+				err = io.EOF // Trailing partial frame
+			}
+			if err == io.EOF && n > 0 {
+				return n, nil
+			}
+			return n, err
+		}
+
+		// Always output stereo (2 channels)
+		w.buffer = make([]byte, 0, frames*2*2)
+		w.bufPos = 0
+
+		bytesPerSample := w.bitsPerSample / 8
+		for i := 0; i < frames; i++ {
+			frame := w.raw[i*w.blockAlign:]
+			left := w.sample(frame)
+			right := left // Mono input - duplicate to stereo
+			if w.channels >= 2 {
+				right = w.sample(frame[bytesPerSample:])
+			}
+
+			// Write left and right channels (little endian)
+			w.buffer = append(w.buffer, byte(left&0xFF), byte(left>>8))
+			w.buffer = append(w.buffer, byte(right&0xFF), byte(right>>8))
+		}
+	}
+
+	return n, nil
+}
+
+// sample converts one little-endian PCM sample to int16
+func (w *WAVReader) sample(b []byte) int16 {
+	switch w.bitsPerSample {
+	case 8:
+		// 8-bit WAV is unsigned with a 128 midpoint
+		return int16(int(b[0])-128) << 8
+	case 24:
+		// Keep the 16 most significant bits
+		return int16(uint16(b[1]) | uint16(b[2])<<8)
+	default:
+		return int16(binary.LittleEndian.Uint16(b))
+	}
+}
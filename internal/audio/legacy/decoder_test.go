@@ -0,0 +1,154 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+)
+
+// wavFrames are the test samples as left, right pairs. They are multiples of 256 so
+// 8-bit input keeps them exactly.
+var wavFrames = [][2]int16{{0, 0}, {256, -256}, {32512, -32768}, {-4096, 12288}}
+
+// buildWAV generates a PCM WAV holding wavFrames (only the left channel when mono),
+// with an odd-sized LIST chunk before the data. dataSize overrides the data chunk's
+// size field when non-zero.
+func buildWAV(channels, bits int, dataSize uint32) []byte {
+	var data bytes.Buffer
+	for _, frame := range wavFrames {
+		for ch := 0; ch < channels; ch++ {
+			v := frame[ch]
+			switch bits {
+			case 8:
+				data.WriteByte(byte(int(v)>>8 + 128))
+			case 16:
+				binary.Write(&data, binary.LittleEndian, v)
+			case 24:
+				data.Write([]byte{0, byte(v), byte(uint16(v) >> 8)})
+			}
+		}
+	}
+	if dataSize == 0 {
+		dataSize = uint32(data.Len())
+	}
+
+	var b bytes.Buffer
+	b.WriteString("RIFF")
+	binary.Write(&b, binary.LittleEndian, uint32(0)) // Ignored by the decoder
+	b.WriteString("WAVE")
+
+	b.WriteString("fmt ")
+	binary.Write(&b, binary.LittleEndian, uint32(16))
+	blockAlign := channels * bits / 8
+	binary.Write(&b, binary.LittleEndian, uint16(wavFormatPCM))
+	binary.Write(&b, binary.LittleEndian, uint16(channels))
+	binary.Write(&b, binary.LittleEndian, uint32(44100))
+	binary.Write(&b, binary.LittleEndian, uint32(44100*blockAlign))
+	binary.Write(&b, binary.LittleEndian, uint16(blockAlign))
+	binary.Write(&b, binary.LittleEndian, uint16(bits))
+
+	b.WriteString("LIST")
+	binary.Write(&b, binary.LittleEndian, uint32(3))
+	b.Write([]byte{'a', 'b', 'c', 0}) // Three bytes plus the pad byte
+
+	b.WriteString("data")
+	binary.Write(&b, binary.LittleEndian, dataSize)
+	b.Write(data.Bytes())
+	return b.Bytes()
+}
+
+func TestWAVDecoder(t *testing.T) {
+	tests := []struct {
+		name     string
+		channels int
+		bits     int
+		dataSize uint32
+	}{
+		{"8-bit mono", 1, 8, 0},
+		{"8-bit stereo", 2, 8, 0},
+		{"16-bit mono", 1, 16, 0},
+		{"16-bit stereo", 2, 16, 0},
+		{"24-bit mono", 1, 24, 0},
+		{"24-bit stereo", 2, 24, 0},
+		{"16-bit stereo, streamed size", 2, 16, 0xFFFFFFFF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoder := &WAVDecoder{}
+			reader, err := decoder.Decode(bytes.NewReader(buildWAV(tt.channels, tt.bits, tt.dataSize)))
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			if decoder.SampleRate() != 44100 || decoder.Channels() != tt.channels {
+				t.Errorf("got %d Hz, %d channels; want 44100 Hz, %d channels", decoder.SampleRate(), decoder.Channels(), tt.channels)
+			}
+			wantDuration := int64(len(wavFrames))
+			if tt.dataSize == 0xFFFFFFFF {
+				wantDuration = 0 // Unknown until the end of the stream
+			}
+			if decoder.Duration() != wantDuration {
+				t.Errorf("got duration %d, want %d", decoder.Duration(), wantDuration)
+			}
+
+			out, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatalf("reading PCM: %v", err)
+			}
+			// Output is always 16-bit stereo: 4 bytes per frame
+			if len(out) != len(wavFrames)*4 {
+				t.Fatalf("got %d bytes, want %d", len(out), len(wavFrames)*4)
+			}
+			for i, frame := range wavFrames {
+				left := int16(binary.LittleEndian.Uint16(out[i*4:]))
+				right := int16(binary.LittleEndian.Uint16(out[i*4+2:]))
+				wantRight := frame[1]
+				if tt.channels == 1 {
+					wantRight = frame[0]
+				}
+				if left != frame[0] || right != wantRight {
+					t.Errorf("frame %d: got %d/%d, want %d/%d", i, left, right, frame[0], wantRight)
+				}
+			}
+		})
+	}
+}
+
+func TestWAVDecoderRejectsNonPCM(t *testing.T) {
+	wav := buildWAV(2, 16, 0)
+	binary.LittleEndian.PutUint16(wav[20:22], 3) // IEEE float
+	if _, err := (&WAVDecoder{}).Decode(bytes.NewReader(wav)); err == nil {
+		t.Fatal("expected an error for a float WAV")
+	}
+}
+
+func TestStreamFormat(t *testing.T) {
+	tests := []struct {
+		suffix    string
+		format    string
+		transcode bool
+	}{
+		{"mp3", "mp3", false},
+		{"MP3", "mp3", false},
+		{"flac", "flac", false},
+		{"ogg", "ogg", false},
+		{"oga", "ogg", false},
+		{"wav", "wav", false},
+		{"wave", "wav", false},
+		{"m4a", transcodeFormat, true},
+		{"aac", transcodeFormat, true},
+		{"opus", transcodeFormat, true},
+		{"alac", transcodeFormat, true},
+		{"", transcodeFormat, true},
+	}
+	for _, tt := range tests {
+		format, transcode := StreamFormat(tt.suffix)
+		if format != tt.format || transcode != tt.transcode {
+			t.Errorf("StreamFormat(%q) = %q, %v; want %q, %v", tt.suffix, format, transcode, tt.format, tt.transcode)
+		}
+		// Whatever comes back must have a decoder
+		if _, err := NewDecoder(format); err != nil {
+			t.Errorf("StreamFormat(%q) picked %q, which has no decoder", tt.suffix, format)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package audio
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"navitone-cli/internal/models"
@@ -26,10 +27,15 @@ type Manager struct {
 	repeatMode   RepeatMode
 	shuffleMode  bool
 	isSeeking    bool  // Flag to prevent auto-advance during seeking
+	stopAfterCurrent bool // Stop instead of advancing when the current track finishes
+	undoQueue    []models.Track // Queue before the last clear or replace (see PreviousQueue)
+	undoIndex    int            // Track that was current in undoQueue, -1 for none
+	failedInRow  int            // Tracks that failed to play since one last produced audio
 
-	// Callbacks
-	stateCallback func(*models.AppState)
-	logCallback   func(string)
+	// Events for the UI (see Events)
+	events    chan models.PlaybackEvent
+	trackHook   func(models.Track)      // Called when a track starts; must not block
+	startPaused func(models.Track) bool // Whether a track starts paused; must not block
 
 	// Synchronization
 	mu sync.RWMutex
@@ -44,9 +50,9 @@ const (
 	RepeatAll
 )
 
-// NewManager creates a new audio manager
-func NewManager(navidromeClient *navidrome.Client, scrobbler *scrobbling.Manager) (*Manager, error) {
-	player, err := NewPlayer()
+// NewManager creates a new audio manager whose player is configured by opts
+func NewManager(navidromeClient *navidrome.Client, scrobbler *scrobbling.Manager, opts PlayerOptions) (*Manager, error) {
+	player, err := NewPlayer(opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create audio player: %w", err)
 	}
@@ -60,6 +66,7 @@ func NewManager(navidromeClient *navidrome.Client, scrobbler *scrobbling.Manager
 		queue:           make([]models.Track, 0),
 		currentIndex:    -1,
 		repeatMode:      RepeatNone,
+		events:          make(chan models.PlaybackEvent, models.PlaybackEventBuffer),
 	}
 
 	// Set up player event callback
@@ -77,27 +84,39 @@ func (m *Manager) CheckStreamingPermissions() error {
 	return nil
 }
 
-// SetStateCallback sets the callback function for state updates
-func (m *Manager) SetStateCallback(callback func(*models.AppState)) {
+// Events returns the channel that reports state changes and log messages
+func (m *Manager) Events() <-chan models.PlaybackEvent {
+	return m.events
+}
+
+// SetTrackHook sets a function called with each track as it starts playing
+func (m *Manager) SetTrackHook(hook func(models.Track)) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.stateCallback = callback
+	m.trackHook = hook
 }
 
-// SetLogCallback sets the callback function for log messages
-func (m *Manager) SetLogCallback(callback func(string)) {
+// SetStartPaused sets a function deciding whether a track starts paused
+func (m *Manager) SetStartPaused(hold func(models.Track) bool) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.logCallback = callback
+	m.startPaused = hold
 }
 
-// logMessage sends a message to the log callback if available
-func (m *Manager) logMessage(message string) {
-	if m.logCallback != nil {
-		go m.logCallback(message) // Call in goroutine to avoid blocking
+// emit queues an event for the UI without blocking; safe with or without the lock held
+func (m *Manager) emit(event models.PlaybackEvent) {
+	select {
+	case m.events <- event:
+	default:
+		// UI is behind; a later state event carries the same information
 	}
 }
 
+// logMessage sends a message to the UI log
+func (m *Manager) logMessage(message string) {
+	m.emit(models.PlaybackEvent{Log: message})
+}
+
 // AddToQueue adds a track to the playback queue
 func (m *Manager) AddToQueue(track models.Track) {
 	m.mu.Lock()
@@ -165,6 +184,7 @@ func (m *Manager) ClearQueue() {
 	defer m.mu.Unlock()
 
 	m.player.Stop()
+	m.saveUndoLocked()
 	m.queue = make([]models.Track, 0)
 	m.currentIndex = -1
 	m.isPlaying = false
@@ -172,6 +192,130 @@ func (m *Manager) ClearQueue() {
 	m.notifyStateChange()
 }
 
+// ReplaceQueue swaps the whole queue for tracks in one step (clear + add) and,
+// when play is set, starts the first of them
+func (m *Manager) ReplaceQueue(tracks []models.Track, play bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.player.Stop()
+	m.saveUndoLocked()
+	m.queue = append([]models.Track(nil), tracks...)
+	m.originalQueue = nil
+	m.currentIndex = -1
+	m.isPlaying = false
+	m.logMessage(fmt.Sprintf("Replaced queue with %d tracks", len(tracks)))
+
+	var err error
+	if play && len(m.queue) > 0 {
+		err = m.playTrackAtIndexLocked(0)
+	}
+	m.notifyStateChange()
+	return err
+}
+
+// InsertTracksNext inserts tracks right after the current track
+func (m *Manager) InsertTracksNext(tracks []models.Track) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var currentID string
+	if m.currentIndex >= 0 && m.currentIndex < len(m.queue) {
+		currentID = m.queue[m.currentIndex].ID
+	}
+
+	at := m.currentIndex + 1
+	if at < 0 || at > len(m.queue) {
+		at = len(m.queue)
+	}
+	m.queue = insertTracks(m.queue, at, tracks)
+
+	// Also insert after the current track in the unshuffled order, so the tracks
+	// still play next once shuffle is turned off
+	if m.shuffleMode && len(m.originalQueue) > 0 {
+		originalIndex := len(m.originalQueue) - 1 // Append if the current track isn't found
+		for i, t := range m.originalQueue {
+			if currentID != "" && t.ID == currentID {
+				originalIndex = i
+				break
+			}
+		}
+		m.originalQueue = insertTracks(m.originalQueue, originalIndex+1, tracks)
+	}
+
+	m.logMessage(fmt.Sprintf("Inserted %d tracks after the current track", len(tracks)))
+	m.notifyStateChange()
+}
+
+// insertTracks returns queue with tracks inserted at index (clamped to the queue bounds)
+func insertTracks(queue []models.Track, index int, tracks []models.Track) []models.Track {
+	if index < 0 {
+		index = 0
+	}
+	if index > len(queue) {
+		index = len(queue)
+	}
+
+	result := make([]models.Track, 0, len(queue)+len(tracks))
+	result = append(result, queue[:index]...)
+	result = append(result, tracks...)
+	return append(result, queue[index:]...)
+}
+
+// ClearBeforeCurrent removes the tracks before the current one and returns how many
+// were removed
+func (m *Manager) ClearBeforeCurrent() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.currentIndex <= 0 {
+		return 0
+	}
+	m.saveUndoLocked()
+	removed := m.currentIndex
+	m.queue = append([]models.Track(nil), m.queue[removed:]...)
+	m.currentIndex = 0
+	m.notifyStateChange()
+	return removed
+}
+
+// ClearAfterCurrent removes the tracks after the current one and returns how many
+// were removed
+func (m *Manager) ClearAfterCurrent() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.currentIndex < 0 || m.currentIndex >= len(m.queue)-1 {
+		return 0
+	}
+	m.saveUndoLocked()
+	removed := len(m.queue) - m.currentIndex - 1
+	m.queue = m.queue[:m.currentIndex+1]
+	m.notifyStateChange()
+	return removed
+}
+
+// saveUndoLocked remembers the queue before a clear or replace so PreviousQueue can
+// bring it back; an empty queue leaves the last snapshot alone (must be called with lock held)
+func (m *Manager) saveUndoLocked() {
+	if len(m.queue) == 0 {
+		return
+	}
+	m.undoQueue = append([]models.Track(nil), m.queue...)
+	m.undoIndex = m.currentIndex
+}
+
+// PreviousQueue hands back the queue from before the last clear or replace, with
+// the index of the track that was current (-1 for none), and forgets it
+func (m *Manager) PreviousQueue() ([]models.Track, int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tracks, index := m.undoQueue, m.undoIndex
+	m.undoQueue = nil
+	return tracks, index
+}
+
 // PlayTrackAtIndex starts playing the track at the specified queue index
 func (m *Manager) PlayTrackAtIndex(index int) error {
 	m.mu.Lock()
@@ -266,7 +410,7 @@ func (m *Manager) NextTrack() error {
 		return fmt.Errorf("queue is empty")
 	}
 
-	nextIndex := m.getNextTrackIndex()
+	nextIndex := m.skipUnavailableLocked(m.getNextTrackIndex())
 	if nextIndex >= 0 {
 		return m.playTrackAtIndexLocked(nextIndex)
 	}
@@ -361,6 +505,11 @@ func (m *Manager) GetCurrentIndex() int {
 	return m.currentIndex
 }
 
+// GetStreamInfo returns the decoder details for the current stream
+func (m *Manager) GetStreamInfo() models.StreamInfo {
+	return m.player.GetStreamInfo()
+}
+
 // IsPlaying returns whether audio is currently playing
 func (m *Manager) IsPlaying() bool {
 	m.mu.RLock()
@@ -374,6 +523,86 @@ func (m *Manager) SetVolume(volume float64) {
 	m.logMessage(fmt.Sprintf("Set volume to %.0f%%", volume*100))
 }
 
+// GetVolume returns the playback volume (0.0 to 1.0)
+func (m *Manager) GetVolume() float64 {
+	return m.player.GetVolume()
+}
+
+// GetPosition returns the playback position of the current track
+func (m *Manager) GetPosition() time.Duration {
+	return m.player.GetPosition()
+}
+
+// GetDuration returns the duration of the current track
+func (m *Manager) GetDuration() time.Duration {
+	return m.player.GetDuration()
+}
+
+// ErrUnsupported is returned for features only the MPV backend provides
+var ErrUnsupported = errors.New("not supported by the oto backend")
+
+// ReshuffleQueue is not supported by the oto backend
+func (m *Manager) ReshuffleQueue() error {
+	return ErrUnsupported
+}
+
+// SetSpeed is not supported by the oto backend; playback always runs at 1x
+func (m *Manager) SetSpeed(multiplier float64) (float64, error) {
+	return 1, ErrUnsupported
+}
+
+// GetSpeed returns the playback speed multiplier (always 1x)
+func (m *Manager) GetSpeed() float64 {
+	return 1
+}
+
+// SetAudioDevice is not supported by the oto backend, which always plays through the
+// system default output
+func (m *Manager) SetAudioDevice(device string) error {
+	return ErrUnsupported
+}
+
+// ListAudioDevices is not supported by the oto backend
+func (m *Manager) ListAudioDevices() ([]models.AudioDevice, error) {
+	return nil, ErrUnsupported
+}
+
+// SetEqualizer is not supported by the oto backend, which has no audio filters
+func (m *Manager) SetEqualizer(preset string) error {
+	return ErrUnsupported
+}
+
+// SetEqualizerBands is not supported by the oto backend
+func (m *Manager) SetEqualizerBands(bands []float64) error {
+	return ErrUnsupported
+}
+
+// SetSkipSilence is not supported by the oto backend, which has no audio filters
+func (m *Manager) SetSkipSilence(enabled bool) error {
+	return ErrUnsupported
+}
+
+// SetLocalTrackResolver is ignored: the oto player only decodes HTTP streams
+func (m *Manager) SetLocalTrackResolver(resolve func(trackID string) (string, bool)) {}
+
+// SetOffline is ignored: the oto backend has no offline playback
+func (m *Manager) SetOffline(offline bool) {}
+
+// SetStopAfterCurrent makes playback stop when the current track finishes
+func (m *Manager) SetStopAfterCurrent(enabled bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopAfterCurrent = enabled
+	m.notifyStateChange()
+}
+
+// IsStopAfterCurrent returns whether playback stops after the current track
+func (m *Manager) IsStopAfterCurrent() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.stopAfterCurrent
+}
+
 // Close closes the audio manager and releases resources
 func (m *Manager) Close() error {
 	m.Stop()
@@ -493,7 +722,7 @@ func (m *Manager) SeekBackward(seconds int) error {
 	return fmt.Errorf("no track currently playing")
 }
 
-// seekToPosition seeks to a specific position using HTTP Range requests
+// seekToPosition seeks to a specific position using a timeOffset stream or HTTP Range requests
 func (m *Manager) seekToPosition(position time.Duration) error {
 	if m.currentIndex < 0 || m.currentIndex >= len(m.queue) {
 		return fmt.Errorf("no track currently playing")
@@ -510,7 +739,7 @@ func (m *Manager) seekToPosition(position time.Duration) error {
 		position = trackDuration
 	}
 	
-	m.logMessage(fmt.Sprintf("Seeking to position %v (%d seconds) using HTTP Range", position, int(position.Seconds())))
+	m.logMessage(fmt.Sprintf("Seeking to position %v (%d seconds)", position, int(position.Seconds())))
 	
 	// Set seeking flag to prevent auto-advance on errors
 	// Keep it set for a few seconds to handle async errors
@@ -524,25 +753,26 @@ func (m *Manager) seekToPosition(position time.Duration) error {
 	}()
 	
 	// Get current stream URL
-	streamURL := m.navidromeClient.GetStreamURL(track.ID)
+	streamURL, format := m.streamURLFor(track)
 	wasPlaying := m.isPlaying
 	
 	// For compressed formats like FLAC, MP3, OGG - seeking with HTTP Range doesn't work well
-	// because decoders need to start from frame boundaries
-	// Instead, we'll adjust the position offset for the UI display
-	if track.Suffix == "flac" || track.Suffix == "mp3" || track.Suffix == "ogg" {
-		m.logMessage(fmt.Sprintf("Compressed format (%s) - adjusting position offset for seeking", track.Suffix))
-		
-		// Calculate the offset we want to apply
-		currentRealPosition := m.player.GetPosition()
-		seekOffset := position - currentRealPosition
-		
-		// Tell the player to adjust its position calculations by this offset
-		m.player.AdjustPositionOffset(seekOffset)
-		
-		m.logMessage(fmt.Sprintf("Applied position offset of %v (target: %v, current: %v)", seekOffset, position, currentRealPosition))
-		m.notifyStateChange()
-		return nil
+	// because decoders need to start from frame boundaries. Instead, re-request the stream
+	// from the server starting at the target time and restart the decoder.
+	if format == "flac" || format == "mp3" || format == "ogg" {
+		m.logMessage(fmt.Sprintf("Compressed format (%s) - restarting stream at %v", format, position))
+
+		m.player.Stop()
+		m.isPlaying = false
+
+		offsetURL := m.navidromeClient.GetStreamURLAt(track.ID, position)
+		if err := m.player.PlayWithFormatAndDuration(offsetURL, track.ID, "mp3", trackDuration); err != nil {
+			return fmt.Errorf("failed to restart playback at %v: %w", position, err)
+		}
+
+		// The new stream starts at the target time, so report positions relative to it
+		m.player.AdjustPositionOffset(position)
+		return m.finishSeek(position, wasPlaying)
 	}
 	
 	// For uncompressed formats (WAV, AIFF), try actual HTTP Range seeking
@@ -555,20 +785,20 @@ func (m *Manager) seekToPosition(position time.Duration) error {
 	if err != nil {
 		m.logMessage(fmt.Sprintf("Range seeking failed, restarting from beginning: %v", err))
 		// Fallback: restart from beginning but keep playing
-		err = m.player.PlayWithFormatAndDuration(streamURL, track.ID, track.Suffix, trackDuration)
+		err = m.player.PlayWithFormatAndDuration(streamURL, track.ID, format, trackDuration)
 		if err != nil {
 			return fmt.Errorf("failed to start playback: %w", err)
 		}
 		position = 0
 	} else {
-		m.logMessage(fmt.Sprintf("Estimated byte position: %d of content for %s format", bytePosition, track.Suffix))
+		m.logMessage(fmt.Sprintf("Estimated byte position: %d of content for %s format", bytePosition, format))
 		
 		// Try range playback for uncompressed formats
-		err = m.player.PlayWithRange(streamURL, track.ID, track.Suffix, trackDuration, bytePosition)
+		err = m.player.PlayWithRange(streamURL, track.ID, format, trackDuration, bytePosition)
 		if err != nil {
 			m.logMessage(fmt.Sprintf("Range playback failed, restarting from beginning: %v", err))
 			// Ultimate fallback: restart from beginning but keep playing
-			err = m.player.PlayWithFormatAndDuration(streamURL, track.ID, track.Suffix, trackDuration)
+			err = m.player.PlayWithFormatAndDuration(streamURL, track.ID, format, trackDuration)
 			if err != nil {
 				return fmt.Errorf("failed to start playback: %w", err)
 			}
@@ -577,6 +807,12 @@ func (m *Manager) seekToPosition(position time.Duration) error {
 	}
 	
 	// Update position tracking to account for the seek offset
+	m.player.AdjustPositionOffset(position)
+	return m.finishSeek(position, wasPlaying)
+}
+
+// finishSeek restores the play/pause state after the stream was restarted at position
+func (m *Manager) finishSeek(position time.Duration, wasPlaying bool) error {
 	m.player.mu.Lock()
 	m.player.position = position
 	m.player.mu.Unlock()
@@ -598,6 +834,16 @@ func (m *Manager) seekToPosition(position time.Duration) error {
 	return nil
 }
 
+// streamURLFor returns the stream URL and decoder format for track, asking the
+// server for an MP3 transcode when we have no decoder for the source format
+func (m *Manager) streamURLFor(track models.Track) (string, string) {
+	format, transcode := StreamFormat(track.Suffix)
+	if transcode {
+		return m.navidromeClient.GetTranscodedStreamURL(track.ID, format), format
+	}
+	return m.navidromeClient.GetStreamURL(track.ID), format
+}
+
 // playTrackAtIndexLocked plays the track at the specified index (must be called with lock held)
 func (m *Manager) playTrackAtIndexLocked(index int) error {
 	if index < 0 || index >= len(m.queue) {
@@ -611,35 +857,51 @@ func (m *Manager) playTrackAtIndexLocked(index int) error {
 	}
 	
 	// Use stream URL with proper parameters for full track access
-	streamURL := m.navidromeClient.GetStreamURL(track.ID)
+	streamURL, format := m.streamURLFor(track)
 
 	// Convert duration from seconds to time.Duration
 	trackDuration := time.Duration(track.Duration) * time.Second
 
 	// Pass the track format hint and duration to the player
-	err := m.player.PlayWithFormatAndDuration(streamURL, track.ID, track.Suffix, trackDuration)
+	err := m.player.PlayWithFormatAndDuration(streamURL, track.ID, format, trackDuration)
 	if err != nil {
-		// Fallback to download URL
+		// Fallback to download URL (original file, so only for formats we can decode)
+		if _, transcode := StreamFormat(track.Suffix); transcode {
+			return fmt.Errorf("failed to play track: %w", err)
+		}
 		downloadURL := m.navidromeClient.GetDownloadURL(track.ID)
-		err = m.player.PlayWithFormatAndDuration(downloadURL, track.ID, track.Suffix, trackDuration)
+		err = m.player.PlayWithFormatAndDuration(downloadURL, track.ID, format, trackDuration)
 		if err != nil {
 			return fmt.Errorf("failed to play track: %w", err)
 		}
 	}
 
+	// The player is still opening the stream, so pausing now keeps it silent
+	hold := m.startPaused != nil && m.startPaused(track)
+	if hold {
+		m.player.Pause()
+	}
+
 	m.currentIndex = index
-	m.isPlaying = true
+	m.isPlaying = !hold
 
 	m.logMessage(fmt.Sprintf("Playing track: %s - %s", track.Artist, track.Title))
 	m.notifyStateChange()
+	if m.trackHook != nil {
+		m.trackHook(track)
+	}
 
 	// Submit "Now Playing" to scrobbling services
 	if m.scrobbler != nil {
 		scrobbleTrack := scrobbling.ScrobbleTrack{
-			Title:    track.Title,
-			Artist:   track.Artist,
-			Album:    track.Album,
-			Duration: track.Duration,
+			Title:         track.Title,
+			Artist:        track.Artist,
+			Album:         track.Album,
+			Duration:      track.Duration,
+			TrackNumber:   track.Track,
+			RecordingMBID: track.RecordingMBID,
+			ReleaseMBID:   track.ReleaseMBID,
+			ArtistMBIDs:   track.ArtistMBIDs,
 		}
 		go m.scrobbler.UpdateNowPlaying(scrobbleTrack)
 	}
@@ -647,6 +909,40 @@ func (m *Manager) playTrackAtIndexLocked(index int) error {
 	return nil
 }
 
+// markUnavailableLocked flags every queued copy of a track that failed to stream,
+// so advancing skips it and the queue shows it (must be called with lock held)
+func (m *Manager) markUnavailableLocked(trackID string) {
+	for i := range m.queue {
+		if m.queue[i].ID == trackID {
+			m.queue[i].Unavailable = true
+		}
+	}
+	for i := range m.originalQueue {
+		if m.originalQueue[i].ID == trackID {
+			m.originalQueue[i].Unavailable = true
+		}
+	}
+}
+
+// skipUnavailableLocked moves a next-track index forward past tracks that failed to
+// stream before, wrapping only with repeat all; -1 when nothing playable is left
+// (must be called with lock held)
+func (m *Manager) skipUnavailableLocked(index int) int {
+	for tries := 0; index >= 0 && m.queue[index].Unavailable; tries++ {
+		if tries == len(m.queue) {
+			return -1
+		}
+		index++
+		if index >= len(m.queue) {
+			if m.repeatMode != RepeatAll {
+				return -1
+			}
+			index = 0
+		}
+	}
+	return index
+}
+
 // getNextTrackIndex returns the index of the next track to play
 func (m *Manager) getNextTrackIndex() int {
 	// Shuffle mode doesn't change navigation logic - queue is already shuffled
@@ -692,34 +988,73 @@ func (m *Manager) handlePlayerEvent(event PlaybackEvent) {
 	case "finished":
 		// Start next track in background
 		go func() {
+			m.mu.Lock()
+			stop := m.stopAfterCurrent
+			m.stopAfterCurrent = false
+			m.mu.Unlock()
+			if stop {
+				m.Stop()
+				return
+			}
 
 			// Play next track
 			m.NextTrack()
 		}()
 
+	case "position_update":
+		// Audio is flowing, so the track started fine
+		m.mu.Lock()
+		m.failedInRow = 0
+		if m.currentIndex >= 0 && m.currentIndex < len(m.queue) && m.queue[m.currentIndex].Unavailable {
+			m.queue[m.currentIndex].Unavailable = false // Played again when picked explicitly, and it works now
+			m.notifyStateChange()
+		}
+		m.mu.Unlock()
+
 	case "error":
 		m.logMessage(fmt.Sprintf("Playback error for track: %s", event.TrackID))
-		// Only advance to next track on error if we're not seeking
-		if !m.isSeeking {
+		// Only count and advance on errors that aren't from a seek restarting the stream
+		if m.isSeeking {
+			m.logMessage("Ignoring error during seeking operation")
+			break
+		}
+		m.mu.Lock()
+		m.markUnavailableLocked(event.TrackID)
+		m.notifyStateChange()
+		m.failedInRow++
+		failed := m.failedInRow
+		if failed >= models.MaxFailedTracks {
+			m.failedInRow = 0
+		}
+		m.mu.Unlock()
+		if failed >= models.MaxFailedTracks {
+			// A run of broken tracks (a missing album, say): stop instead of skipping through all of them
+			m.Stop()
+			m.logMessage(fmt.Sprintf("Skipped %d unplayable tracks - stopping", failed))
+		} else {
 			m.logMessage("Advancing to next track due to playback error")
 			go m.NextTrack()
-		} else {
-			m.logMessage("Ignoring error during seeking operation")
 		}
 	}
 }
 
-// notifyStateChange notifies the UI about state changes (must be called with lock held)
+// notifyStateChange sends the UI a snapshot of the playback state (must be called with lock held)
 func (m *Manager) notifyStateChange() {
-	if m.stateCallback != nil {
-		// Create updated state - this is a simplified version
-		// The actual implementation will depend on the full AppState structure
-		go func() {
-			// Call the callback in a goroutine to avoid blocking
-			// The callback will need to be implemented to update the AppState
-			// m.stateCallback(updatedState)
-		}()
+	state := &models.PlaybackState{
+		Queue:            make([]models.Track, len(m.queue)),
+		IsPlaying:        m.isPlaying,
+		IsShuffle:        m.shuffleMode,
+		StopAfterCurrent: m.stopAfterCurrent,
+		Speed:            1,
+		Position:         m.player.GetPosition(),
+		StreamInfo:       m.player.GetStreamInfo(),
+	}
+	copy(state.Queue, m.queue)
+	if m.currentIndex >= 0 && m.currentIndex < len(m.queue) {
+		track := m.queue[m.currentIndex]
+		state.CurrentTrack = &track
 	}
+	m.emit(models.PlaybackEvent{State: state})
 }
 
 // estimateBytePosition estimates the byte position for a given time offset
@@ -0,0 +1,65 @@
+package audio
+
+import (
+	"strings"
+	"testing"
+
+	"navitone-cli/internal/models"
+)
+
+// tracks returns a track per ID
+func tracks(ids ...string) []models.Track {
+	result := make([]models.Track, len(ids))
+	for i, id := range ids {
+		result[i] = models.Track{ID: id}
+	}
+	return result
+}
+
+// ids joins a queue's track IDs for comparison
+func ids(queue []models.Track) string {
+	parts := make([]string, len(queue))
+	for i, track := range queue {
+		parts[i] = track.ID
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestInsertTracksNext(t *testing.T) {
+	tests := []struct {
+		name         string
+		queue        string
+		original     string // Unshuffled order when shuffle is on
+		current      int
+		wantQueue    string
+		wantOriginal string
+	}{
+		{"shuffle off", "a b c d", "", 1, "a b x y c d", ""},
+		{"shuffle off, last track", "a b c d", "", 3, "a b c d x y", ""},
+		{"shuffle off, nothing played", "a b c d", "", -1, "x y a b c d", ""},
+		{"shuffle on", "c a d b", "a b c d", 1, "c a x y d b", "a x y b c d"},
+		{"shuffle on, last in original order", "c a d b", "a b c d", 2, "c a d x y b", "a b c d x y"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Manager{
+				player:       &Player{},
+				queue:        tracks(strings.Fields(tt.queue)...),
+				currentIndex: tt.current,
+				shuffleMode:  tt.original != "",
+			}
+			if tt.original != "" {
+				m.originalQueue = tracks(strings.Fields(tt.original)...)
+			}
+
+			m.InsertTracksNext(tracks("x", "y"))
+
+			if got := ids(m.queue); got != tt.wantQueue {
+				t.Errorf("queue = %q, want %q", got, tt.wantQueue)
+			}
+			if got := ids(m.originalQueue); got != tt.wantOriginal {
+				t.Errorf("original queue = %q, want %q", got, tt.wantOriginal)
+			}
+		})
+	}
+}
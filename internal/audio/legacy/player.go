@@ -8,6 +8,8 @@ import (
 	"sync"
 	"time"
 
+	"navitone-cli/internal/models"
+
 	"github.com/ebitengine/oto/v3"
 )
 
@@ -54,6 +56,8 @@ type Player struct {
 	position   time.Duration
 	duration   time.Duration
 	byteOffset int64  // HTTP Range byte offset for seeking
+	streamInfo models.StreamInfo // Decoder details for the current stream
+	prebufferSize int // Bytes read ahead before decoding starts (0 disables)
 
 	// Control channels
 	stopCh   chan struct{}
@@ -72,16 +76,30 @@ type Player struct {
 	wg sync.WaitGroup
 }
 
+// defaultBufferSize is the oto output buffer used when none is configured
+const defaultBufferSize = 100 * time.Millisecond
+
+// PlayerOptions configures NewPlayer
+type PlayerOptions struct {
+	BufferSize    time.Duration // Oto output buffer (0 for the default); larger rides out underruns at the cost of latency
+	PrebufferSize int           // Bytes of the stream read ahead before playback starts (0 disables)
+}
+
 // NewPlayer creates a new audio player
-func NewPlayer() (*Player, error) {
+func NewPlayer(opts PlayerOptions) (*Player, error) {
+	bufferSize := opts.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+
 	// Initialize Oto context with reasonable defaults
 	// Use conservative settings to minimize audio issues
 	op := &oto.NewContextOptions{
 		SampleRate:   44100,
 		ChannelCount: 2,
 		Format:       oto.FormatSignedInt16LE,
-		// Add buffer size configuration to prevent underruns
-		BufferSize: time.Millisecond * 100, // 100ms buffer
+		// Buffer size configuration to prevent underruns
+		BufferSize: bufferSize,
 	}
 
 
@@ -100,6 +118,7 @@ func NewPlayer() (*Player, error) {
 		},
 		state:      StateStopped,
 		volume:     0.7, // Default volume 70%
+		prebufferSize: opts.PrebufferSize,
 		stopCh:     make(chan struct{}),
 		pauseCh:    make(chan struct{}),
 		resumeCh:   make(chan struct{}),
@@ -158,10 +177,12 @@ func (p *Player) Pause() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	if p.state == StatePlaying && p.player != nil {
+	if p.state == StatePlaying {
 		p.state = StatePaused
-		// Directly pause the oto player
-		p.player.Pause()
+		// Directly pause the oto player; before the stream is open, the playback loop starts it paused
+		if p.player != nil {
+			p.player.Pause()
+		}
 		p.emitEvent("paused", p.currentID, p.position, p.duration)
 	}
 }
@@ -171,10 +192,12 @@ func (p *Player) Resume() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	if p.state == StatePaused && p.player != nil {
+	if p.state == StatePaused {
 		p.state = StatePlaying
-		// Directly resume the oto player
-		p.player.Play()
+		// Directly resume the oto player; before the stream is open, the playback loop starts it
+		if p.player != nil {
+			p.player.Play()
+		}
 		p.emitEvent("resumed", p.currentID, p.position, p.duration)
 	}
 }
@@ -207,6 +230,13 @@ func (p *Player) SetVolume(volume float64) {
 	// TODO: Apply volume to current player if playing
 }
 
+// GetVolume returns the playback volume (0.0 to 1.0)
+func (p *Player) GetVolume() float64 {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.volume
+}
+
 // GetState returns the current playback state
 func (p *Player) GetState() PlaybackState {
 	p.mu.RLock()
@@ -235,6 +265,24 @@ func (p *Player) GetCurrentTrack() string {
 	return p.currentID
 }
 
+// GetStreamInfo returns the decoder details for the current stream
+func (p *Player) GetStreamInfo() models.StreamInfo {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.streamInfo
+}
+
+// setStreamInfo records the sample rate and channels reported by decoder
+func (p *Player) setStreamInfo(format string, decoder Decoder) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.streamInfo = models.StreamInfo{
+		Codec:      format,
+		SampleRate: decoder.SampleRate(),
+		Channels:   decoder.Channels(),
+	}
+}
+
 // SetEventCallback sets the callback function for playback events
 func (p *Player) SetEventCallback(callback func(PlaybackEvent)) {
 	p.mu.Lock()
@@ -401,16 +449,20 @@ func (p *Player) playbackLoop() {
 	if format != "" {
 		decoder, err := NewDecoder(format)
 		if err != nil {
-			// Fallback to MP3 decoder
-			decoder, err = NewDecoder("mp3")
-			if err != nil {
-				p.emitEvent("error", p.currentID, 0, 0)
-				return
-			}
+			// Unsupported format (e.g. AAC) - never feed it to the MP3 decoder
+			p.emitEvent("error", p.currentID, 0, 0)
+			return
 		}
 
 		
-		decodedReader, err := decoder.Decode(resp.Body)
+		// Fill the readahead before decoding so the start of the track doesn't stutter
+		stream, closeStream, ok := p.prebuffer(resp.Body)
+		if !ok {
+			return // Stopped while prebuffering
+		}
+		defer closeStream()
+
+		decodedReader, err := decoder.Decode(stream)
 		if err != nil {
 			// Reset response body - we need to make a new request
 			resp.Body.Close()
@@ -447,9 +499,10 @@ func (p *Player) playbackLoop() {
 				p.emitEvent("error", p.currentID, 0, 0)
 				return
 			}
-			
+			p.setStreamInfo("mp3", mp3Decoder)
 		} else {
-			}
+			p.setStreamInfo(format, decoder)
+		}
 
 
 		audioReader = decodedReader
@@ -458,14 +511,14 @@ func (p *Player) playbackLoop() {
 		return
 	}
 
-	// Create a new Oto player for this stream
+	// Create a new Oto player for this stream, and start it unless paused meanwhile
 	p.mu.Lock()
 	p.player = p.context.NewPlayer(audioReader)
+	if p.state == StatePlaying {
+		p.player.Play()
+	}
 	p.mu.Unlock()
 
-	// Start playback
-	p.player.Play()
-
 	// Position tracking loop
 	ticker := time.NewTicker(100 * time.Millisecond)
 	defer ticker.Stop()
@@ -508,19 +561,9 @@ func (p *Player) playbackLoop() {
 				p.mu.RUnlock()
 				
 				
-				if !isPlaying {
-					// Only consider track "finished" if:
-					// 1. Player reports not playing AND
-					// 2. We've played for at least 30 seconds (to avoid false positives) AND
-					// 3. Either we've played 90% of the track OR we've exceeded the track duration
-					minPlayTime := 30 * time.Second
-					finishThreshold := time.Duration(float64(trackDuration) * 0.9) // 90% of track
-					
-					hasPlayedMinimum := currentPosition >= minPlayTime
-					hasPlayedMostOfTrack := currentPosition >= finishThreshold
-					hasExceededDuration := trackDuration > 0 && currentPosition >= trackDuration
-					
-					if hasPlayedMinimum && (hasPlayedMostOfTrack || hasExceededDuration) {
+				// A paused oto player also reports not playing; only an idle player in the playing state has ended
+				if !isPlaying && p.GetState() == StatePlaying {
+					if trackFinished(currentPosition, trackDuration) {
 						p.emitEvent("finished", trackID, currentPosition, trackDuration)
 						return
 					}
@@ -533,22 +576,12 @@ func (p *Player) playbackLoop() {
 
 // detectAudioFormat detects the audio format from URL, content-type, or format hint
 func (p *Player) detectAudioFormat(url, contentType string) string {
-	// First priority: Use format hint from track metadata
+	// First priority: Use format hint from track metadata. Suffixes we can't decode
+	// (m4a, aac, opus...) map to the format the manager asked the server to
+	// transcode them to.
 	if p.formatHint != "" {
-		hint := strings.ToLower(p.formatHint)
-		// Normalize common extensions
-		switch hint {
-		case "mp3", "mpeg":
-			return "mp3"
-		case "flac":
-			return "flac"
-		case "ogg", "oga", "vorbis":
-			return "ogg"
-		case "wav", "wave":
-			return "wav"
-		case "m4a", "aac":
-			return "mp3" // Fallback to MP3 decoder for now
-		}
+		format, _ := StreamFormat(p.formatHint)
+		return format
 	}
 
 	// Second priority: Try to detect from URL extension
@@ -580,7 +613,33 @@ func (p *Player) detectAudioFormat(url, contentType string) string {
 	if strings.Contains(contentType, "audio/wav") || strings.Contains(contentType, "audio/wave") {
 		return "wav"
 	}
+	if strings.Contains(contentType, "audio/mp4") || strings.Contains(contentType, "audio/aac") {
+		return "aac" // Untranscoded AAC: NewDecoder rejects it, so it is never fed to the MP3 decoder
+	}
 
 	// Last resort: Default to MP3
 	return "mp3"
 }
+
+// unknownDurationGrace is how long a stream without a known duration must have played
+// before oto reporting it idle counts as the track finishing
+const unknownDurationGrace = 5 * time.Second
+
+// trackFinished decides whether an idle oto player means the track really ended.
+// Only consider the track finished if:
+// 1. We've played for at least min(30s, half the track) (to avoid false positives) AND
+// 2. We've played 90% of the track (which also covers exceeding the duration)
+// Streams with unknown duration finish once oto goes idle after a short grace period.
+func trackFinished(position, duration time.Duration) bool {
+	if duration <= 0 {
+		return position >= unknownDurationGrace
+	}
+
+	minPlayTime := 30 * time.Second
+	if half := duration / 2; half < minPlayTime {
+		minPlayTime = half
+	}
+	finishThreshold := time.Duration(float64(duration) * 0.9) // 90% of track
+
+	return position >= minPlayTime && position >= finishThreshold
+}
@@ -0,0 +1,78 @@
+package audio
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTrackFinished(t *testing.T) {
+	s := time.Second
+	tests := []struct {
+		name     string
+		position time.Duration
+		duration time.Duration
+		want     bool
+	}{
+		// A 20-second track: 90% is 18s, and the minimum play time drops to 10s
+		{"20s track at the end", 20 * s, 20 * s, true},
+		{"20s track at 90%", 18 * s, 20 * s, true},
+		{"20s track idle early", 9 * s, 20 * s, false},
+		{"20s track idle before 90%", 15 * s, 20 * s, false},
+
+		// A 3-minute track: 90% is 162s
+		{"3min track at the end", 180 * s, 180 * s, true},
+		{"3min track at 90%", 162 * s, 180 * s, true},
+		{"3min track past its duration", 185 * s, 180 * s, true},
+		{"3min track idle after a stall", 40 * s, 180 * s, false},
+		{"3min track idle just before 90%", 161 * s, 180 * s, false},
+
+		// Unknown duration: idle counts once the grace period has passed
+		{"unknown duration at start", 2 * s, 0, false},
+		{"unknown duration after grace", unknownDurationGrace, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := trackFinished(tt.position, tt.duration); got != tt.want {
+				t.Errorf("trackFinished(%v, %v) = %v, want %v", tt.position, tt.duration, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectAudioFormatHint(t *testing.T) {
+	tests := map[string]string{
+		"mp3":  "mp3",
+		"FLAC": "flac",
+		"oga":  "ogg",
+		"wav":  "wav",
+		"m4a":  transcodeFormat,
+		"aac":  transcodeFormat,
+		"mp4":  transcodeFormat,
+		"opus": transcodeFormat,
+	}
+	for hint, want := range tests {
+		p := &Player{formatHint: hint}
+		// The URL and content type describe the untranscoded source; the hint wins
+		got := p.detectAudioFormat("http://server/rest/stream?id=1", "audio/mp4")
+		if got != want {
+			t.Errorf("hint %q: got %q, want %q", hint, got, want)
+		}
+		if _, err := NewDecoder(got); err != nil {
+			t.Errorf("hint %q picked %q, which has no decoder", hint, got)
+		}
+	}
+}
+
+// TestPauseWhileOpening checks that pausing before the stream is open holds the
+// state, so the playback loop starts the oto player paused
+func TestPauseWhileOpening(t *testing.T) {
+	p := &Player{state: StatePlaying}
+	p.Pause()
+	if p.GetState() != StatePaused {
+		t.Fatalf("state after Pause = %v, want paused", p.GetState())
+	}
+	p.Resume()
+	if p.GetState() != StatePlaying {
+		t.Errorf("state after Resume = %v, want playing", p.GetState())
+	}
+}
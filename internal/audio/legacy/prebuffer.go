@@ -0,0 +1,122 @@
+package audio
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// prebufferTimeout bounds how long playback waits for the prebuffer to fill on slow
+// servers before it starts with whatever has arrived
+const prebufferTimeout = 3 * time.Second
+
+// prebufferChunk is the size of each read from the HTTP stream
+const prebufferChunk = 32 * 1024
+
+// readahead reads a stream ahead of the decoder in the background, holding at most
+// about size bytes, so short network stalls don't starve playback
+type readahead struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+	buf  []byte // Bytes read from the stream but not yet decoded
+	size int
+	err  error // Read error from the stream, returned once buf drains
+	closed bool
+
+	ready     chan struct{} // Closed once size bytes are buffered or the stream ends
+	readyOnce sync.Once
+}
+
+// newReadahead starts reading src in the background, buffering up to size bytes
+func newReadahead(src io.Reader, size int) *readahead {
+	r := &readahead{
+		size:  size,
+		ready: make(chan struct{}),
+	}
+	r.cond = sync.NewCond(&r.mu)
+	go r.fill(src)
+	return r
+}
+
+// fill copies src into the buffer until the stream ends or Close is called, pausing
+// while the buffer is full
+func (r *readahead) fill(src io.Reader) {
+	chunk := make([]byte, prebufferChunk)
+	for {
+		n, err := src.Read(chunk)
+
+		r.mu.Lock()
+		for len(r.buf) >= r.size && !r.closed {
+			r.cond.Wait()
+		}
+		if r.closed {
+			r.mu.Unlock()
+			return
+		}
+		r.buf = append(r.buf, chunk[:n]...)
+		if err != nil {
+			r.err = err
+		}
+		if len(r.buf) >= r.size || err != nil {
+			r.readyOnce.Do(func() { close(r.ready) })
+		}
+		r.cond.Broadcast()
+		r.mu.Unlock()
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Read returns buffered stream data, blocking until more arrives
+func (r *readahead) Read(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for len(r.buf) == 0 && r.err == nil && !r.closed {
+		r.cond.Wait()
+	}
+	if len(r.buf) == 0 {
+		if r.closed {
+			return 0, io.ErrClosedPipe
+		}
+		return 0, r.err
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.cond.Broadcast()
+	return n, nil
+}
+
+// Close stops the background reader; the underlying stream is closed by its owner
+func (r *readahead) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	r.cond.Broadcast()
+}
+
+// prebuffer wraps body in a readahead of p.prebufferSize bytes and waits for it to fill,
+// up to prebufferTimeout. It returns the reader to decode from, a cleanup function, and
+// false if playback was stopped while waiting.
+func (p *Player) prebuffer(body io.Reader) (io.Reader, func(), bool) {
+	if p.prebufferSize <= 0 {
+		return body, func() {}, true
+	}
+
+	r := newReadahead(body, p.prebufferSize)
+	timeout := time.NewTimer(prebufferTimeout)
+	defer timeout.Stop()
+
+	select {
+	case <-r.ready:
+	case <-timeout.C:
+		// Slow server - start with what has arrived and keep streaming
+	case <-p.stopCh:
+		r.Close()
+		return nil, func() {}, false
+	}
+	return r, r.Close, true
+}
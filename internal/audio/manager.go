@@ -23,12 +23,14 @@ const (
 	RepeatAll  = mpv.RepeatAll
 )
 
-// NewManager creates a new MPV-based audio manager
-func NewManager(navidromeClient *navidrome.Client, scrobbler *scrobbling.Manager) (*Manager, error) {
+// NewManager creates a new MPV-based audio manager playing through device
+// (empty for MPV's default output)
+func NewManager(navidromeClient *navidrome.Client, scrobbler *scrobbling.Manager, device string) (*Manager, error) {
 	mpvManager, err := mpv.NewManager(navidromeClient, scrobbler)
 	if err != nil {
 		return nil, err
 	}
+	mpvManager.SetAudioDevice(device)
 
 	manager := &Manager{
 		mpvManager: mpvManager,
@@ -42,14 +44,19 @@ func NewManager(navidromeClient *navidrome.Client, scrobbler *scrobbling.Manager
 	return manager, nil
 }
 
-// SetStateCallback sets the callback function for state updates
-func (m *Manager) SetStateCallback(callback func(*models.AppState)) {
-	m.mpvManager.SetStateCallback(callback)
+// Events returns the channel that reports state changes and log messages
+func (m *Manager) Events() <-chan models.PlaybackEvent {
+	return m.mpvManager.Events()
 }
 
-// SetLogCallback sets the callback function for log messages
-func (m *Manager) SetLogCallback(callback func(string)) {
-	m.mpvManager.SetLogCallback(callback)
+// SetTrackHook sets a function called with each track as it starts playing
+func (m *Manager) SetTrackHook(hook func(models.Track)) {
+	m.mpvManager.SetTrackHook(hook)
+}
+
+// SetStartPaused sets a function deciding whether a track starts paused
+func (m *Manager) SetStartPaused(hold func(models.Track) bool) {
+	m.mpvManager.SetStartPaused(hold)
 }
 
 // AddToQueue adds a track to the playback queue
@@ -62,6 +69,11 @@ func (m *Manager) AddTracksToQueue(tracks []models.Track) {
 	m.mpvManager.AddTracksToQueue(tracks)
 }
 
+// InsertTracksNext inserts tracks right after the current track
+func (m *Manager) InsertTracksNext(tracks []models.Track) {
+	m.mpvManager.InsertTracksNext(tracks)
+}
+
 // RemoveFromQueue removes a track from the queue at the specified index
 func (m *Manager) RemoveFromQueue(index int) {
 	m.mpvManager.RemoveFromQueue(index)
@@ -72,6 +84,26 @@ func (m *Manager) ClearQueue() {
 	m.mpvManager.ClearQueue()
 }
 
+// ReplaceQueue swaps the whole queue for tracks and optionally starts the first
+func (m *Manager) ReplaceQueue(tracks []models.Track, play bool) error {
+	return m.mpvManager.ReplaceQueue(tracks, play)
+}
+
+// PreviousQueue hands back the queue from before the last clear or replace
+func (m *Manager) PreviousQueue() ([]models.Track, int) {
+	return m.mpvManager.PreviousQueue()
+}
+
+// ClearBeforeCurrent removes already-played tracks before the current one
+func (m *Manager) ClearBeforeCurrent() int {
+	return m.mpvManager.ClearBeforeCurrent()
+}
+
+// ClearAfterCurrent removes upcoming tracks after the current one
+func (m *Manager) ClearAfterCurrent() int {
+	return m.mpvManager.ClearAfterCurrent()
+}
+
 // PlayTrackAtIndex starts playing the track at the specified queue index
 func (m *Manager) PlayTrackAtIndex(index int) error {
 	return m.mpvManager.PlayTrackAtIndex(index)
@@ -162,6 +194,11 @@ func (m *Manager) GetDuration() time.Duration {
 	return m.mpvManager.GetDuration()
 }
 
+// GetStreamInfo returns details about the stream being decoded
+func (m *Manager) GetStreamInfo() models.StreamInfo {
+	return m.mpvManager.GetStreamInfo()
+}
+
 // Close closes the audio manager and releases resources
 func (m *Manager) Close() error {
 	return m.mpvManager.Shutdown()
@@ -183,6 +220,69 @@ func (m *Manager) ToggleShuffle() {
     }
 }
 
+// ReshuffleQueue re-randomizes the queue while shuffle is on
+func (m *Manager) ReshuffleQueue() error {
+	return m.mpvManager.ReshuffleQueue()
+}
+
+// SpeedStep is the increment used by the speed up/down keys
+const SpeedStep = mpv.SpeedStep
+
+// SetSpeed sets the playback speed multiplier and returns the applied (clamped) value
+func (m *Manager) SetSpeed(multiplier float64) (float64, error) {
+	return m.mpvManager.SetSpeed(multiplier)
+}
+
+// GetSpeed returns the playback speed multiplier
+func (m *Manager) GetSpeed() float64 {
+	return m.mpvManager.GetSpeed()
+}
+
+// SetAudioDevice switches the audio output device
+func (m *Manager) SetAudioDevice(device string) error {
+	return m.mpvManager.SetAudioDevice(device)
+}
+
+// SetEqualizer applies an equalizer preset (see config.EqualizerPresets)
+func (m *Manager) SetEqualizer(preset string) error {
+	return m.mpvManager.SetEqualizer(preset)
+}
+
+// SetEqualizerBands sets the equalizer gain of each band in dB
+func (m *Manager) SetEqualizerBands(bands []float64) error {
+	return m.mpvManager.SetEqualizerBands(bands)
+}
+
+// SetSkipSilence turns trimming of silence at track edges on or off
+func (m *Manager) SetSkipSilence(enabled bool) error {
+	return m.mpvManager.SetSkipSilence(enabled)
+}
+
+// ListAudioDevices returns the audio output devices MPV can use
+func (m *Manager) ListAudioDevices() ([]models.AudioDevice, error) {
+	return m.mpvManager.ListAudioDevices()
+}
+
+// SetLocalTrackResolver sets the lookup for downloaded copies of tracks
+func (m *Manager) SetLocalTrackResolver(resolve func(trackID string) (string, bool)) {
+	m.mpvManager.SetLocalTrackResolver(resolve)
+}
+
+// SetOffline switches playback to downloaded copies while the server is unreachable
+func (m *Manager) SetOffline(offline bool) {
+	m.mpvManager.SetOffline(offline)
+}
+
+// SetStopAfterCurrent makes playback stop when the current track finishes
+func (m *Manager) SetStopAfterCurrent(enabled bool) {
+	m.mpvManager.SetStopAfterCurrent(enabled)
+}
+
+// IsStopAfterCurrent returns whether playback stops after the current track
+func (m *Manager) IsStopAfterCurrent() bool {
+	return m.mpvManager.IsStopAfterCurrent()
+}
+
 // IsShuffleEnabled returns whether shuffle mode is enabled (if implemented in MPV manager)
 func (m *Manager) IsShuffleEnabled() bool {
     if m.mpvManager != nil {
@@ -2,6 +2,8 @@ package mpv
 
 import (
 	"fmt"
+	"navitone-cli/internal/config"
+	"navitone-cli/internal/models"
 	"time"
 )
 
@@ -72,13 +74,13 @@ func (c *CommandWrapper) SeekPercent(percent float64) error {
 
 // Volume Commands
 
-// SetVolume sets the playback volume (0-100)
+// SetVolume sets the playback volume (0-100, or up to config.MaxBoostVolume)
 func (c *CommandWrapper) SetVolume(volume float64) error {
 	if volume < 0 {
 		volume = 0
 	}
-	if volume > 100 {
-		volume = 100
+	if volume > config.MaxBoostVolume {
+		volume = config.MaxBoostVolume
 	}
 	return c.SetProperty("volume", volume)
 }
@@ -96,6 +98,11 @@ func (c *CommandWrapper) GetVolume() (float64, error) {
 	return 0, fmt.Errorf("invalid volume type")
 }
 
+// SetSpeed sets the playback speed multiplier
+func (c *CommandWrapper) SetSpeed(speed float64) error {
+	return c.SetProperty("speed", speed)
+}
+
 // Property Commands
 
 // SetProperty sets an MPV property
@@ -208,6 +215,45 @@ func (c *CommandWrapper) GetAudioDevice() (string, error) {
 	return "", fmt.Errorf("invalid audio device type")
 }
 
+// GetAudioDeviceList returns the audio outputs MPV can use (audio-device-list)
+func (c *CommandWrapper) GetAudioDeviceList() ([]models.AudioDevice, error) {
+	result, err := c.GetProperty("audio-device-list")
+	if err != nil {
+		return nil, err
+	}
+
+	entries, ok := result.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid audio device list type")
+	}
+
+	devices := make([]models.AudioDevice, 0, len(entries))
+	for _, entry := range entries {
+		fields, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := fields["name"].(string)
+		description, _ := fields["description"].(string)
+		if name != "" {
+			devices = append(devices, models.AudioDevice{Name: name, Description: description})
+		}
+	}
+	return devices, nil
+}
+
+// AddAudioFilter appends a labelled audio filter (see the af command)
+func (c *CommandWrapper) AddAudioFilter(label, filter string) error {
+	_, err := c.ipc.SendCommand("af", "add", "@"+label+":"+filter)
+	return err
+}
+
+// RemoveAudioFilter removes the audio filter with the given label
+func (c *CommandWrapper) RemoveAudioFilter(label string) error {
+	_, err := c.ipc.SendCommand("af", "remove", "@"+label)
+	return err
+}
+
 // SetReplayGain sets replay gain mode
 func (c *CommandWrapper) SetReplayGain(mode string) error {
 	// Valid modes: "no", "track", "album"
@@ -35,6 +35,7 @@ const (
 	EventTrackError     EventType = "track-error"
 	EventPositionUpdate EventType = "position-update"
 	EventStateChange    EventType = "state-change"
+	EventAudioInfo      EventType = "audio-info"
 )
 
 // EndFileReason represents the reason a file ended
@@ -183,6 +184,12 @@ func (p *EventProcessor) handlePropertyChange(event MPVEvent) {
 				"paused": paused,
 			})
 		}
+	case "audio-bitrate", "audio-codec-name", "audio-params/samplerate", "audio-params/channel-count":
+		if event.Data != nil {
+			p.emitEvent(EventAudioInfo, map[string]interface{}{
+				event.Name: event.Data,
+			})
+		}
 	}
 }
 
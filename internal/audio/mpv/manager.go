@@ -2,10 +2,13 @@ package mpv
 
 import (
     "fmt"
+    "math"
     "math/rand"
+    "navitone-cli/internal/config"
     "navitone-cli/internal/models"
     "navitone-cli/pkg/navidrome"
     "navitone-cli/pkg/scrobbling"
+    "strings"
     "sync"
     "time"
 )
@@ -27,13 +30,25 @@ type Manager struct {
 	isPaused         bool
 	repeatMode       RepeatMode
     shuffleMode      bool
+	stopAfterCurrent bool // Stop instead of advancing when the current track ends
 	position         time.Duration
 	duration         time.Duration
 	volume           float64
-
-	// Callbacks
-	stateCallback    func(*models.AppState)
-	logCallback      func(string)
+	speed            float64 // Playback speed multiplier (1.0 = normal)
+	audioDevice      string  // Output device passed to MPV; empty for MPV's default
+	equalizer        []float64 // Equalizer gains in dB per config.EqualizerFrequencies band; nil when flat
+	skipSilence      bool    // Trim silence with the silenceremove filter, restarted for each track
+	offline          bool    // Server unreachable: prefer downloaded copies over streams
+	localTrack       func(trackID string) (string, bool) // Looks up a downloaded copy of a track
+	streamInfo       models.StreamInfo
+	undoQueue        []models.Track // Queue before the last clear or replace (see PreviousQueue)
+	undoIndex        int            // Track that was current in undoQueue, -1 for none
+	failedInRow      int            // Tracks that failed to play since the last one that started
+
+	// Events for the UI (see Events)
+	events           chan models.PlaybackEvent
+	trackHook        func(models.Track) // Called when a track starts; must not block
+	startPaused      func(models.Track) bool // Whether a track starts paused; must not block
 
 	// Synchronization
 	mu               sync.RWMutex
@@ -41,6 +56,28 @@ type Manager struct {
 	stopEventLoop    chan struct{}
 }
 
+// restartThreshold is how far into a track PreviousTrack restarts it rather than
+// going back to the previous track
+const restartThreshold = 3 * time.Second
+
+// Playback speed limits and step for SetSpeed
+const (
+	MinSpeed  = 0.5
+	MaxSpeed  = 3.0
+	SpeedStep = 0.1
+)
+
+// ClampSpeed limits a speed multiplier to MinSpeed-MaxSpeed, rounded to SpeedStep
+func ClampSpeed(multiplier float64) float64 {
+	if multiplier < MinSpeed {
+		multiplier = MinSpeed
+	}
+	if multiplier > MaxSpeed {
+		multiplier = MaxSpeed
+	}
+	return math.Round(multiplier/SpeedStep) * SpeedStep
+}
+
 // RepeatMode represents different repeat modes
 type RepeatMode int
 
@@ -63,7 +100,9 @@ func NewManager(navidromeClient *navidrome.Client, scrobbler *scrobbling.Manager
 		currentIndex:    -1,
 		repeatMode:      RepeatNone,
 		volume:          1.0, // Default 100% volume
+		speed:           1.0,
 		stopEventLoop:   make(chan struct{}),
+		events:          make(chan models.PlaybackEvent, models.PlaybackEventBuffer),
 	}
 
 	return manager, nil
@@ -75,7 +114,11 @@ func (m *Manager) Start() error {
 	defer m.mu.Unlock()
 
 	// Start MPV process
-	if err := m.process.Start(nil); err != nil {
+	var args []string
+	if m.audioDevice != "" {
+		args = append(args, "--audio-device="+m.audioDevice)
+	}
+	if err := m.process.Start(args); err != nil {
 		return fmt.Errorf("failed to start MPV process: %w", err)
 	}
 
@@ -98,6 +141,12 @@ func (m *Manager) Start() error {
 		m.logMessage(fmt.Sprintf("Failed to set initial volume: %v", err))
 	}
 
+	if m.equalizer != nil {
+		if err := m.applyEqualizerLocked(m.equalizer); err != nil {
+			m.logMessage(fmt.Sprintf("Failed to set equalizer: %v", err))
+		}
+	}
+
 	// Set up property observations for real-time updates
 	if err := m.commands.ObserveProperty(1, "playback-time"); err != nil {
 		m.logMessage(fmt.Sprintf("Failed to observe playback-time: %v", err))
@@ -108,6 +157,12 @@ func (m *Manager) Start() error {
 	if err := m.commands.ObserveProperty(3, "pause"); err != nil {
 		m.logMessage(fmt.Sprintf("Failed to observe pause: %v", err))
 	}
+	// Stream details for the player's now-streaming line
+	for i, property := range []string{"audio-bitrate", "audio-codec-name", "audio-params/samplerate", "audio-params/channel-count"} {
+		if err := m.commands.ObserveProperty(4+i, property); err != nil {
+			m.logMessage(fmt.Sprintf("Failed to observe %s: %v", property, err))
+		}
+	}
 
 	// Start event processing loop
 	m.eventWg.Add(1)
@@ -140,18 +195,32 @@ func (m *Manager) Shutdown() error {
 	return nil
 }
 
-// SetStateCallback sets the callback function for state updates
-func (m *Manager) SetStateCallback(callback func(*models.AppState)) {
+// Events returns the channel that reports state changes and log messages
+func (m *Manager) Events() <-chan models.PlaybackEvent {
+	return m.events
+}
+
+// SetTrackHook sets a function called with each track as it starts playing
+func (m *Manager) SetTrackHook(hook func(models.Track)) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.stateCallback = callback
+	m.trackHook = hook
 }
 
-// SetLogCallback sets the callback function for log messages
-func (m *Manager) SetLogCallback(callback func(string)) {
+// SetStartPaused sets a function deciding whether a track starts paused
+func (m *Manager) SetStartPaused(hold func(models.Track) bool) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.logCallback = callback
+	m.startPaused = hold
+}
+
+// emit queues an event for the UI without blocking; safe with or without the lock held
+func (m *Manager) emit(event models.PlaybackEvent) {
+	select {
+	case m.events <- event:
+	default:
+		// UI is behind; a later state event carries the same information
+	}
 }
 
 // AddToQueue adds a track to the playback queue
@@ -184,6 +253,50 @@ func (m *Manager) AddTracksToQueue(tracks []models.Track) {
 	m.notifyStateChange()
 }
 
+// InsertTracksNext inserts tracks right after the current track so they play next,
+// in the given order. In shuffle mode they are also placed after the current track
+// in the original order, so disabling shuffle keeps them next.
+func (m *Manager) InsertTracksNext(tracks []models.Track) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var currentID string
+	if m.currentIndex >= 0 && m.currentIndex < len(m.queue) {
+		currentID = m.queue[m.currentIndex].ID
+	}
+
+	m.queue = insertTracks(m.queue, m.currentIndex+1, tracks)
+
+	if m.shuffleMode && len(m.originalQueue) > 0 {
+		originalIndex := len(m.originalQueue) - 1 // Append if the current track isn't found
+		for i, t := range m.originalQueue {
+			if currentID != "" && t.ID == currentID {
+				originalIndex = i
+				break
+			}
+		}
+		m.originalQueue = insertTracks(m.originalQueue, originalIndex+1, tracks)
+	}
+
+	m.logMessage(fmt.Sprintf("Inserted %d tracks to play next", len(tracks)))
+	m.notifyStateChange()
+}
+
+// insertTracks returns queue with tracks inserted at index (clamped to the queue bounds)
+func insertTracks(queue []models.Track, index int, tracks []models.Track) []models.Track {
+	if index < 0 {
+		index = 0
+	}
+	if index > len(queue) {
+		index = len(queue)
+	}
+
+	result := make([]models.Track, 0, len(queue)+len(tracks))
+	result = append(result, queue[:index]...)
+	result = append(result, tracks...)
+	return append(result, queue[index:]...)
+}
+
 // RemoveFromQueue removes a track from the queue at the specified index
 func (m *Manager) RemoveFromQueue(index int) {
 	m.mu.Lock()
@@ -217,6 +330,7 @@ func (m *Manager) ClearQueue() {
 	if m.commands != nil {
 		m.commands.Stop()
 	}
+	m.saveUndoLocked()
 	m.queue = make([]models.Track, 0)
 	m.originalQueue = make([]models.Track, 0)
 	m.currentIndex = -1
@@ -226,6 +340,117 @@ func (m *Manager) ClearQueue() {
 	m.notifyStateChange()
 }
 
+// ReplaceQueue swaps the whole queue for tracks in one step (clear + add) and,
+// when play is set, starts the first of them
+func (m *Manager) ReplaceQueue(tracks []models.Track, play bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.commands != nil {
+		m.commands.Stop()
+	}
+	m.saveUndoLocked()
+	m.queue = append([]models.Track(nil), tracks...)
+	m.originalQueue = make([]models.Track, 0)
+	m.currentIndex = -1
+	m.isPlaying = false
+	m.isPaused = false
+	m.logMessage(fmt.Sprintf("Replaced queue with %d tracks", len(tracks)))
+
+	var err error
+	if play && len(m.queue) > 0 {
+		err = m.playTrackAtIndexLocked(0)
+	}
+	m.notifyStateChange()
+	return err
+}
+
+// ClearBeforeCurrent removes already-played tracks before the current one and
+// returns how many were removed. The current track keeps playing at index 0.
+func (m *Manager) ClearBeforeCurrent() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.currentIndex <= 0 || m.currentIndex >= len(m.queue) {
+		return 0
+	}
+
+	m.saveUndoLocked()
+	removed := m.queue[:m.currentIndex]
+	m.removeFromOriginalQueue(removed)
+	m.queue = append([]models.Track(nil), m.queue[m.currentIndex:]...)
+	m.currentIndex = 0
+
+	m.notifyStateChange()
+	return len(removed)
+}
+
+// ClearAfterCurrent removes upcoming tracks after the current one and returns how
+// many were removed
+func (m *Manager) ClearAfterCurrent() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.currentIndex < 0 || m.currentIndex >= len(m.queue)-1 {
+		return 0
+	}
+
+	m.saveUndoLocked()
+	removed := m.queue[m.currentIndex+1:]
+	m.removeFromOriginalQueue(removed)
+	count := len(removed)
+	m.queue = m.queue[:m.currentIndex+1]
+
+	m.notifyStateChange()
+	return count
+}
+
+// saveUndoLocked remembers the queue before a clear or replace so PreviousQueue can
+// bring it back. An empty queue leaves the last snapshot alone, so clearing twice
+// doesn't lose it (must be called with lock held).
+func (m *Manager) saveUndoLocked() {
+	if len(m.queue) == 0 {
+		return
+	}
+	m.undoQueue = append([]models.Track(nil), m.queue...)
+	m.undoIndex = m.currentIndex
+}
+
+// PreviousQueue hands back the queue from before the last clear or replace, with
+// the index of the track that was current (-1 for none), and forgets it; nil when
+// there is nothing to undo
+func (m *Manager) PreviousQueue() ([]models.Track, int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tracks, index := m.undoQueue, m.undoIndex
+	m.undoQueue = nil
+	return tracks, index
+}
+
+// removeFromOriginalQueue drops one occurrence of each removed track from the
+// pre-shuffle order so disabling shuffle doesn't bring them back (must be called with lock held)
+func (m *Manager) removeFromOriginalQueue(removed []models.Track) {
+	if !m.shuffleMode || len(m.originalQueue) == 0 {
+		return
+	}
+
+	pending := make(map[string]int, len(removed))
+	for _, t := range removed {
+		pending[t.ID]++
+	}
+
+	kept := make([]models.Track, 0, len(m.originalQueue))
+	for _, t := range m.originalQueue {
+		if pending[t.ID] > 0 {
+			pending[t.ID]--
+			continue
+		}
+		kept = append(kept, t)
+	}
+	m.originalQueue = kept
+}
+
 // PlayTrackAtIndex starts playing the track at the specified queue index
 func (m *Manager) PlayTrackAtIndex(index int) error {
 	m.mu.Lock()
@@ -336,7 +561,7 @@ func (m *Manager) NextTrack() error {
 		return fmt.Errorf("queue is empty")
 	}
 
-	nextIndex := m.getNextTrackIndex()
+	nextIndex := m.skipUnavailableLocked(m.getNextTrackIndex())
 	if nextIndex >= 0 {
 		return m.playTrackAtIndexLocked(nextIndex)
 	}
@@ -361,6 +586,13 @@ func (m *Manager) PreviousTrack() error {
 		return fmt.Errorf("queue is empty")
 	}
 
+	// Like most players, a press further into the track restarts it instead
+	if m.position > restartThreshold && m.currentIndex >= 0 && m.currentIndex < len(m.queue) && m.commands != nil {
+		m.logMessage("Restarting current track")
+		m.position = 0
+		return m.commands.SeekAbsolute(0)
+	}
+
 	prevIndex := m.getPreviousTrackIndex()
 	if prevIndex >= 0 {
 		return m.playTrackAtIndexLocked(prevIndex)
@@ -395,7 +627,8 @@ func (m *Manager) SeekBackward(seconds int) error {
 	return fmt.Errorf("no track currently playing")
 }
 
-// SetVolume sets the playback volume
+// SetVolume sets the playback volume (0.0 to 1.0, or up to config.MaxBoostVolume/100
+// when the caller allows boosting)
 func (m *Manager) SetVolume(volume float64) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -403,8 +636,8 @@ func (m *Manager) SetVolume(volume float64) {
 	if volume < 0 {
 		volume = 0
 	}
-	if volume > 1 {
-		volume = 1
+	if limit := float64(config.MaxBoostVolume) / 100; volume > limit {
+		volume = limit
 	}
 
 	m.volume = volume
@@ -476,6 +709,13 @@ func (m *Manager) GetDuration() time.Duration {
 	return m.duration
 }
 
+// GetStreamInfo returns details about the stream MPV is decoding
+func (m *Manager) GetStreamInfo() models.StreamInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.streamInfo
+}
+
 // Close closes the audio manager and releases resources
 func (m *Manager) Close() error {
 	m.Stop()
@@ -484,11 +724,9 @@ func (m *Manager) Close() error {
 
 // Private methods
 
-// logMessage sends a message to the log callback if available
+// logMessage sends a message to the UI log
 func (m *Manager) logMessage(message string) {
-	if m.logCallback != nil {
-		m.logCallback(message)
-	}
+	m.emit(models.PlaybackEvent{Log: message})
 }
 
 // playTrackAtIndexLocked plays the track at the specified index (must be called with lock held)
@@ -499,17 +737,37 @@ func (m *Manager) playTrackAtIndexLocked(index int) error {
 
 	track := m.queue[index]
 
-	// Get stream URL from Navidrome
+	// Get stream URL from Navidrome, or the downloaded copy while offline
 	streamURL := m.navidromeClient.GetStreamURL(track.ID)
+	local := false
+	if m.offline && m.localTrack != nil {
+		if path, ok := m.localTrack(track.ID); ok {
+			streamURL = path
+			local = true
+		}
+	}
 
 	// Update event processor with current track
 	if m.eventProcessor != nil {
 		m.eventProcessor.SetCurrentTrackID(track.ID)
 	}
 
-	// Load file in MPV
+	// Load file in MPV. Pause carries over from file to file, so it is set for each one.
+	hold := m.startPaused != nil && m.startPaused(track)
 	if m.commands != nil {
+		if err := m.commands.SetProperty("pause", hold); err != nil {
+			m.logMessage(fmt.Sprintf("Failed to set pause: %v", err))
+		}
+		if m.skipSilence {
+			// A fresh filter per track, so each one's leading silence is trimmed too
+			if err := m.applySkipSilenceLocked(true); err != nil {
+				m.logMessage(fmt.Sprintf("Failed to set skip silence: %v", err))
+			}
+		}
 		if err := m.commands.LoadFile(streamURL, "replace"); err != nil {
+			if local {
+				return fmt.Errorf("failed to load cached track: %w", err)
+			}
 			// Fallback to download URL
 			downloadURL := m.navidromeClient.GetDownloadURL(track.ID)
 			if err := m.commands.LoadFile(downloadURL, "replace"); err != nil {
@@ -520,8 +778,9 @@ func (m *Manager) playTrackAtIndexLocked(index int) error {
 
 	m.currentIndex = index
 	m.isPlaying = true
-	m.isPaused = false
+	m.isPaused = hold
 	m.duration = time.Duration(track.Duration) * time.Second
+	m.streamInfo = models.StreamInfo{}
 
 	m.logMessage(fmt.Sprintf("Playing track: %s - %s", track.Artist, track.Title))
 	m.notifyStateChange()
@@ -529,10 +788,14 @@ func (m *Manager) playTrackAtIndexLocked(index int) error {
     // Submit "Now Playing" (routes to server/client based on method)
     if m.scrobbler != nil {
         scrobbleTrack := scrobbling.ScrobbleTrack{
-            Title:    track.Title,
-            Artist:   track.Artist,
-            Album:    track.Album,
-            Duration: track.Duration,
+            Title:         track.Title,
+            Artist:        track.Artist,
+            Album:         track.Album,
+            Duration:      track.Duration,
+            TrackNumber:   track.Track,
+            RecordingMBID: track.RecordingMBID,
+            ReleaseMBID:   track.ReleaseMBID,
+            ArtistMBIDs:   track.ArtistMBIDs,
         }
         go m.scrobbler.NowPlaying(track.ID, scrobbleTrack)
     }
@@ -540,6 +803,40 @@ func (m *Manager) playTrackAtIndexLocked(index int) error {
 	return nil
 }
 
+// markUnavailableLocked flags every queued copy of a track that failed to stream,
+// so advancing skips it and the queue shows it (must be called with lock held)
+func (m *Manager) markUnavailableLocked(trackID string) {
+	for i := range m.queue {
+		if m.queue[i].ID == trackID {
+			m.queue[i].Unavailable = true
+		}
+	}
+	for i := range m.originalQueue {
+		if m.originalQueue[i].ID == trackID {
+			m.originalQueue[i].Unavailable = true
+		}
+	}
+}
+
+// skipUnavailableLocked moves a next-track index forward past tracks that failed to
+// stream before, wrapping only with repeat all; -1 when nothing playable is left
+// (must be called with lock held)
+func (m *Manager) skipUnavailableLocked(index int) int {
+	for tries := 0; index >= 0 && m.queue[index].Unavailable; tries++ {
+		if tries == len(m.queue) {
+			return -1
+		}
+		index++
+		if index >= len(m.queue) {
+			if m.repeatMode != RepeatAll {
+				return -1
+			}
+			index = 0
+		}
+	}
+	return index
+}
+
 // getNextTrackIndex returns the index of the next track to play
 func (m *Manager) getNextTrackIndex() int {
 	switch m.repeatMode {
@@ -651,6 +948,206 @@ func (m *Manager) ToggleShuffle() {
     m.notifyStateChange()
 }
 
+// ReshuffleQueue re-randomizes the queue while shuffle is on. The current track keeps
+// playing and moves to index 0; originalQueue is untouched so disabling shuffle still
+// restores the true original order.
+func (m *Manager) ReshuffleQueue() error {
+    m.mu.Lock()
+    defer m.mu.Unlock()
+
+    if !m.shuffleMode {
+        return fmt.Errorf("shuffle is not enabled")
+    }
+
+    if m.currentIndex >= 0 && m.currentIndex < len(m.queue) {
+        // Move the current track to the front and shuffle everything after it
+        m.queue[0], m.queue[m.currentIndex] = m.queue[m.currentIndex], m.queue[0]
+        m.currentIndex = 0
+        m.shuffleSlice(m.queue[1:])
+    } else {
+        m.shuffleSlice(m.queue)
+    }
+
+    m.logMessage(fmt.Sprintf("Queue reshuffled (%d tracks)", len(m.queue)))
+    m.notifyStateChange()
+    return nil
+}
+
+// SetSpeed sets the playback speed (clamped by ClampSpeed) and returns the applied value.
+// MPV keeps the speed across tracks, so it lasts for the session.
+func (m *Manager) SetSpeed(multiplier float64) (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	speed := ClampSpeed(multiplier)
+	if m.commands != nil {
+		if err := m.commands.SetSpeed(speed); err != nil {
+			return m.speed, fmt.Errorf("failed to set speed: %w", err)
+		}
+	}
+	m.speed = speed
+	m.notifyStateChange()
+	return speed, nil
+}
+
+// GetSpeed returns the playback speed multiplier
+func (m *Manager) GetSpeed() float64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.speed
+}
+
+// SetAudioDevice selects the audio output device ("" or "auto" for MPV's default).
+// Before Start the device is passed on the command line; afterwards it is switched live.
+func (m *Manager) SetAudioDevice(device string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.commands != nil {
+		value := device
+		if value == "" {
+			value = "auto"
+		}
+		if err := m.commands.SetAudioDevice(value); err != nil {
+			return fmt.Errorf("failed to set audio device: %w", err)
+		}
+	}
+	m.audioDevice = device
+	return nil
+}
+
+// equalizerLabel names the equalizer's entry in MPV's audio filter chain
+const equalizerLabel = "navitone-eq"
+
+// SetEqualizer applies one of config.EqualizerPresets
+func (m *Manager) SetEqualizer(preset string) error {
+	bands, ok := config.EqualizerPresets[preset]
+	if !ok {
+		return fmt.Errorf("unknown equalizer preset %q", preset)
+	}
+	return m.SetEqualizerBands(bands)
+}
+
+// SetEqualizerBands sets the gain in dB of each config.EqualizerFrequencies band,
+// live during playback; MPV keeps filters across tracks. All-zero gains remove
+// the filter, so a flat equalizer costs nothing.
+func (m *Manager) SetEqualizerBands(bands []float64) error {
+	if len(bands) != len(config.EqualizerFrequencies) {
+		return fmt.Errorf("equalizer needs %d bands, got %d", len(config.EqualizerFrequencies), len(bands))
+	}
+
+	flat := true
+	for _, gain := range bands {
+		if gain != 0 {
+			flat = false
+			break
+		}
+	}
+	if flat {
+		bands = nil
+	} else {
+		bands = append([]float64(nil), bands...) // The caller may go on editing its slice
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.commands != nil {
+		if err := m.applyEqualizerLocked(bands); err != nil {
+			return fmt.Errorf("failed to set equalizer: %w", err)
+		}
+	}
+	m.equalizer = bands
+	return nil
+}
+
+// applyEqualizerLocked replaces MPV's equalizer filter with one for bands, or just
+// removes it when bands is nil (must be called with lock held)
+func (m *Manager) applyEqualizerLocked(bands []float64) error {
+	m.commands.RemoveAudioFilter(equalizerLabel) // Fails harmlessly when there is none yet
+	if bands == nil {
+		return nil
+	}
+
+	entries := make([]string, len(bands))
+	for i, gain := range bands {
+		entries[i] = fmt.Sprintf("entry(%d,%g)", config.EqualizerFrequencies[i], gain)
+	}
+	return m.commands.AddAudioFilter(equalizerLabel, fmt.Sprintf("lavfi=[firequalizer=gain_entry='%s']", strings.Join(entries, ";")))
+}
+
+// skipSilenceLabel names the silence filter's entry in MPV's audio filter chain
+const skipSilenceLabel = "navitone-silence"
+
+// skipSilenceFilter drops silence below -50dB at the start of a track and any
+// stretch of it longer than two seconds after that, which covers the tail
+const skipSilenceFilter = "lavfi=[silenceremove=start_periods=1:start_threshold=-50dB:stop_periods=-1:stop_duration=2:stop_threshold=-50dB]"
+
+// SetSkipSilence turns silence trimming on or off. It takes effect from the next
+// track, since the filter is restarted as each one loads. Scrobbling is unaffected:
+// it goes by the track finishing and its tagged duration, not by playback time.
+func (m *Manager) SetSkipSilence(enabled bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.commands != nil && !enabled {
+		if err := m.applySkipSilenceLocked(false); err != nil {
+			return fmt.Errorf("failed to turn off skip silence: %w", err)
+		}
+	}
+	m.skipSilence = enabled
+	return nil
+}
+
+// applySkipSilenceLocked replaces MPV's silence filter with a fresh one, or just
+// removes it when enabled is false (must be called with lock held)
+func (m *Manager) applySkipSilenceLocked(enabled bool) error {
+	m.commands.RemoveAudioFilter(skipSilenceLabel) // Fails harmlessly when there is none yet
+	if !enabled {
+		return nil
+	}
+	return m.commands.AddAudioFilter(skipSilenceLabel, skipSilenceFilter)
+}
+
+// SetLocalTrackResolver sets the lookup for downloaded copies of tracks, used in
+// place of the stream while offline
+func (m *Manager) SetLocalTrackResolver(resolve func(trackID string) (string, bool)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.localTrack = resolve
+}
+
+// SetOffline marks the server as unreachable so tracks play from downloaded copies
+func (m *Manager) SetOffline(offline bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.offline = offline
+}
+
+// ListAudioDevices returns the audio output devices MPV can use
+func (m *Manager) ListAudioDevices() ([]models.AudioDevice, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.commands == nil {
+		return nil, fmt.Errorf("MPV is not running")
+	}
+	return m.commands.GetAudioDeviceList()
+}
+
+// SetStopAfterCurrent makes playback stop when the current track finishes
+func (m *Manager) SetStopAfterCurrent(enabled bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopAfterCurrent = enabled
+	m.notifyStateChange()
+}
+
+// IsStopAfterCurrent returns whether playback stops after the current track
+func (m *Manager) IsStopAfterCurrent() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.stopAfterCurrent
+}
+
 // IsShuffleEnabled returns whether shuffle mode is enabled
 func (m *Manager) IsShuffleEnabled() bool {
     m.mu.RLock()
@@ -658,13 +1155,23 @@ func (m *Manager) IsShuffleEnabled() bool {
     return m.shuffleMode
 }
 
-// notifyStateChange notifies the UI about state changes (must be called with lock held)
+// notifyStateChange sends the UI a snapshot of the playback state (must be called with lock held)
 func (m *Manager) notifyStateChange() {
-	if m.stateCallback != nil {
-		go func() {
-			m.stateCallback(nil)
-		}()
+	state := &models.PlaybackState{
+		Queue:            make([]models.Track, len(m.queue)),
+		IsPlaying:        m.isPlaying && !m.isPaused,
+		IsShuffle:        m.shuffleMode,
+		StopAfterCurrent: m.stopAfterCurrent,
+		Speed:            m.speed,
+		Position:         m.position,
+		StreamInfo:       m.streamInfo,
 	}
+	copy(state.Queue, m.queue)
+	if m.currentIndex >= 0 && m.currentIndex < len(m.queue) {
+		track := m.queue[m.currentIndex]
+		state.CurrentTrack = &track
+	}
+	m.emit(models.PlaybackEvent{State: state})
 }
 
 // eventLoop processes MPV events
@@ -702,6 +1209,13 @@ func (m *Manager) handlePlaybackEvent(event PlaybackEvent) {
 	switch event.Type {
 	case EventTrackStarted:
 		m.logMessage("Track started")
+		m.failedInRow = 0
+		if m.currentIndex >= 0 && m.currentIndex < len(m.queue) && m.queue[m.currentIndex].Unavailable {
+			m.queue[m.currentIndex].Unavailable = false // Played again when picked explicitly, and it works now
+		}
+		if m.trackHook != nil && m.currentIndex >= 0 && m.currentIndex < len(m.queue) {
+			m.trackHook(m.queue[m.currentIndex])
+		}
 
 	case EventTrackFinished:
 		m.logMessage("Track finished")
@@ -716,11 +1230,24 @@ func (m *Manager) handlePlaybackEvent(event PlaybackEvent) {
                 Duration:    track.Duration,
                 TrackNumber: track.Track,
                 Timestamp:   time.Now().Unix(),
+
+                RecordingMBID: track.RecordingMBID,
+                ReleaseMBID:   track.ReleaseMBID,
+                ArtistMBIDs:   track.ArtistMBIDs,
             }
             m.logMessage(fmt.Sprintf("Scrobbling completed track: %s - %s", track.Artist, track.Title))
             go m.scrobbler.SubmitScrobble(track.ID, scrobbleTrack)
         }
 		
+		// Stop after current track wins over repeat modes; it only applies once
+		if m.stopAfterCurrent {
+			m.stopAfterCurrent = false
+			m.isPlaying = false
+			m.isPaused = false
+			m.logMessage("Stopped after current track")
+			break
+		}
+
 		// Auto-advance to next track
 		go func() {
 			time.Sleep(100 * time.Millisecond) // Brief delay
@@ -729,6 +1256,21 @@ func (m *Manager) handlePlaybackEvent(event PlaybackEvent) {
 
 	case EventTrackError:
 		m.logMessage(fmt.Sprintf("Track error: %v", event.Data))
+		if m.currentIndex >= 0 && m.currentIndex < len(m.queue) {
+			m.markUnavailableLocked(m.queue[m.currentIndex].ID)
+		}
+		m.failedInRow++
+		if m.failedInRow >= models.MaxFailedTracks {
+			// A run of broken tracks (a missing album, say): stop instead of skipping through all of them
+			if m.commands != nil {
+				m.commands.Stop()
+			}
+			m.isPlaying = false
+			m.isPaused = false
+			m.logMessage(fmt.Sprintf("Skipped %d unplayable tracks - stopping", m.failedInRow))
+			m.failedInRow = 0
+			break
+		}
 		// Try next track
 		go func() {
 			time.Sleep(100 * time.Millisecond)
@@ -743,6 +1285,28 @@ func (m *Manager) handlePlaybackEvent(event PlaybackEvent) {
 			m.duration = event.Duration
 		}
 
+	case EventAudioInfo:
+		// Track what MPV is actually decoding
+		if dataMap, ok := event.Data.(map[string]interface{}); ok {
+			for name, value := range dataMap {
+				switch v := value.(type) {
+				case string:
+					if name == "audio-codec-name" {
+						m.streamInfo.Codec = v
+					}
+				case float64:
+					switch name {
+					case "audio-bitrate":
+						m.streamInfo.BitRate = int(v / 1000) // MPV reports bits per second
+					case "audio-params/samplerate":
+						m.streamInfo.SampleRate = int(v)
+					case "audio-params/channel-count":
+						m.streamInfo.Channels = int(v)
+					}
+				}
+			}
+		}
+
 	case EventStateChange:
 		// Handle state changes from MPV
 		if dataMap, ok := event.Data.(map[string]interface{}); ok {
@@ -0,0 +1,239 @@
+package mpv
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+)
+
+// tracks returns a track per ID
+func tracks(ids ...string) []models.Track {
+	result := make([]models.Track, len(ids))
+	for i, id := range ids {
+		result[i] = models.Track{ID: id}
+	}
+	return result
+}
+
+// ids joins a queue's track IDs for comparison
+func ids(queue []models.Track) string {
+	parts := make([]string, len(queue))
+	for i, track := range queue {
+		parts[i] = track.ID
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestInsertTracksNext(t *testing.T) {
+	tests := []struct {
+		name         string
+		queue        string
+		original     string // Unshuffled order when shuffle is on
+		current      int
+		wantQueue    string
+		wantOriginal string
+	}{
+		{"shuffle off", "a b c d", "", 1, "a b x y c d", ""},
+		{"shuffle off, last track", "a b c d", "", 3, "a b c d x y", ""},
+		{"shuffle off, nothing played", "a b c d", "", -1, "x y a b c d", ""},
+		{"shuffle on", "c a d b", "a b c d", 1, "c a x y d b", "a x y b c d"},
+		{"shuffle on, last in original order", "c a d b", "a b c d", 2, "c a d x y b", "a b c d x y"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Manager{
+				queue:        tracks(strings.Fields(tt.queue)...),
+				currentIndex: tt.current,
+				shuffleMode:  tt.original != "",
+			}
+			if tt.original != "" {
+				m.originalQueue = tracks(strings.Fields(tt.original)...)
+			}
+
+			m.InsertTracksNext(tracks("x", "y"))
+
+			if got := ids(m.queue); got != tt.wantQueue {
+				t.Errorf("queue = %q, want %q", got, tt.wantQueue)
+			}
+			if got := ids(m.originalQueue); got != tt.wantOriginal {
+				t.Errorf("original queue = %q, want %q", got, tt.wantOriginal)
+			}
+		})
+	}
+}
+
+// fakeMPV answers MPV IPC commands with success and records their names
+type fakeMPV struct {
+	mu       sync.Mutex
+	commands []string
+}
+
+// startFakeMPV serves the MPV IPC protocol on a socket and returns a command
+// wrapper connected to it
+func startFakeMPV(t *testing.T) (*fakeMPV, *CommandWrapper) {
+	t.Helper()
+	socket := filepath.Join(t.TempDir(), "mpv.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("listening on %s: %v", socket, err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	fake := &fakeMPV{}
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			var cmd MPVCommand
+			if json.Unmarshal(scanner.Bytes(), &cmd) != nil || len(cmd.Command) == 0 {
+				continue
+			}
+			parts := make([]string, len(cmd.Command))
+			for i, part := range cmd.Command {
+				parts[i] = fmt.Sprint(part)
+			}
+			fake.mu.Lock()
+			fake.commands = append(fake.commands, strings.Join(parts, " "))
+			fake.mu.Unlock()
+			fmt.Fprintf(conn, "{\"request_id\":%d,\"error\":\"success\"}\n", cmd.RequestID)
+		}
+	}()
+
+	ipc, err := NewIPCClient(socket)
+	if err != nil {
+		t.Fatalf("connecting to fake MPV: %v", err)
+	}
+	t.Cleanup(func() { ipc.Close() })
+	return fake, NewCommandWrapper(ipc)
+}
+
+// sent returns the commands received so far, each as its name and arguments
+func (f *fakeMPV) sent() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.commands...)
+}
+
+func TestPreviousTrack(t *testing.T) {
+	tests := []struct {
+		name        string
+		position    time.Duration
+		wantIndex    int
+		wantCommands []string // Prefixes of the commands sent
+	}{
+		{"within 3s goes to the previous track", 2 * time.Second, 0, []string{"set_property pause false", "loadfile"}},
+		{"after 3s restarts the current track", 10 * time.Second, 1, []string{"seek 0 absolute"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, commands := startFakeMPV(t)
+			m := &Manager{
+				commands:        commands,
+				navidromeClient: navidrome.NewClient("http://navidrome.invalid", "user", "pass"),
+				queue:           tracks("a", "b", "c"),
+				currentIndex:    1,
+				isPlaying:       true,
+				position:        tt.position,
+			}
+
+			if err := m.PreviousTrack(); err != nil {
+				t.Fatalf("PreviousTrack: %v", err)
+			}
+			if m.currentIndex != tt.wantIndex {
+				t.Errorf("current index = %d, want %d", m.currentIndex, tt.wantIndex)
+			}
+			if sent := fake.sent(); !sentPrefixes(sent, tt.wantCommands) {
+				t.Errorf("sent %q, want %q", sent, tt.wantCommands)
+			}
+		})
+	}
+}
+
+// sentPrefixes reports whether each sent command starts with the matching prefix
+func sentPrefixes(sent, prefixes []string) bool {
+	if len(sent) != len(prefixes) {
+		return false
+	}
+	for i, prefix := range prefixes {
+		if !strings.HasPrefix(sent[i], prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// TestStartPaused checks that a track the hold function picks is loaded paused,
+// and that the next one is unpaused again
+func TestStartPaused(t *testing.T) {
+	fake, commands := startFakeMPV(t)
+	m := &Manager{
+		commands:        commands,
+		navidromeClient: navidrome.NewClient("http://navidrome.invalid", "user", "pass"),
+		queue:           tracks("episode", "song"),
+		currentIndex:    -1,
+		startPaused:     func(track models.Track) bool { return track.ID == "episode" },
+	}
+
+	if err := m.PlayTrackAtIndex(0); err != nil {
+		t.Fatalf("PlayTrackAtIndex: %v", err)
+	}
+	if !m.isPaused || !sentPrefixes(fake.sent(), []string{"set_property pause true", "loadfile"}) {
+		t.Errorf("held track: paused %v, sent %q", m.isPaused, fake.sent())
+	}
+
+	if err := m.PlayTrackAtIndex(1); err != nil {
+		t.Fatalf("PlayTrackAtIndex: %v", err)
+	}
+	if m.isPaused || !sentPrefixes(fake.sent()[2:], []string{"set_property pause false", "loadfile"}) {
+		t.Errorf("next track: paused %v, sent %q", m.isPaused, fake.sent()[2:])
+	}
+}
+
+func TestPreviousTrackAtStartOfQueue(t *testing.T) {
+	fake, commands := startFakeMPV(t)
+	m := &Manager{commands: commands, queue: tracks("a", "b"), currentIndex: 0, position: time.Second}
+
+	if err := m.PreviousTrack(); err != nil {
+		t.Fatalf("PreviousTrack: %v", err)
+	}
+	if m.currentIndex != 0 || len(fake.sent()) != 0 {
+		t.Errorf("at the first track: index %d, sent %q; want nothing to happen", m.currentIndex, fake.sent())
+	}
+}
+
+func TestClampSpeed(t *testing.T) {
+	tests := []struct {
+		in, want float64
+	}{
+		{1, 1},
+		{0.1, MinSpeed},
+		{-2, MinSpeed},
+		{MinSpeed, MinSpeed},
+		{MaxSpeed, MaxSpeed},
+		{10, MaxSpeed},
+		{1.04, 1.0},
+		{1.05, 1.1},
+		{1.26, 1.3},
+		{0.94, 0.9},
+		{2.999, 3.0},
+	}
+	for _, tt := range tests {
+		if got := ClampSpeed(tt.in); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("ClampSpeed(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
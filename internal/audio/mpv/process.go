@@ -1,13 +1,17 @@
 package mpv
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
+
+	"navitone-cli/internal/config"
 )
 
 // MPVProcess manages the MPV subprocess
@@ -34,6 +38,57 @@ func NewMPVProcess(socketPath string) *MPVProcess {
 	}
 }
 
+// Version returns the version of the mpv binary on PATH, e.g. "0.37.0"
+func Version() (string, error) {
+	path, err := exec.LookPath("mpv")
+	if err != nil {
+		return "", fmt.Errorf("mpv binary not found in PATH: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, path, "--version").Output()
+	if err != nil {
+		return "", fmt.Errorf("running mpv --version: %w", err)
+	}
+
+	// The first line reads "mpv 0.37.0 Copyright ..." (or "mpv v0.37.0-123-g..." for git builds)
+	fields := strings.Fields(string(out))
+	if len(fields) < 2 || fields[0] != "mpv" {
+		return "", fmt.Errorf("unexpected mpv --version output")
+	}
+	return strings.TrimPrefix(fields[1], "v"), nil
+}
+
+// DeviceNames lists the audio outputs the mpv binary on PATH detects, without
+// starting a player, by reading "mpv --audio-device=help"
+func DeviceNames() ([]string, error) {
+	path, err := exec.LookPath("mpv")
+	if err != nil {
+		return nil, fmt.Errorf("mpv binary not found in PATH: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, path, "--audio-device=help").Output()
+	if err != nil {
+		return nil, fmt.Errorf("running mpv --audio-device=help: %w", err)
+	}
+
+	// Devices are listed one per line as:  'pulse/sink-name' (Description)
+	var names []string
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "'") {
+			continue
+		}
+		if end := strings.Index(line[1:], "'"); end >= 0 {
+			names = append(names, line[1:end+1])
+		}
+	}
+	return names, nil
+}
+
 // Start starts the MPV process with the given arguments
 func (m *MPVProcess) Start(args []string) error {
 	m.mu.Lock()
@@ -61,6 +116,7 @@ func (m *MPVProcess) Start(args []string) error {
 		"--gapless-audio=yes",     // Enable gapless playback
 		"--replaygain=track",      // Enable replay gain
 		"--volume=70",             // Default volume 70%
+		fmt.Sprintf("--volume-max=%d", config.MaxBoostVolume), // Room for audio.allow_boost
 		fmt.Sprintf("--input-ipc-server=%s", m.socketPath), // IPC socket
 		fmt.Sprintf("--log-file=%s", m.logPath),             // Log file
 	}
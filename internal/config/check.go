@@ -0,0 +1,111 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+)
+
+// CheckResult is one line of a config check: a failure when Err is set, a
+// warning when Warning is, and a pass otherwise
+type CheckResult struct {
+	Name    string
+	Detail  string // What was checked, shown on a pass
+	Warning string
+	Err     error
+}
+
+// LoadForCheck reads the config file the way Load does, but never creates or
+// repairs it: a missing file or a decode error is returned instead. Keys the file
+// sets that navitone doesn't know are returned too, since they are usually typos.
+func LoadForCheck() (*Config, []string, error) {
+	configPath, err := GetConfigPath()
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := os.Stat(configPath); err != nil {
+		return nil, nil, fmt.Errorf("no config file at %s (run navitone once to create it)", configPath)
+	}
+
+	config := DefaultConfig()
+	meta, err := toml.DecodeFile(configPath, config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", configPath, err)
+	}
+	var unknown []string
+	for _, key := range meta.Undecoded() {
+		unknown = append(unknown, key.String())
+	}
+	config.resolvePassword()
+	return config, unknown, nil
+}
+
+// Check runs Validate and then checks what the settings point at on disk: that the
+// download and export folders and the debug log can be written. Audio backend
+// checks live with the audio package.
+func (c *Config) Check() []CheckResult {
+	results := []CheckResult{{Name: "settings", Detail: "all values valid"}}
+	if err := c.Validate(); err != nil {
+		results[0].Err = err
+	}
+
+	downloads := CheckResult{Name: "download folder"}
+	if dir, err := c.Downloads.ResolvePath(); err != nil {
+		downloads.Err = err
+	} else {
+		downloads.Detail = dir
+		downloads.Err = checkWritableDir(dir)
+	}
+	results = append(results, downloads)
+
+	exports := CheckResult{Name: "export folder"}
+	if dir, err := c.Downloads.ResolveExportPath(); err != nil {
+		exports.Err = err
+	} else {
+		exports.Detail = dir
+		exports.Err = checkWritableDir(dir)
+	}
+	results = append(results, exports)
+
+	logFile := CheckResult{Name: "debug log"}
+	if !c.Debug.LogToFile {
+		logFile.Detail = "disabled"
+	} else if path, err := GetLogPath(c); err != nil {
+		logFile.Err = err
+	} else {
+		logFile.Detail = path
+		logFile.Err = checkWritableDir(filepath.Dir(path))
+	}
+	return append(results, logFile)
+}
+
+// checkWritableDir reports whether files can be created in dir. A folder that
+// doesn't exist yet is fine as long as it can be created, so the nearest existing
+// parent is tested instead.
+func checkWritableDir(dir string) error {
+	existing := dir
+	for {
+		info, err := os.Stat(existing)
+		if err == nil {
+			if !info.IsDir() {
+				return fmt.Errorf("%s is not a directory", existing)
+			}
+			break
+		}
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			return fmt.Errorf("%s: %w", dir, err)
+		}
+		existing = parent
+	}
+
+	probe, err := os.CreateTemp(existing, ".navitone-check-*")
+	if err != nil {
+		return fmt.Errorf("%s is not writable: %w", existing, err)
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+	return nil
+}
@@ -5,6 +5,9 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
 )
@@ -16,6 +19,17 @@ type Config struct {
 	UI         UIConfig         `toml:"ui"`
 	Theme      ThemeConfig      `toml:"theme"`
 	Scrobbling ScrobblingConfig `toml:"scrobbling"`
+	Debug      DebugConfig      `toml:"debug"`
+	Cache      CacheConfig      `toml:"cache"`
+	Downloads  DownloadsConfig  `toml:"downloads"`
+	Hooks      HooksConfig      `toml:"hooks"`
+	Search     SearchConfig     `toml:"search"`
+	Behavior   BehaviorConfig   `toml:"behavior"`
+	Timeouts   TimeoutsConfig   `toml:"timeouts"`
+
+	// externalPassword is a password supplied by NAVITONE_PASSWORD, the keyring or a
+	// flag; Save never writes it back to the plaintext config file
+	externalPassword string
 }
 
 // NavidromeConfig contains Navidrome server settings
@@ -23,14 +37,76 @@ type NavidromeConfig struct {
 	ServerURL string `toml:"server_url"`
 	Username  string `toml:"username"`
 	Password  string `toml:"password"`
+	Token     string `toml:"token"` // Pre-computed md5(password + salt); used when password is empty
+	Salt      string `toml:"salt"`
 	Timeout   int    `toml:"timeout"` // in seconds
 }
 
+// Accepted range for AudioConfig.BufferSize, in milliseconds
+const (
+	MinBufferSizeMs = 20
+	MaxBufferSizeMs = 2000
+)
+
+// legacyBufferSize is the byte-count default older configs were written with before
+// buffer_size was used (and measured in milliseconds)
+const legacyBufferSize = 4096
+
+// maxPrebufferKB caps AudioConfig.PrebufferKB
+const maxPrebufferKB = 8192
+
 // AudioConfig contains audio playback settings
 type AudioConfig struct {
 	Device     string `toml:"device"`     // Audio device (auto-detect if empty)
-	Volume     int    `toml:"volume"`     // Default volume (0-100)
-	BufferSize int    `toml:"buffer_size"` // Buffer size for streaming
+	Volume     int    `toml:"volume"`     // Default volume (0-100, or up to MaxBoostVolume with allow_boost)
+	BufferSize int    `toml:"buffer_size"` // Oto output buffer in ms: larger survives underruns, smaller has less latency
+	PauseOnOther bool `toml:"pause_on_other"` // Pause when another MPRIS player starts playing (Linux)
+	MPRIS      bool   `toml:"mpris"`      // Expose playback over MPRIS for media keys and desktop widgets (Linux)
+	SeekStepSeconds int `toml:"seek_step_seconds"` // Seconds skipped by the left/right scrub keys
+	Backend    string `toml:"backend"`    // Playback backend: "auto", "mpv" or "oto"
+	PrebufferKB int   `toml:"prebuffer_kb"` // Stream data read ahead before an oto track starts (0 disables)
+	IdlePauseMinutes int `toml:"idle_pause_minutes"` // Pause after this long without input (0 disables)
+	Equalizer  string `toml:"equalizer"`  // Equalizer preset (see EqualizerPresets) or "custom"; MPV only
+	EqualizerBands []float64 `toml:"equalizer_bands"` // Gains in dB for the "custom" preset, one per EqualizerFrequencies band
+	AllowBoost bool   `toml:"allow_boost"` // Let the volume go above 100% (up to MaxBoostVolume), amplifying quiet tracks; MPV only
+	SkipSilence bool  `toml:"skip_silence"` // Cut silence at the start and end of tracks (and long silent gaps inside them); MPV only
+}
+
+// MaxBoostVolume is the highest volume percentage audio.allow_boost permits; above
+// 100% MPV amplifies in software, so loud passages can clip
+const MaxBoostVolume = 150
+
+// MaxVolume is the highest volume percentage the audio settings permit: 100, or
+// MaxBoostVolume with allow_boost
+func (a AudioConfig) MaxVolume() int {
+	if a.AllowBoost {
+		return MaxBoostVolume
+	}
+	return 100
+}
+
+// EqualizerCustom is the audio.equalizer value that uses audio.equalizer_bands
+const EqualizerCustom = "custom"
+
+// Equalizer band limits in dB
+const (
+	MinEqualizerGain = -12
+	MaxEqualizerGain = 12
+)
+
+// EqualizerFrequencies are the centre frequencies in Hz of the equalizer bands
+var EqualizerFrequencies = []int{31, 62, 125, 250, 500, 1000, 2000, 4000, 8000, 16000}
+
+// EqualizerPresetNames lists the equalizer presets in the order they are offered
+var EqualizerPresetNames = []string{"flat", "bass", "treble", "vocal", "rock"}
+
+// EqualizerPresets are the gains in dB of each preset, one per EqualizerFrequencies band
+var EqualizerPresets = map[string][]float64{
+	"flat":   {0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+	"bass":   {6, 5, 4, 2, 0, 0, 0, 0, 0, 0},
+	"treble": {0, 0, 0, 0, 0, 1, 2, 4, 5, 6},
+	"vocal":  {-2, -2, -1, 0, 2, 4, 4, 2, 0, -1},
+	"rock":   {4, 3, 2, 0, -1, -1, 0, 2, 3, 4},
 }
 
 // UIConfig contains user interface settings
@@ -47,8 +123,43 @@ type UIConfig struct {
     ArtworkQuality string `toml:"artwork_quality"` // "low", "medium", "high", "ultra"
     ArtworkColor   bool   `toml:"artwork_color"`   // Enable colored ASCII art
     ArtworkSize    string `toml:"artwork_size"`    // "small", "medium", "large"
+    DynamicAccent  bool   `toml:"dynamic_accent"`  // Tint the accent color from the playing album's cover
+
+    LogLines int `toml:"log_lines"` // Number of log messages shown below the player (1-10)
+    HideLog  bool `toml:"hide_log"` // Start with the log area hidden (toggle with Alt+0)
+    Marquee  bool `toml:"marquee"`  // Scroll long selected rows instead of truncating them
+    TwoColumn bool `toml:"two_column"` // Split lists and home sections into two columns on terminals wider than 120
+    Scrollbar bool `toml:"scrollbar"`  // Draw a scrollbar beside lists longer than the screen
+    Glyphs    string `toml:"glyphs"`    // Icon set: "emoji", "ascii" (no emoji support) or "nerdfont"
+    SetWindowTitle bool `toml:"set_window_title"` // Show the playing track in the terminal title
+    Locale    string `toml:"locale"`    // Language for number formatting, e.g. "de" for 1.234 ("none" disables separators; default 1,234)
+    NewBadgeDays   int    `toml:"new_badge_days"`   // Mark albums added this many days ago or less as NEW (0 disables the badge)
+    AlbumRowFormat string `toml:"album_row_format"` // Album row template, e.g. "{artist} - {name}|{year:4}" (see AlbumRowFields); empty for the built-in layout
+
+    // Home tab sections in display order (see HomeSectionIDs); leave one out to hide it
+    HomeSections []string `toml:"home_sections"`
+
+    // Items shown in each home tab section (1-20; trimmed to fit the terminal)
+    HomeRecentCount     int `toml:"home_recent_count"`
+    HomeTopArtistsCount int `toml:"home_top_artists_count"`
+    HomeMostPlayedCount int `toml:"home_most_played_count"`
+    HomeTopTracksCount  int `toml:"home_top_tracks_count"`
+    HomeRecentlyPlayedCount int `toml:"home_recently_played_count"`
 }
 
+// DefaultNewBadgeDays is how recent an album must be for the New Additions filter
+// when ui.new_badge_days is 0
+const DefaultNewBadgeDays = 14
+
+// MaxHomeSectionCount is the largest configurable number of items per home section
+const MaxHomeSectionCount = 20
+
+// HomeSectionCount is the number of home tab sections (see HomeSectionCounts)
+const HomeSectionCount = 5
+
+// HomeSectionIDs names the home tab sections for ui.home_sections, indexed by section
+var HomeSectionIDs = [HomeSectionCount]string{"recently_added", "top_artists", "most_played", "top_tracks", "recently_played"}
+
 // ThemeConfig contains enhanced theming with Omarchy integration support
 type ThemeConfig struct {
     Name       string            `toml:"name"`       // Theme name (e.g., "omarchy-dracula")
@@ -74,6 +185,7 @@ type ScrobblingConfig struct {
     Method       string             `toml:"method"`
     LastFM       LastFMConfig       `toml:"lastfm"`
     ListenBrainz ListenBrainzConfig `toml:"listenbrainz"`
+    Custom       CustomScrobblerConfig `toml:"custom"`
 }
 
 // LastFMConfig contains Last.fm scrobbling settings
@@ -91,6 +203,145 @@ type ListenBrainzConfig struct {
 	Token   string `toml:"token"`
 }
 
+// CustomScrobblerConfig posts scrobbles to a self-hosted sink such as Maloja
+type CustomScrobblerConfig struct {
+	Enabled       bool   `toml:"enabled"`
+	Name          string `toml:"name"`            // Shown in logs (defaults to "Custom")
+	URL           string `toml:"url"`             // Scrobble endpoint, e.g. https://maloja.example/apis/mlj_1/newscrobble
+	NowPlayingURL string `toml:"now_playing_url"` // Optional; now playing is skipped when empty
+	APIKey        string `toml:"api_key"`
+	Payload       string `toml:"payload"` // JSON template with {artist}, {title}, ... placeholders (defaults to Maloja's format)
+}
+
+// DebugConfig contains debug logging settings
+type DebugConfig struct {
+	LogToFile    bool   `toml:"log_to_file"`     // Write debug log to disk (disable for privacy)
+	LogPath      string `toml:"log_path"`        // Override log file path (NAVITONE_LOG env takes precedence)
+	MaxLogSizeMB int    `toml:"max_log_size_mb"` // Rotate the log when it grows beyond this size
+}
+
+// CacheConfig contains on-disk library cache settings
+type CacheConfig struct {
+	Enabled bool `toml:"enabled"` // Cache album/artist/playlist lists for instant startup
+	TTL     int  `toml:"ttl"`     // Hours before a cached library is ignored (0 = never expires)
+	OfflineMaxMB int `toml:"offline_max_mb"` // Disk space for tracks downloaded for offline play (0 = unlimited)
+}
+
+// maxDownloadWorkers caps DownloadsConfig.Workers
+const maxDownloadWorkers = 16
+
+// DownloadsConfig contains settings for saving tracks to disk (separate from the offline cache)
+type DownloadsConfig struct {
+	Path    string `toml:"path"`    // Music folder for downloads (defaults to ~/Music/Navitone)
+	Workers int    `toml:"workers"` // Tracks downloaded in parallel
+	ExportPath string `toml:"export_path"` // Folder for queue exports (defaults to the downloads folder)
+}
+
+// HooksConfig lets external scripts (status bars and the like) follow track changes
+type HooksConfig struct {
+	NowPlayingFile bool   `toml:"now_playing_file"` // Write $XDG_RUNTIME_DIR/navitone-nowplaying.json on each track start
+	OnTrackChange  string `toml:"on_track_change"`  // Command run on each track start (no shell; gets artist, title, album and NAVITONE_* env)
+}
+
+// SearchConfig contains search and list filter settings
+type SearchConfig struct {
+	Fuzzy bool `toml:"fuzzy"` // Match typos and partial words in the log filter and rank search results by closeness
+}
+
+// Values for BehaviorConfig.EnterAction
+const (
+	EnterActionPlay  = "play"  // Enter plays the selection and queues the rest; Shift+Enter queues
+	EnterActionQueue = "queue" // Enter queues the selection; Shift+Enter plays
+)
+
+// Values for BehaviorConfig.DefaultQueueMode
+const (
+	QueueModeAppend  = "append"  // a adds to the end of the queue; Alt+A replaces it
+	QueueModeReplace = "replace" // a replaces the queue and plays; Alt+A appends
+)
+
+// BehaviorConfig contains settings for what keys do
+type BehaviorConfig struct {
+	EnterAction        string `toml:"enter_action"`         // "play" or "queue"; Shift+Enter does the other
+	DefaultQueueMode   string `toml:"default_queue_mode"`   // What a does with an album, artist or playlist: "append" or "replace"; Alt+A does the other
+	AutoRefreshMinutes int    `toml:"auto_refresh_minutes"` // Reload the library in the background this often (0 = off)
+	RestoreSession     bool   `toml:"restore_session"`      // Reopen on the tab and items selected when last quit
+
+	// Sorts applied whenever each list loads, until another is picked with the
+	// sort menu ("" keeps the server's order)
+	DefaultAlbumSort    string `toml:"default_album_sort"`    // One of AlbumSorts
+	DefaultArtistSort   string `toml:"default_artist_sort"`   // One of ArtistSorts
+	DefaultPlaylistSort string `toml:"default_playlist_sort"` // One of PlaylistSorts
+}
+
+// Sort IDs each behavior.default_*_sort setting accepts, matching the sort menu
+var (
+	AlbumSorts    = []string{"alpha", "date_added", "play_count", "album_artist", "year", "rating", "starred", "new"}
+	ArtistSorts   = []string{"alpha", "date_added", "play_count"}
+	PlaylistSorts = []string{"alpha", "date_added", "play_count"}
+)
+
+// TimeoutsConfig contains how long each kind of server request may take, in seconds
+type TimeoutsConfig struct {
+	Library  int `toml:"library"`  // Albums, artists, playlists, home data and track lists
+	Search   int `toml:"search"`   // Search as you type and loading more results
+	Metadata int `toml:"metadata"` // Ratings, now playing, scan status, share links and scrobbling status
+	Ping     int `toml:"ping"`     // Connection tests and the offline check
+}
+
+// LibraryTimeout returns the library timeout as a duration
+func (t TimeoutsConfig) LibraryTimeout() time.Duration { return time.Duration(t.Library) * time.Second }
+
+// SearchTimeout returns the search timeout as a duration
+func (t TimeoutsConfig) SearchTimeout() time.Duration { return time.Duration(t.Search) * time.Second }
+
+// MetadataTimeout returns the metadata timeout as a duration
+func (t TimeoutsConfig) MetadataTimeout() time.Duration { return time.Duration(t.Metadata) * time.Second }
+
+// PingTimeout returns the ping timeout as a duration
+func (t TimeoutsConfig) PingTimeout() time.Duration { return time.Duration(t.Ping) * time.Second }
+
+// ClientTimeout returns the limit for a single HTTP request: the longest operation
+// timeout, so the per-operation ones decide, but never below navidrome.timeout
+func (c *Config) ClientTimeout() time.Duration {
+	longest := c.Navidrome.Timeout
+	for _, seconds := range []int{c.Timeouts.Library, c.Timeouts.Search, c.Timeouts.Metadata, c.Timeouts.Ping} {
+		if seconds > longest {
+			longest = seconds
+		}
+	}
+	return time.Duration(longest) * time.Second
+}
+
+// ResolvePath returns the download folder, expanding a leading ~ and falling back to ~/Music/Navitone
+func (d DownloadsConfig) ResolvePath() (string, error) {
+	path := d.Path
+	if path == "" {
+		path = filepath.Join("~", "Music", "Navitone")
+	}
+	return expandHome(path)
+}
+
+// ResolveExportPath returns the queue export folder, falling back to the download folder
+func (d DownloadsConfig) ResolveExportPath() (string, error) {
+	if d.ExportPath == "" {
+		return d.ResolvePath()
+	}
+	return expandHome(d.ExportPath)
+}
+
+// expandHome replaces a leading ~ in path with the user's home directory
+func expandHome(path string) (string, error) {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(homeDir, path[1:])
+	}
+	return path, nil
+}
+
 // DefaultConfig returns a configuration with default values
 func DefaultConfig() *Config {
     return &Config{
@@ -103,7 +354,13 @@ func DefaultConfig() *Config {
 		Audio: AudioConfig{
 			Device:     "", // Auto-detect
 			Volume:     100,
-			BufferSize: 4096,
+			BufferSize: 100, // ms
+			PauseOnOther: false,
+			MPRIS:        true,
+			SeekStepSeconds: 10,
+			Backend:    "auto", // MPV when installed, otherwise oto
+			PrebufferKB: 256,
+			Equalizer:  "flat",
 		},
         UI: UIConfig{
             Theme:          "dark",
@@ -114,6 +371,19 @@ func DefaultConfig() *Config {
             ArtworkQuality: "high",   // Default to high quality
             ArtworkColor:   false,    // Start with monochrome for compatibility
             ArtworkSize:    "medium", // Balanced size
+            LogLines:       2,
+            Marquee:        true,
+            SetWindowTitle: true,
+            TwoColumn:      true,
+            Scrollbar:      true,
+            Glyphs:         "emoji",
+            NewBadgeDays:   DefaultNewBadgeDays,
+            HomeRecentCount:     4,
+            HomeTopArtistsCount: 4,
+            HomeMostPlayedCount: 4,
+            HomeTopTracksCount:  4,
+            HomeRecentlyPlayedCount: 4,
+            HomeSections:        append([]string(nil), HomeSectionIDs[:]...),
             Keybindings: map[string]string{
                 "quit":       "ctrl+c,q",
                 "next_tab":   "tab",
@@ -156,12 +426,107 @@ func DefaultConfig() *Config {
                 Enabled: false,
                 Token:   "",
             },
+            Custom: CustomScrobblerConfig{
+                Enabled: false,
+                Name:    "Custom",
+            },
+        },
+        Debug: DebugConfig{
+            LogToFile:    true,
+            LogPath:      "", // Defaults to $XDG_STATE_HOME/navitone/navitone.log
+            MaxLogSizeMB: 5,
+        },
+        Cache: CacheConfig{
+            Enabled: true,
+            TTL:     24,
+            OfflineMaxMB: 2048,
+        },
+        Downloads: DownloadsConfig{
+            Path:    "", // ~/Music/Navitone
+            Workers: 3,
+        },
+        Behavior: BehaviorConfig{
+            EnterAction:      EnterActionPlay,
+            DefaultQueueMode: QueueModeAppend,
+            RestoreSession:   true,
+        },
+        Timeouts: TimeoutsConfig{
+            Library:  60,
+            Search:   15,
+            Metadata: 10,
+            Ping:     10,
         },
     }
 }
 
+// configPathOverride is set by SetConfigPath (e.g. from the --config flag)
+var configPathOverride string
+
+// SetConfigPath makes Load and Save use path instead of the default location
+func SetConfigPath(path string) {
+	configPathOverride = path
+}
+
+// HasCredentials reports whether a password or a stored token+salt is available
+func (c *Config) HasCredentials() bool {
+	return c.Navidrome.Password != "" || (c.Navidrome.Token != "" && c.Navidrome.Salt != "")
+}
+
+// resolvePassword fills an empty password from NAVITONE_PASSWORD or the OS keyring
+func (c *Config) resolvePassword() {
+	if c.Navidrome.Password != "" || c.Navidrome.Token != "" {
+		return
+	}
+	if password := os.Getenv("NAVITONE_PASSWORD"); password != "" {
+		c.SetExternalPassword(password)
+		return
+	}
+	if c.Navidrome.ServerURL == "" || c.Navidrome.Username == "" {
+		return
+	}
+	if password, err := GetKeyringPassword(c.Navidrome.ServerURL, c.Navidrome.Username); err == nil && password != "" {
+		c.SetExternalPassword(password)
+	}
+}
+
+// SetExternalPassword uses password for this session without persisting it to the config file
+func (c *Config) SetExternalPassword(password string) {
+	c.Navidrome.Password = password
+	c.externalPassword = password
+}
+
+// StorePasswordInKeyring moves the current password into the OS keyring so it is
+// no longer written to the plaintext config file
+func (c *Config) StorePasswordInKeyring() error {
+	if c.Navidrome.Password == "" {
+		return fmt.Errorf("no password to store")
+	}
+	if err := SetKeyringPassword(c.Navidrome.ServerURL, c.Navidrome.Username, c.Navidrome.Password); err != nil {
+		return err
+	}
+	c.externalPassword = c.Navidrome.Password
+	return Save(c)
+}
+
+// ApplyEnv overrides server settings from NAVITONE_SERVER and NAVITONE_USERNAME
+func (c *Config) ApplyEnv() {
+	if server := os.Getenv("NAVITONE_SERVER"); server != "" {
+		c.Navidrome.ServerURL = server
+	}
+	if username := os.Getenv("NAVITONE_USERNAME"); username != "" {
+		c.Navidrome.Username = username
+	}
+}
+
 // GetConfigPath returns the path to the configuration file
 func GetConfigPath() (string, error) {
+	if configPathOverride != "" {
+		if err := os.MkdirAll(filepath.Dir(configPathOverride), 0755); err != nil {
+			return "", err
+		}
+		return configPathOverride, nil
+	}
+
 	configDir, err := os.UserConfigDir()
 	if err != nil {
 		return "", err
@@ -175,6 +540,33 @@ func GetConfigPath() (string, error) {
 	return filepath.Join(navitoneDir, "config.toml"), nil
 }
 
+// GetLogPath returns the debug log path: NAVITONE_LOG, then debug.log_path,
+// then $XDG_STATE_HOME/navitone (~/.local/state/navitone), then the user cache dir
+func GetLogPath(cfg *Config) (string, error) {
+	if path := os.Getenv("NAVITONE_LOG"); path != "" {
+		return path, nil
+	}
+	if cfg != nil && cfg.Debug.LogPath != "" {
+		return cfg.Debug.LogPath, nil
+	}
+
+	stateDir := os.Getenv("XDG_STATE_HOME")
+	if stateDir == "" {
+		if homeDir, err := os.UserHomeDir(); err == nil {
+			stateDir = filepath.Join(homeDir, ".local", "state")
+		}
+	}
+	if stateDir == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		stateDir = cacheDir
+	}
+
+	return filepath.Join(stateDir, "navitone", "navitone.log"), nil
+}
+
 // Load loads configuration from file, creating default if it doesn't exist
 func Load() (*Config, error) {
 	configPath, err := GetConfigPath()
@@ -189,6 +581,7 @@ func Load() (*Config, error) {
 		if err := Save(config); err != nil {
 			return nil, err
 		}
+		config.resolvePassword()
 		return config, nil
 	}
 
@@ -207,6 +600,13 @@ func Load() (*Config, error) {
 		Save(config)
 	}
 
+	// The old unused default would now mean a four-second buffer
+	if config.Audio.BufferSize == legacyBufferSize {
+		config.Audio.BufferSize = DefaultConfig().Audio.BufferSize
+	}
+
+	config.resolvePassword()
+
 	return config, nil
 }
 
@@ -266,6 +666,13 @@ func Save(config *Config) error {
         return err
     }
     defer file.Close()
+
+	// Keep externally supplied passwords out of the file
+	if config.externalPassword != "" && config.Navidrome.Password == config.externalPassword {
+		stored := *config
+		stored.Navidrome.Password = ""
+		config = &stored
+	}
 	
 	encoder := toml.NewEncoder(file)
 	return encoder.Encode(config)
@@ -282,13 +689,173 @@ func (c *Config) Validate() error {
 		return &ValidationError{Field: "navidrome.username", Message: "Username is required"}
 	}
 	
-	if c.Audio.Volume < 0 || c.Audio.Volume > 100 {
-		return &ValidationError{Field: "audio.volume", Message: "Volume must be between 0 and 100"}
+	if c.Audio.Volume < 0 || c.Audio.Volume > c.Audio.MaxVolume() {
+		return &ValidationError{Field: "audio.volume", Message: fmt.Sprintf("Volume must be between 0 and %d", c.Audio.MaxVolume())}
+	}
+
+	if c.Audio.SeekStepSeconds < 1 {
+		return &ValidationError{Field: "audio.seek_step_seconds", Message: "Seek step must be at least 1 second"}
+	}
+
+	if c.Audio.BufferSize < MinBufferSizeMs || c.Audio.BufferSize > MaxBufferSizeMs {
+		return &ValidationError{Field: "audio.buffer_size", Message: fmt.Sprintf("Buffer size must be between %d and %d ms", MinBufferSizeMs, MaxBufferSizeMs)}
+	}
+
+	if c.Audio.IdlePauseMinutes < 0 {
+		return &ValidationError{Field: "audio.idle_pause_minutes", Message: "Idle pause cannot be negative"}
+	}
+
+	if c.Audio.PrebufferKB < 0 || c.Audio.PrebufferKB > maxPrebufferKB {
+		return &ValidationError{Field: "audio.prebuffer_kb", Message: fmt.Sprintf("Prebuffer must be between 0 and %d KB", maxPrebufferKB)}
+	}
+
+	if c.Cache.OfflineMaxMB < 0 {
+		return &ValidationError{Field: "cache.offline_max_mb", Message: "Offline cache size cannot be negative"}
+	}
+
+	if c.Downloads.Workers < 1 || c.Downloads.Workers > maxDownloadWorkers {
+		return &ValidationError{Field: "downloads.workers", Message: fmt.Sprintf("Download workers must be between 1 and %d", maxDownloadWorkers)}
+	}
+
+	switch c.Audio.Backend {
+	case "auto", "mpv", "oto":
+	default:
+		return &ValidationError{Field: "audio.backend", Message: "Backend must be auto, mpv or oto"}
+	}
+
+	switch c.UI.Glyphs {
+	case "emoji", "ascii", "nerdfont":
+	default:
+		return &ValidationError{Field: "ui.glyphs", Message: "Glyphs must be emoji, ascii or nerdfont"}
+	}
+
+	switch c.Behavior.EnterAction {
+	case EnterActionPlay, EnterActionQueue:
+	default:
+		return &ValidationError{Field: "behavior.enter_action", Message: "Enter action must be play or queue"}
+	}
+
+	switch c.Behavior.DefaultQueueMode {
+	case QueueModeAppend, QueueModeReplace:
+	default:
+		return &ValidationError{Field: "behavior.default_queue_mode", Message: "Default queue mode must be append or replace"}
+	}
+
+	timeoutFields := []string{"timeouts.library", "timeouts.search", "timeouts.metadata", "timeouts.ping"}
+	for i, seconds := range []int{c.Timeouts.Library, c.Timeouts.Search, c.Timeouts.Metadata, c.Timeouts.Ping} {
+		if seconds < 1 || seconds > 600 {
+			return &ValidationError{Field: timeoutFields[i], Message: "Timeouts must be between 1 and 600 seconds"}
+		}
+	}
+
+	if _, ok := EqualizerPresets[c.Audio.Equalizer]; !ok && c.Audio.Equalizer != EqualizerCustom {
+		return &ValidationError{Field: "audio.equalizer", Message: fmt.Sprintf("Equalizer must be one of %s or %s", strings.Join(EqualizerPresetNames, ", "), EqualizerCustom)}
+	}
+	if c.Audio.Equalizer == EqualizerCustom && len(c.Audio.EqualizerBands) != len(EqualizerFrequencies) {
+		return &ValidationError{Field: "audio.equalizer_bands", Message: fmt.Sprintf("The custom equalizer needs %d band gains", len(EqualizerFrequencies))}
+	}
+	for _, gain := range c.Audio.EqualizerBands {
+		if gain < MinEqualizerGain || gain > MaxEqualizerGain {
+			return &ValidationError{Field: "audio.equalizer_bands", Message: fmt.Sprintf("Equalizer gains must be between %d and %d dB", MinEqualizerGain, MaxEqualizerGain)}
+		}
+	}
+
+	sortFields := []string{"behavior.default_album_sort", "behavior.default_artist_sort", "behavior.default_playlist_sort"}
+	sortChoices := [][]string{AlbumSorts, ArtistSorts, PlaylistSorts}
+	for i, sort := range []string{c.Behavior.DefaultAlbumSort, c.Behavior.DefaultArtistSort, c.Behavior.DefaultPlaylistSort} {
+		if sort != "" && !slices.Contains(sortChoices[i], sort) {
+			return &ValidationError{Field: sortFields[i], Message: fmt.Sprintf("Default sort must be one of %s, or empty", strings.Join(sortChoices[i], ", "))}
+		}
+	}
+
+	if c.Behavior.AutoRefreshMinutes < 0 || c.Behavior.AutoRefreshMinutes > 1440 {
+		return &ValidationError{Field: "behavior.auto_refresh_minutes", Message: "Auto refresh must be between 0 (off) and 1440 minutes"}
+	}
+
+	if c.UI.NewBadgeDays < 0 || c.UI.NewBadgeDays > 365 {
+		return &ValidationError{Field: "ui.new_badge_days", Message: "New badge days must be between 0 (off) and 365"}
+	}
+
+	if c.UI.AlbumRowFormat != "" {
+		if _, err := ParseRowFormat(c.UI.AlbumRowFormat, AlbumRowFields); err != nil {
+			return &ValidationError{Field: "ui.album_row_format", Message: fmt.Sprintf("Invalid album row format: %v", err)}
+		}
+	}
+
+	if c.UI.LogLines < 1 || c.UI.LogLines > 10 {
+		return &ValidationError{Field: "ui.log_lines", Message: "Log lines must be between 1 and 10"}
+	}
+
+	homeFields := []string{"ui.home_recent_count", "ui.home_top_artists_count", "ui.home_most_played_count", "ui.home_top_tracks_count", "ui.home_recently_played_count"}
+	homeCounts := []int{c.UI.HomeRecentCount, c.UI.HomeTopArtistsCount, c.UI.HomeMostPlayedCount, c.UI.HomeTopTracksCount, c.UI.HomeRecentlyPlayedCount}
+	for i, count := range homeCounts {
+		if count < 1 || count > MaxHomeSectionCount {
+			return &ValidationError{Field: homeFields[i], Message: fmt.Sprintf("Home section counts must be between 1 and %d", MaxHomeSectionCount)}
+		}
+	}
+	if _, err := parseHomeSections(c.UI.HomeSections); err != nil {
+		return &ValidationError{Field: "ui.home_sections", Message: fmt.Sprintf("Invalid home sections: %v", err)}
+	}
+
+	if c.Cache.TTL < 0 {
+		return &ValidationError{Field: "cache.ttl", Message: "Cache TTL cannot be negative"}
+	}
+
+	if c.Scrobbling.Custom.Enabled && c.Scrobbling.Custom.URL == "" {
+		return &ValidationError{Field: "scrobbling.custom.url", Message: "Custom scrobbler URL is required when it is enabled"}
 	}
 	
 	return nil
 }
 
+// HomeSectionOrder returns the sections to show on the home tab, as indexes into
+// HomeSectionIDs in display order. An empty or invalid ui.home_sections shows every
+// section in the default order.
+func (u UIConfig) HomeSectionOrder() []int {
+	order, err := parseHomeSections(u.HomeSections)
+	if err != nil || len(order) == 0 {
+		order = make([]int, HomeSectionCount)
+		for i := range order {
+			order[i] = i
+		}
+	}
+	return order
+}
+
+// parseHomeSections maps ui.home_sections names to section indexes, rejecting
+// unknown and repeated names
+func parseHomeSections(names []string) ([]int, error) {
+	order := make([]int, 0, len(names))
+	seen := make(map[int]bool)
+	for _, name := range names {
+		section := slices.Index(HomeSectionIDs[:], strings.ToLower(strings.TrimSpace(name)))
+		if section < 0 {
+			return nil, fmt.Errorf("unknown section %q (expected %s)", name, strings.Join(HomeSectionIDs[:], ", "))
+		}
+		if seen[section] {
+			return nil, fmt.Errorf("%q is listed twice", name)
+		}
+		seen[section] = true
+		order = append(order, section)
+	}
+	return order, nil
+}
+
+// HomeSectionCounts returns the configured item counts for the home sections
+// (recently added, top artists, most played, top tracks, recently played),
+// defaulting unset ones to 4
+func (u UIConfig) HomeSectionCounts() [HomeSectionCount]int {
+	counts := [HomeSectionCount]int{u.HomeRecentCount, u.HomeTopArtistsCount, u.HomeMostPlayedCount, u.HomeTopTracksCount, u.HomeRecentlyPlayedCount}
+	for i, count := range counts {
+		if count < 1 {
+			counts[i] = 4
+		} else if count > MaxHomeSectionCount {
+			counts[i] = MaxHomeSectionCount
+		}
+	}
+	return counts
+}
+
 // ValidationError represents a configuration validation error
 type ValidationError struct {
 	Field   string
@@ -0,0 +1,29 @@
+package config
+
+import "testing"
+
+func TestValidateVolume(t *testing.T) {
+	tests := []struct {
+		volume  int
+		boost   bool
+		wantErr bool
+	}{
+		{0, false, false},
+		{100, false, false},
+		{101, false, true},
+		{130, true, false},
+		{MaxBoostVolume, true, false},
+		{MaxBoostVolume + 1, true, true},
+		{-1, true, true},
+	}
+	for _, tt := range tests {
+		cfg := DefaultConfig()
+		cfg.Navidrome.ServerURL = "http://navidrome.local"
+		cfg.Navidrome.Username = "user"
+		cfg.Audio.Volume = tt.volume
+		cfg.Audio.AllowBoost = tt.boost
+		if err := cfg.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("volume %d, boost %v: got %v, want an error: %v", tt.volume, tt.boost, err, tt.wantErr)
+		}
+	}
+}
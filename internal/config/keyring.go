@@ -0,0 +1,46 @@
+package config
+
+import (
+	"errors"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/zalando/go-keyring"
+)
+
+// keyringService is the service name passwords are stored under in the OS keyring
+const keyringService = "navitone-cli"
+
+// ErrKeyringUnavailable is returned when no supported keyring service is available
+// (e.g. headless servers without a Secret Service daemon)
+var ErrKeyringUnavailable = errors.New("no keyring service available")
+
+// keyringAccount keys keyring entries by username and server URL
+func keyringAccount(serverURL, username string) string {
+	return username + "@" + serverURL
+}
+
+// GetKeyringPassword looks up the password for serverURL/username in the OS keyring:
+// the Secret Service on Linux, the login keychain on macOS, the Credential Manager on Windows
+func GetKeyringPassword(serverURL, username string) (string, error) {
+	password, err := keyring.Get(keyringService, keyringAccount(serverURL, username))
+	if err != nil {
+		return "", keyringError(err)
+	}
+	return password, nil
+}
+
+// SetKeyringPassword stores the password for serverURL/username in the OS keyring
+func SetKeyringPassword(serverURL, username, password string) error {
+	return keyringError(keyring.Set(keyringService, keyringAccount(serverURL, username), password))
+}
+
+// keyringError maps go-keyring's errors for a platform without a keyring, or a
+// Linux session without a Secret Service daemon, to ErrKeyringUnavailable
+func keyringError(err error) error {
+	var dbusErr dbus.Error
+	if errors.Is(err, keyring.ErrUnsupportedPlatform) ||
+		errors.As(err, &dbusErr) && dbusErr.Name == "org.freedesktop.DBus.Error.ServiceUnknown" {
+		return ErrKeyringUnavailable
+	}
+	return err
+}
@@ -0,0 +1,103 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AlbumRowFields are the fields ui.album_row_format can show
+var AlbumRowFields = []string{"artist", "name", "year", "genre", "tracks", "plays", "duration", "rating", "new"}
+
+// RowFormat is a parsed row template such as "{artist} - {name}|{year:4}": literal
+// text and {field} or {field:width} tokens, with an optional | between the left side
+// and the right-aligned columns. A positive width pads on the left (for numbers), a
+// negative one on the right.
+type RowFormat struct {
+	left, right []rowToken
+}
+
+// rowToken is literal text, or a field padded to width
+type rowToken struct {
+	text  string
+	field string
+	width int
+}
+
+// ParseRowFormat parses a row template, accepting only the given field names
+func ParseRowFormat(format string, fields []string) (*RowFormat, error) {
+	parts := strings.Split(format, "|")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("only one | may separate the left side from the columns")
+	}
+
+	var f RowFormat
+	var err error
+	if f.left, err = parseRowTokens(parts[0], fields); err != nil {
+		return nil, err
+	}
+	if len(parts) == 2 {
+		if f.right, err = parseRowTokens(parts[1], fields); err != nil {
+			return nil, err
+		}
+	}
+	return &f, nil
+}
+
+// parseRowTokens splits one side of a row template into text and field tokens
+func parseRowTokens(s string, fields []string) ([]rowToken, error) {
+	var tokens []rowToken
+	for s != "" {
+		open := strings.IndexByte(s, '{')
+		if open < 0 {
+			tokens = append(tokens, rowToken{text: s})
+			break
+		}
+		if open > 0 {
+			tokens = append(tokens, rowToken{text: s[:open]})
+		}
+		end := strings.IndexByte(s[open:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed { in %q", s[open:])
+		}
+
+		token := rowToken{field: s[open+1 : open+end]}
+		if name, width, ok := strings.Cut(token.field, ":"); ok {
+			n, err := strconv.Atoi(width)
+			if err != nil {
+				return nil, fmt.Errorf("invalid width in {%s}", token.field)
+			}
+			token.field, token.width = name, n
+		}
+		known := false
+		for _, field := range fields {
+			known = known || field == token.field
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown field {%s} (expected one of %s)", token.field, strings.Join(fields, ", "))
+		}
+		tokens = append(tokens, token)
+		s = s[open+end+1:]
+	}
+	return tokens, nil
+}
+
+// Render fills the template with values by field name, returning the left side and
+// the right-aligned columns
+func (f *RowFormat) Render(values map[string]string) (left, right string) {
+	return renderRowTokens(f.left, values), renderRowTokens(f.right, values)
+}
+
+// renderRowTokens joins one side's tokens, trimming the spaces a trailing empty
+// field leaves behind
+func renderRowTokens(tokens []rowToken, values map[string]string) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		if token.field == "" {
+			b.WriteString(token.text)
+			continue
+		}
+		fmt.Fprintf(&b, "%*s", token.width, values[token.field])
+	}
+	return strings.TrimRight(b.String(), " ")
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Session is where the user left off: the active tab and the item selected on
+// each library tab (by ID, so it survives the lists changing order). It lives in
+// session.toml next to the config file and is rewritten on every exit.
+type Session struct {
+	Tab        int    `toml:"tab"`
+	AlbumID    string `toml:"album_id"`
+	ArtistID   string `toml:"artist_id"`
+	PlaylistID string `toml:"playlist_id"`
+}
+
+// getSessionPath returns session.toml in the config file's directory
+func getSessionPath() (string, error) {
+	configPath, err := GetConfigPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(configPath), "session.toml"), nil
+}
+
+// LoadSession returns the session saved on the last exit; a missing or
+// unreadable file gives an empty session
+func LoadSession() *Session {
+	session := &Session{}
+	path, err := getSessionPath()
+	if err != nil {
+		return session
+	}
+	if _, err := toml.DecodeFile(path, session); err != nil {
+		return &Session{}
+	}
+	return session
+}
+
+// SaveSession writes the session for the next start
+func SaveSession(session *Session) error {
+	path, err := getSessionPath()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return toml.NewEncoder(file).Encode(session)
+}
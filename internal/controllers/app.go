@@ -2,17 +2,27 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"navitone-cli/internal/artwork"
 	"navitone-cli/internal/audio"
 	"navitone-cli/internal/config"
+	"navitone-cli/internal/downloads"
 	"navitone-cli/internal/models"
+	"navitone-cli/internal/offline"
+	"navitone-cli/internal/hooks"
+	"navitone-cli/internal/mpris"
 	"navitone-cli/internal/utils"
 	"navitone-cli/internal/views"
 	"navitone-cli/pkg/navidrome"
@@ -26,25 +36,63 @@ type App struct {
 	state           *models.AppState
 	view            *views.MainView
 	navidromeClient *navidrome.Client
-	audioManager    *audio.Manager
+	audioManager    audio.AudioBackend
 	scrobbler       *scrobbling.Manager
 	artworkManager  *artwork.Manager
+	playerWatcher   *mpris.Watcher
+	mprisServer     *mpris.Server // Media keys and desktop widgets (Linux)
+	hooks           *hooks.Runner // Tells external scripts about track changes
+	searchSeq       int // Incremented per search keystroke for debouncing
+	cleanupOnce     sync.Once
+
+	lastSessionTick time.Time // Previous session clock tick
+	lastInput       time.Time // Last key or mouse input, for the idle pause
+	lastRefresh     time.Time // Last full library refresh, for behavior.auto_refresh_minutes
+
+	marqueeRunning bool   // Whether the marquee tick loop is scheduled
+	marqueeKey     string // Selection the marquee offset belongs to
+	spinnerRunning bool   // Whether the loading spinner tick loop is scheduled
+
+	offlineStore       *offline.Store // Tracks downloaded for offline playback
+	offlinePending     []models.Track // Tracks waiting to be downloaded
+	offlineDownloading bool
+	reconnectAttempts  int                   // Failed pings since going offline, for the retry backoff
+	downloader         *downloads.Downloader // Saves tracks to the downloads folder; created on first use
+	goPending          bool                  // "g" was pressed on the Queue tab; the next key picks where to jump
+	volumePending      bool                  // "V" was pressed; the next digit picks a volume preset
+	confirmAnswer      func(yes bool) tea.Cmd // Runs when the confirm prompt is answered
+	resumeTrack        models.Track           // Long track whose position is kept for a bookmark; zero when none
+	resumePosition     time.Duration          // Its last position seen
+	windowTitle        string                // Terminal title last set, "" when cleared
+	accentCover        string                // Cover the dynamic accent is (being) taken from, "" for the theme's
+	audioBackend       string                // Backend playing audio: audio.BackendMPV or audio.BackendOto
+	restoredSelection  map[models.Tab]string // Item IDs from the last session, selected once their lists load
+	albumSortInMemory  bool                  // The active album sort was applied in memory rather than by the server
 }
 
 // setupDebugLogging sets up file logging for debug output
-func setupDebugLogging() {
-	homeDir, err := os.UserHomeDir()
+func setupDebugLogging(cfg *config.Config) {
+	if !cfg.Debug.LogToFile {
+		log.SetOutput(io.Discard) // Never write to stderr under the TUI
+		return
+	}
+
+	logFile, err := config.GetLogPath(cfg)
 	if err != nil {
-		return // If we can't get home dir, skip logging
+		return // If we can't resolve a log path, skip logging
 	}
-	
-	tmpDir := filepath.Join(homeDir, "tmp")
-	if err := os.MkdirAll(tmpDir, 0755); err != nil {
-		return // If we can't create tmp dir, skip logging
+
+	if err := os.MkdirAll(filepath.Dir(logFile), 0755); err != nil {
+		return // If we can't create the log dir, skip logging
 	}
-	
-	logFile := filepath.Join(tmpDir, "navitone.log")
-	file, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+
+	// Rotate so the log doesn't grow unbounded across sessions
+	maxSize := int64(cfg.Debug.MaxLogSizeMB) * 1024 * 1024
+	if info, err := os.Stat(logFile); err == nil && maxSize > 0 && info.Size() > maxSize {
+		os.Rename(logFile, logFile+".1")
+	}
+
+	file, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
 	if err != nil {
 		return // If we can't open log file, skip logging
 	}
@@ -54,15 +102,55 @@ func setupDebugLogging() {
 	log.Printf("=== Navitone Debug Session Started ===")
 }
 
-// NewApp creates a new application instance
-func NewApp() *App {
-	// Set up debug logging to ~/tmp/navitone.log
-	setupDebugLogging()
-	// Load configuration
+// Options holds command-line overrides applied on top of the loaded config
+type Options struct {
+	ConfigPath string // Alternate config file
+	ServerURL  string
+	Username   string
+	Password   string
+	NoAudio    bool // Metadata-only mode: browse without starting MPV
+}
+
+// loadConfig loads the config file (falling back to defaults) and applies the
+// environment and command-line overrides
+func loadConfig(opts Options) *config.Config {
+	if opts.ConfigPath != "" {
+		config.SetConfigPath(opts.ConfigPath)
+	}
+
 	cfg, err := config.Load()
 	if err != nil {
 		cfg = config.DefaultConfig()
 	}
+	applyOptions(cfg, opts)
+	return cfg
+}
+
+// applyOptions layers the environment and then the command-line flags over cfg
+func applyOptions(cfg *config.Config, opts Options) {
+	cfg.ApplyEnv()
+	if opts.ServerURL != "" {
+		cfg.Navidrome.ServerURL = opts.ServerURL
+	}
+	if opts.Username != "" {
+		cfg.Navidrome.Username = opts.Username
+	}
+	if opts.Password != "" {
+		cfg.SetExternalPassword(opts.Password)
+	}
+}
+
+// NewApp creates a new application instance
+func NewApp() *App {
+	return NewAppWithOptions(Options{})
+}
+
+// NewAppWithOptions creates a new application instance with command-line overrides.
+// Precedence is flags > environment > config file.
+func NewAppWithOptions(opts Options) *App {
+	cfg := loadConfig(opts)
+	// Set up debug logging (see config.GetLogPath)
+	setupDebugLogging(cfg)
 
 	state := &models.AppState{
 		CurrentTab: models.HomeTab,
@@ -73,9 +161,15 @@ func NewApp() *App {
 		Artists:     make([]models.Artist, 0),
 		Playlists:   make([]models.Playlist, 0),
 		LogMessages: make([]string, 0),
+		SessionStart: time.Now(),
+		ActiveSorts: map[string]string{
+			"albums":    cfg.Behavior.DefaultAlbumSort,
+			"artists":   cfg.Behavior.DefaultArtistSort,
+			"playlists": cfg.Behavior.DefaultPlaylistSort,
+		},
 		
 		// Initialize Home tab state
-		HomeSelectedSection:  0, // Start with Recently Added section
+		HomeSelectedSection:  cfg.UI.HomeSectionOrder()[0], // Start with the first section shown
 		HomeSelectedIndex:    0,
 		RecentlyAddedAlbums:  make([]models.Album, 0),
 		TopArtistsByPlays:    make([]models.Artist, 0),
@@ -87,6 +181,7 @@ func NewApp() *App {
 		CurrentArtwork:      "",
 		LoadingArtwork:      false,
 		ShowArtwork:         cfg.UI.ShowAlbumArt,
+		HideLogArea:         cfg.UI.HideLog,
 	}
 
 
@@ -119,7 +214,8 @@ func NewApp() *App {
     styles := views.NewThemedStyles(theme)
 
     app := &App{
-        state: state,
+        state:     state,
+        lastInput: time.Now(),
         view: &views.MainView{
             // We'll set this up properly
         },
@@ -137,21 +233,40 @@ func NewApp() *App {
         app.scrobbler.AttachNavidromeClient(app.navidromeClient)
     }
 
-    // Detect server scrobbling capability
+    // Show the last-known library immediately; Init refreshes it in the background
+    if app.navidromeClient != nil {
+        app.loadCachedLibrary()
+    }
+    app.loadPlayHistory()
+    app.restoreSession()
+
+    // Detect server scrobbling capability and user permissions
     app.updateServerScrobbleStatus()
+    app.updateServerUserStatus()
 
 	// Initialize audio manager
-	if app.navidromeClient != nil {
-		audioManager, err := audio.NewManager(app.navidromeClient, app.scrobbler)
+	if opts.NoAudio {
+		app.logMessage("Audio disabled (--no-audio) - browsing only")
+	} else if app.navidromeClient != nil {
+		audioManager, backend, err := audio.NewBackend(audio.BackendOptions{
+			Backend:    cfg.Audio.Backend,
+			Device:     cfg.Audio.Device,
+			BufferSize: time.Duration(cfg.Audio.BufferSize) * time.Millisecond,
+			Prebuffer:  cfg.Audio.PrebufferKB * 1024,
+		}, app.navidromeClient, app.scrobbler)
 		if err == nil {
 			app.audioManager = audioManager
-			// Set up callback to update app state when audio changes
-			audioManager.SetStateCallback(app.updateAudioState)
-			// Set up callback for log messages
-			audioManager.SetLogCallback(app.logMessage)
-			// Set initial volume from config
-			audioManager.SetVolume(float64(cfg.Audio.Volume) / 100.0)
-			app.logMessage("Audio manager initialized successfully")
+			app.audioBackend = backend
+			app.hooks = hooks.NewRunner(cfg.Hooks)
+			audioManager.SetTrackHook(app.hooks.TrackStarted)
+			// Long tracks wait for their bookmark lookup before making a sound
+			audioManager.SetStartPaused(func(track models.Track) bool { return resumable(&track) })
+			// Set initial volume from config, kept to 100 on a backend without boost
+			app.setVolume(cfg.Audio.Volume)
+			app.reportAudioBackend(cfg.Audio.Backend, backend)
+			app.checkAudioDevice(cfg.Audio.Device)
+			app.applyEqualizer()
+			app.applySkipSilence()
 		} else {
 			app.logMessage(fmt.Sprintf("Failed to create audio manager: %v", err))
 		}
@@ -159,6 +274,9 @@ func NewApp() *App {
 		app.logMessage("Audio manager not initialized - Navidrome client is nil (check config)")
 	}
 
+	// Open the offline download store (after audio, which plays from it)
+	app.initOfflineStore()
+
 	// Initialize artwork manager
 	artworkManager, err := artwork.NewManager(cfg)
 	if err == nil {
@@ -176,25 +294,69 @@ func NewApp() *App {
 	return app
 }
 
-// updateAudioState updates the app state based on audio manager changes
-func (a *App) updateAudioState(state *models.AppState) {
-	if a.audioManager != nil {
-		// Update queue from audio manager
-		a.state.Queue = a.audioManager.GetQueue()
+// PlaybackStateMsg carries the audio manager's state after a change onto the UI loop
+type PlaybackStateMsg struct {
+	State models.PlaybackState
+}
 
-		// Update current playing track
-		currentTrack := a.audioManager.GetCurrentTrack()
-		a.state.CurrentTrack = currentTrack
+// PlaybackLogMsg carries a log line from the audio manager
+type PlaybackLogMsg struct {
+	Message string
+}
 
-		// Update playing state
-		a.state.IsPlaying = a.audioManager.IsPlaying()
+// listenForPlaybackEvents waits for the next audio manager event; its handler re-arms it
+func listenForPlaybackEvents(events <-chan models.PlaybackEvent) tea.Cmd {
+	return func() tea.Msg {
+		event := <-events
+		if event.State != nil {
+			return PlaybackStateMsg{State: *event.State}
+		}
+		return PlaybackLogMsg{Message: event.Log}
+	}
+}
 
-		// Update shuffle state
-		a.state.IsShuffleMode = a.audioManager.IsShuffleEnabled()
+// handlePlaybackState copies the audio manager's state into the app state and
+// waits for the next event
+func (a *App) handlePlaybackState(msg PlaybackStateMsg) (tea.Model, tea.Cmd) {
+	state := msg.State
+	selected := selectedID(a.state.SelectedQueueIndex, len(a.state.Queue), func(i int) string {
+		return a.state.Queue[i].ID
+	})
+	a.state.Queue = state.Queue
+	a.state.SelectedQueueIndex = reselect(selected, a.state.SelectedQueueIndex, len(a.state.Queue), func(i int) string {
+		return a.state.Queue[i].ID
+	})
+	a.state.CurrentTrack = state.CurrentTrack
+	a.state.IsPlaying = state.IsPlaying
+	a.state.IsShuffleMode = state.IsShuffle
+	a.state.StopAfterCurrent = state.StopAfterCurrent
+	a.state.PlaybackSpeed = state.Speed
+	a.state.Position = state.Position
+	a.state.StreamInfo = state.StreamInfo
+	if a.mprisServer != nil {
+		a.mprisServer.Update(state)
+	}
+	return a, tea.Batch(listenForPlaybackEvents(a.audioManager.Events()), a.watchBookmarks(), a.windowTitleCmd(), a.accentCmd())
+}
 
-		// Update position from audio manager
-		a.state.Position = a.audioManager.GetPosition()
+// windowTitleCmd sets the terminal title to the playing track ("♪ Artist - Title")
+// when it changed, or clears it when nothing plays or config.UI.SetWindowTitle is off
+func (a *App) windowTitleCmd() tea.Cmd {
+	title := ""
+	if track := a.state.CurrentTrack; track != nil && a.state.ConfigForm.Config.UI.SetWindowTitle {
+		// Strip control characters so a tag can't end the escape sequence early
+		title = strings.Map(func(r rune) rune {
+			if r < 0x20 || r == 0x7f {
+				return -1
+			}
+			return r
+		}, fmt.Sprintf("♪ %s - %s", track.Artist, track.Title))
+	}
+	if title == a.windowTitle {
+		return nil
 	}
+	a.windowTitle = title
+	return tea.SetWindowTitle(title)
 }
 
 // logMessage adds a message to the app's log area
@@ -202,47 +364,419 @@ func (a *App) logMessage(message string) {
 	a.state.AddLogMessage(message)
 }
 
+// setLoadingError records a failed library load, sending the user to the Config tab
+// when the failure was the server rejecting our credentials
+func (a *App) setLoadingError(err error) {
+	a.state.LoadingError = err.Error()
+	a.noteRequestError(err)
+	if !navidrome.IsAuthError(err) {
+		return
+	}
+
+	if a.state.AuthError == "" {
+		// Only jump once so retries don't keep pulling the user away
+		a.logMessage(fmt.Sprintf("Server rejected credentials: %v", err))
+		a.state.CurrentTab = models.ConfigTab
+		a.state.ConfigForm.ValidationError = "Server rejected your credentials - update them, then F3 to test and F2 to save"
+	}
+	a.state.AuthError = err.Error()
+}
+
+// insertTracksNext queues tracks to play right after the current track
+func (a *App) insertTracksNext(tracks []models.Track) {
+	if a.audioManager != nil {
+		a.audioManager.InsertTracksNext(tracks)
+		// State will be updated via the audio manager events
+		return
+	}
+
+	// Without audio, place them after the current track if it is queued
+	index := 0
+	if a.state.CurrentTrack != nil {
+		for i, t := range a.state.Queue {
+			if t.ID == a.state.CurrentTrack.ID {
+				index = i + 1
+				break
+			}
+		}
+	}
+	queue := make([]models.Track, 0, len(a.state.Queue)+len(tracks))
+	queue = append(queue, a.state.Queue[:index]...)
+	queue = append(queue, tracks...)
+	a.state.Queue = append(queue, a.state.Queue[index:]...)
+}
+
+// changeSpeed steps the playback speed down ("{"), up ("}") or back to 1.0 ("\\")
+func (a *App) changeSpeed(key string) {
+	if a.audioManager == nil {
+		a.logMessage("Speed control requires MPV")
+		return
+	}
+
+	speed := a.audioManager.GetSpeed()
+	switch key {
+	case "{":
+		speed -= audio.SpeedStep
+	case "}":
+		speed += audio.SpeedStep
+	default:
+		speed = 1.0
+	}
+
+	applied, err := a.audioManager.SetSpeed(speed)
+	if err != nil {
+		a.logMessage(fmt.Sprintf("Speed error: %v", err))
+		return
+	}
+	a.state.PlaybackSpeed = applied
+	a.logMessage(fmt.Sprintf("Playback speed: %.1fx", applied))
+}
+
+// Seek distances for the modified scrub keys (the plain arrows use config.Audio.SeekStepSeconds)
+const (
+	seekJumpSeconds = 60
+	seekFineSeconds = 5
+)
+
+// seekStep returns the configured left/right scrub step in seconds
+func (a *App) seekStep() int {
+	if step := a.state.ConfigForm.Config.Audio.SeekStepSeconds; step > 0 {
+		return step
+	}
+	return 10
+}
+
+// seek moves the playback position by seconds (negative seeks backward)
+func (a *App) seek(seconds int) {
+	if a.audioManager == nil {
+		return
+	}
+	if seconds >= 0 {
+		if err := a.audioManager.SeekForward(seconds); err != nil {
+			a.logMessage(fmt.Sprintf("Seek forward error: %v", err))
+		}
+		return
+	}
+	if err := a.audioManager.SeekBackward(-seconds); err != nil {
+		a.logMessage(fmt.Sprintf("Seek backward error: %v", err))
+	}
+}
+
+// isEditingConfig reports whether keystrokes are going into a config field
+func (a *App) isEditingConfig() bool {
+	return a.state.CurrentTab == models.ConfigTab && a.state.ConfigForm.EditMode
+}
+
+// clampVolume keeps a volume percentage within 0-limit
+func clampVolume(volume, limit int) int {
+	if volume < 0 {
+		return 0
+	}
+	if volume > limit {
+		return limit
+	}
+	return volume
+}
+
+// maxVolume is the highest volume percentage allowed: 100, or config.MaxBoostVolume
+// with audio.allow_boost on the MPV backend
+func (a *App) maxVolume() int {
+	if a.audioBackend != audio.BackendMPV {
+		return 100
+	}
+	return a.state.ConfigForm.Config.Audio.MaxVolume()
+}
+
+// setVolume applies a volume percentage to the audio manager and UI state, warning
+// when it first goes above 100%
+func (a *App) setVolume(volume int) {
+	if volume > 100 && a.maxVolume() == 100 && a.state.Volume == 100 && a.state.ConfigForm.Config.Audio.AllowBoost {
+		a.logMessage("Volume boost needs the mpv backend")
+	}
+	volume = clampVolume(volume, a.maxVolume())
+	if volume > 100 && a.state.Volume <= 100 {
+		a.logMessage(fmt.Sprintf("Volume boosted to %d%%: loud passages may clip", volume))
+	}
+	if a.audioManager != nil {
+		a.audioManager.SetVolume(float64(volume) / 100)
+	}
+	a.state.Volume = volume // Sync UI state
+}
+
+// volumePreset maps the key after "V" to a volume: 1-9 are 10-90%, 0 is 100% and
+// + is the most audio.allow_boost permits
+func (a *App) volumePreset(key string) (int, bool) {
+	switch {
+	case key == "0":
+		return 100, true
+	case key == "+" || key == "=":
+		return a.maxVolume(), true
+	case len(key) == 1 && key[0] >= '1' && key[0] <= '9':
+		return int(key[0]-'0') * 10, true
+	}
+	return 0, false
+}
+
+// adjustVolume changes the volume by delta percent; adjusting while muted unmutes
+// relative to the remembered level
+func (a *App) adjustVolume(delta int) {
+	volume := a.state.Volume
+	if a.state.Muted {
+		a.state.Muted = false
+		volume = a.state.PreMuteVolume
+	}
+	a.setVolume(volume + delta)
+}
+
+// toggleMute silences playback, remembering the level in PreMuteVolume to restore
+// on the next toggle
+func (a *App) toggleMute() {
+	if !a.state.Muted {
+		a.state.PreMuteVolume = a.state.Volume
+		a.state.Muted = true
+		a.setVolume(0)
+		a.logMessage("Muted")
+		return
+	}
+
+	a.state.Muted = false
+	a.setVolume(a.state.PreMuteVolume)
+	a.logMessage(fmt.Sprintf("Unmuted (%d%%)", a.state.Volume))
+}
+
 // cleanup handles graceful shutdown of all resources
 func (a *App) cleanup() tea.Cmd {
-	if a.audioManager != nil {
-		a.audioManager.Close()
+	a.Cleanup()
+	if a.windowTitle != "" {
+		return tea.Sequence(tea.SetWindowTitle(""), tea.Quit)
 	}
 	return tea.Quit
 }
 
-// Cleanup handles graceful shutdown of all resources (public version for external use)
+// Cleanup handles graceful shutdown of all resources (public version for external use).
+// Only the first call does anything, so main can run it after the program exits
+// whether or not quitting already did.
 func (a *App) Cleanup() {
-	if a.audioManager != nil {
-		a.audioManager.Close()
-	}
+	a.cleanupOnce.Do(func() {
+		a.saveSession()
+		a.saveBookmarkOnExit()
+		if a.playerWatcher != nil {
+			a.playerWatcher.Stop()
+		}
+		if a.mprisServer != nil {
+			a.mprisServer.Stop()
+		}
+		if a.audioManager != nil {
+			a.audioManager.Close()
+		}
+	})
 }
 
 // Init implements tea.Model
 func (a *App) Init() tea.Cmd {
-	// Load initial data for the current tab
-	if a.state.CurrentTab == models.HomeTab && a.navidromeClient != nil {
-		return a.loadHomeData()
+	// Load initial data for the current tab and refresh any cached lists
+	cmds := []tea.Cmd{a.refreshCachedLibrary(), sessionTick(), a.startMarquee(), a.checkConnectivity(), a.startSpinner()}
+	if a.navidromeClient != nil {
+		cmds = append(cmds, a.handleTabChange()) // Loads the tab restored from the last session
 	}
-	return nil
+	if a.audioManager != nil {
+		cmds = append(cmds, listenForPlaybackEvents(a.audioManager.Events()))
+	}
+	cmds = append(cmds, a.updateMPRISServer(), a.updatePlayerWatcher())
+	return tea.Batch(cmds...)
+}
+
+// MarqueeTickMsg advances the scroll position of a long selected row
+type MarqueeTickMsg struct{}
+
+// marqueeTick schedules the next marquee step
+func marqueeTick() tea.Cmd {
+	return tea.Tick(300*time.Millisecond, func(time.Time) tea.Msg {
+		return MarqueeTickMsg{}
+	})
+}
+
+// startMarquee starts the marquee tick loop if it is enabled and not already running
+func (a *App) startMarquee() tea.Cmd {
+	if a.marqueeRunning || !a.state.ConfigForm.Config.UI.Marquee {
+		return nil
+	}
+	a.marqueeRunning = true
+	return marqueeTick()
+}
+
+// handleMarqueeTick scrolls the selected row, restarting from the beginning whenever
+// the selection moves; the loop stops once the marquee is disabled in config
+func (a *App) handleMarqueeTick() (tea.Model, tea.Cmd) {
+	if !a.state.ConfigForm.Config.UI.Marquee {
+		a.marqueeRunning = false
+		a.state.MarqueeOffset = 0
+		return a, nil
+	}
+
+	key := fmt.Sprintf("%d:%d:%d:%d:%d", a.state.CurrentTab, a.state.SelectedAlbumIndex,
+		a.state.SelectedArtistIndex, a.state.SelectedPlaylistIndex, a.state.SelectedQueueIndex)
+	if key != a.marqueeKey {
+		a.marqueeKey = key
+		a.state.MarqueeOffset = 0
+	} else {
+		a.state.MarqueeOffset++
+	}
+	return a, marqueeTick()
+}
+
+// SessionTickMsg is sent once per second to advance the session clock
+type SessionTickMsg time.Time
+
+// sessionTick schedules the next session clock tick
+func sessionTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return SessionTickMsg(t)
+	})
+}
+
+// handleSessionTick accumulates listening time while audio is actually playing
+func (a *App) handleSessionTick(msg SessionTickMsg) (tea.Model, tea.Cmd) {
+	now := time.Time(msg)
+	if !a.lastSessionTick.IsZero() && a.state.IsPlaying && a.state.CurrentTrack != nil {
+		a.state.SessionListenTime += now.Sub(a.lastSessionTick)
+	}
+	a.lastSessionTick = now
+	a.checkIdlePause(now)
+	return a, tea.Batch(sessionTick(), a.recordPlayHistory(), a.watchBookmarks(), a.checkAutoRefresh(now))
+}
+
+// checkIdlePause pauses playback once there has been no input for audio.idle_pause_minutes,
+// so an endless queue doesn't stream all night after the listener falls asleep
+func (a *App) checkIdlePause(now time.Time) {
+	minutes := a.state.ConfigForm.Config.Audio.IdlePauseMinutes
+	if minutes <= 0 || !a.state.IsPlaying || a.audioManager == nil || a.lastInput.IsZero() {
+		return
+	}
+	idle := now.Sub(a.lastInput)
+	if idle < time.Duration(minutes)*time.Minute {
+		return
+	}
+
+	a.audioManager.Pause()
+	a.lastInput = now // Don't fire again before the state event reports the pause
+	a.logMessage(fmt.Sprintf("Paused after %d minutes without input - press Space to resume", int(idle.Minutes())))
+}
+
+// SpinnerTickMsg advances the loading spinner
+type SpinnerTickMsg struct{}
+
+// spinnerTick schedules the next spinner frame
+func spinnerTick() tea.Cmd {
+	return tea.Tick(100*time.Millisecond, func(time.Time) tea.Msg {
+		return SpinnerTickMsg{}
+	})
+}
+
+// startSpinner starts the spinner tick loop when something is loading and it isn't running
+func (a *App) startSpinner() tea.Cmd {
+	if a.spinnerRunning || !a.state.IsLoading() {
+		return nil
+	}
+	a.spinnerRunning = true
+	return spinnerTick()
+}
+
+// handleSpinnerTick animates loading placeholders, stopping once nothing is loading
+func (a *App) handleSpinnerTick() (tea.Model, tea.Cmd) {
+	if !a.state.IsLoading() {
+		a.spinnerRunning = false
+		return a, nil
+	}
+	a.state.SpinnerFrame++
+	return a, spinnerTick()
 }
 
-// Update implements tea.Model
+// Update implements tea.Model; any message that starts a load also starts the spinner
 func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	model, cmd := a.update(msg)
+	if spin := a.startSpinner(); spin != nil {
+		cmd = tea.Batch(cmd, spin)
+	}
+	return model, cmd
+}
+
+// update dispatches a message to its handler
+func (a *App) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case SpinnerTickMsg:
+		return a.handleSpinnerTick()
 	case tea.KeyMsg:
+		a.lastInput = time.Now()
 		// Handle modal navigation first
-		if a.state.ShowAlbumModal || a.state.ShowArtistModal || a.state.ShowPlaylistModal || a.state.ShowSearchModal || a.state.ShowSortModal {
+		if a.state.ShowAlbumModal || a.state.ShowArtistModal || a.state.ShowPlaylistModal || a.state.ShowSearchModal || a.state.ShowSortModal || a.state.ShowLogModal || a.state.ShowPlaylistPicker || a.state.ShowNowPlayingModal || a.state.ShowHistoryModal || a.state.ShowDevicePicker || a.state.ShowRandomPicker || a.state.ShowEqualizer || a.state.ShowFolderBrowser || a.state.ShowFitPicker || a.state.ShowPodcasts || a.state.ShowConfirm {
 			return a.handleModalKeyPress(msg)
 		}
 		return a.handleKeyPress(msg)
 	case tea.MouseMsg:
+		a.lastInput = time.Now()
 		return a.handleMouseEvent(msg)
+	case SessionTickMsg:
+		return a.handleSessionTick(msg)
+	case MarqueeTickMsg:
+		return a.handleMarqueeTick()
+	case ConnectivityTickMsg:
+		return a, a.checkConnectivity()
+	case ConnectivityResult:
+		return a.handleConnectivityResult(msg)
+	case OfflineDownloadResult:
+		return a.handleOfflineDownloadResult(msg)
+	case RatingResult:
+		return a.handleRatingResult(msg)
+	case FitToTimeResult:
+		return a.handleFitToTimeResult(msg)
+	case FolderLoadResult:
+		return a.handleFolderLoadResult(msg)
+	case FolderTracksResult:
+		return a.handleFolderTracksResult(msg)
+	case PodcastsLoadResult:
+		return a.handlePodcastsLoadResult(msg)
+	case BookmarkResult:
+		return a.handleBookmarkResult(msg)
+	case BookmarkSaveResult:
+		return a.handleBookmarkSaveResult(msg)
+	case ArtistRadioResult:
+		return a.handleArtistRadioResult(msg)
+	case AccentDebounceMsg:
+		return a.handleAccentDebounce(msg)
+	case AccentResult:
+		return a.handleAccentResult(msg)
+	case DownloadTracksLoadResult:
+		return a.handleDownloadTracksLoaded(msg)
+	case DownloadProgressMsg:
+		return a.handleDownloadProgress(msg)
+	case PlaybackStateMsg:
+		return a.handlePlaybackState(msg)
+	case ShareLinkResult:
+		return a.handleShareLinkResult(msg)
+	case MPRISCommandMsg:
+		return a.handleMPRISCommand(msg)
+	case OtherPlayerStartedMsg:
+		return a.handleOtherPlayerStarted(msg)
+	case PlaybackLogMsg:
+		a.logMessage(msg.Message)
+		return a, listenForPlaybackEvents(a.audioManager.Events())
+	case RandomSessionResult:
+		return a.handleRandomSessionResult(msg)
+	case BatchQueueResult:
+		return a.handleBatchQueueResult(msg)
+	case RandomAlbumPlayResult:
+		return a.handleRandomAlbumPlayResult(msg)
 	case tea.WindowSizeMsg:
 		// Debug: ignore invalid window size messages that might be causing the header to disappear
 		if msg.Width > 0 && msg.Height > 0 {
 			a.view.SetSize(msg.Width, msg.Height)
 		}
 		return a, nil
+	case ScrobblingTestResult:
+		return a.handleScrobblingTestResult(msg)
+	case ConfigCheckResult:
+		return a.handleConfigCheckResult(msg)
 	case ConnectionTestResult:
 		// Handle connection test result
 		cf := a.state.ConfigForm
@@ -250,54 +784,91 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cf.ConnectionStatus = msg.Message
 		// Reinitialize client if connection was successful
         if msg.Success {
-            a.initializeNavidromeClient()
-            if a.scrobbler != nil && a.navidromeClient != nil {
-                a.scrobbler.AttachNavidromeClient(a.navidromeClient)
+            if a.state.AuthError != "" {
+                a.state.AuthError = ""
+                cf.ValidationError = ""
+                a.logMessage("Credentials accepted - press 'r' on a tab to reload")
             }
-            // Refresh server scrobble status after reconnection
-            a.updateServerScrobbleStatus()
+            a.reconnectClient()
+            a.state.ConnectionState = models.ConnectionConnected
         }
         return a, nil
 	case AlbumsLoadResult:
 		// Handle albums load result
 		a.state.LoadingAlbums = false
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
-			// Replace with all albums
+			// Replace with all albums, keeping the selected album selected
+			selected := selectedID(a.state.SelectedAlbumIndex, len(a.state.Albums), func(i int) string {
+				return a.state.Albums[i].ID
+			})
+			if selected == "" {
+				selected = a.takeRestoredSelection(models.AlbumsTab)
+			}
+			index := a.state.SelectedAlbumIndex
 			a.state.Albums = msg.Albums
+			a.clearMarksFor(models.AlbumsTab)
 			a.state.LoadingError = ""
+			resort := a.reapplyAlbumSort()
+			a.state.SelectedAlbumIndex = reselect(selected, index, len(a.state.Albums), func(i int) string {
+				return a.state.Albums[i].ID
+			})
+			return a, tea.Batch(a.cacheLibrary(), resort)
 		}
 		return a, nil
 	case AlbumsSortResult:
 		// Handle albums sort result
 		a.state.LoadingAlbums = false
+		a.clearMarksFor(models.AlbumsTab)
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 			a.logMessage(fmt.Sprintf("Sort failed: %s", msg.Error.Error()))
-		} else if msg.UseInMemorySort {
+			return a, nil
+		}
+		// Keep the selection when re-sorting a reload; a sort from the menu starts at the top
+		selected := ""
+		if msg.KeepSelection {
+			selected = selectedID(a.state.SelectedAlbumIndex, len(a.state.Albums), func(i int) string {
+				return a.state.Albums[i].ID
+			})
+		}
+		if msg.UseInMemorySort {
 			// Fallback to in-memory sorting for unsupported API sorts (like year)
 			a.sortAlbumsInMemory(msg.SortBy)
-			a.logMessage(fmt.Sprintf("Sorted by %s (in-memory)", msg.SortBy))
 		} else {
-			// Use API-sorted results
 			a.state.Albums = msg.Albums
-			a.state.SelectedAlbumIndex = 0
 			a.state.LoadingError = ""
-			a.logMessage(fmt.Sprintf("Sorted by %s", msg.SortBy))
+		}
+		a.state.SelectedAlbumIndex = reselect(selected, 0, len(a.state.Albums), func(i int) string {
+			return a.state.Albums[i].ID
+		})
+		// Remember how the sort was applied, so reloads re-apply it the same way
+		a.state.ActiveSorts["albums"] = msg.SortBy
+		a.albumSortInMemory = msg.UseInMemorySort
+		if !msg.KeepSelection {
+			if msg.UseInMemorySort {
+				a.logMessage(fmt.Sprintf("Sorted by %s (in-memory)", msg.SortBy))
+			} else {
+				a.logMessage(fmt.Sprintf("Sorted by %s", msg.SortBy))
+			}
 		}
 		return a, nil
 	case ArtistsSortResult:
 		// Handle artists sort result  
 		if msg.UseInMemorySort {
+			a.clearMarksFor(models.ArtistsTab)
 			a.sortArtistsInMemory(msg.SortBy)
+			a.state.ActiveSorts["artists"] = msg.SortBy
 			a.logMessage(fmt.Sprintf("Sorted artists by %s", msg.SortBy))
 		}
 		return a, nil
 	case PlaylistsSortResult:
 		// Handle playlists sort result
 		if msg.UseInMemorySort {
+			a.clearMarksFor(models.PlaylistsTab)
 			a.sortPlaylistsInMemory(msg.SortBy) 
+			a.state.ActiveSorts["playlists"] = msg.SortBy
 			a.logMessage(fmt.Sprintf("Sorted playlists by %s", msg.SortBy))
 		}
 		return a, nil
@@ -305,65 +876,123 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle artists load result
 		a.state.LoadingArtists = false
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
+			selected := selectedID(a.state.SelectedArtistIndex, len(a.state.Artists), func(i int) string {
+				return a.state.Artists[i].ID
+			})
+			if selected == "" {
+				selected = a.takeRestoredSelection(models.ArtistsTab)
+			}
+			index := a.state.SelectedArtistIndex
 			a.state.Artists = msg.Artists
+			a.clearMarksFor(models.ArtistsTab)
 			a.state.LoadingError = ""
+			if sortBy := a.state.ActiveSorts["artists"]; sortBy != "" {
+				a.sortArtistsInMemory(sortBy)
+			}
+			a.state.SelectedArtistIndex = reselect(selected, index, len(a.state.Artists), func(i int) string {
+				return a.state.Artists[i].ID
+			})
+			return a, a.cacheLibrary()
+		}
+		return a, nil
+	case ScanStatusResult:
+		// Handle library scan start/poll result
+		return a.handleScanStatusResult(msg)
+	case ScanPollMsg:
+		// Poll library scan progress
+		return a, a.pollScanStatus()
+	case NowPlayingLoadResult:
+		// Handle server now playing result
+		a.state.LoadingNowPlaying = false
+		if msg.Error != nil {
+			a.logMessage(fmt.Sprintf("Failed to load now playing: %v", msg.Error))
+		} else {
+			a.state.NowPlaying = msg.Entries
 		}
 		return a, nil
+	case NowPlayingTickMsg:
+		// Refresh only while the modal is visible
+		if !a.state.ShowNowPlayingModal {
+			return a, nil
+		}
+		return a, tea.Batch(a.loadNowPlaying(), nowPlayingTick())
+	case PlaylistPickerTracksResult:
+		// Album tracks fetched for the playlist picker
+		if msg.Error != nil {
+			a.logMessage(fmt.Sprintf("Failed to load album tracks: %v", msg.Error))
+			return a, nil
+		}
+		return a, a.openPlaylistPicker(msg.Tracks)
+	case PlaylistCreateResult:
+		return a.handlePlaylistCreateResult(msg)
+	case PlaylistAddResult:
+		// Handle add-to-playlist result
+		if msg.Error != nil {
+			a.logMessage(fmt.Sprintf("Failed to add to playlist %s: %v", msg.PlaylistName, msg.Error))
+			return a, nil
+		}
+		for i := range a.state.Playlists {
+			if a.state.Playlists[i].ID == msg.PlaylistID {
+				a.state.Playlists[i].SongCount += msg.Added
+				a.state.Playlists[i].Duration += msg.Duration
+				break
+			}
+		}
+		a.logMessage(fmt.Sprintf("Added %d tracks to playlist %s", msg.Added, msg.PlaylistName))
+		return a, nil
 	case PlaylistsLoadResult:
 		// Handle playlists load result
 		a.state.LoadingPlaylists = false
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
+			selected := selectedID(a.state.SelectedPlaylistIndex, len(a.state.Playlists), func(i int) string {
+				return a.state.Playlists[i].ID
+			})
+			if selected == "" {
+				selected = a.takeRestoredSelection(models.PlaylistsTab)
+			}
 			a.state.Playlists = msg.Playlists
+			a.clearMarksFor(models.PlaylistsTab)
 			a.state.LoadingError = ""
+			if sortBy := a.state.ActiveSorts["playlists"]; sortBy != "" {
+				a.sortPlaylistsInMemory(sortBy)
+			}
+			a.state.SelectedPlaylistIndex = reselect(selected, a.state.SelectedPlaylistIndex, len(a.state.Playlists), func(i int) string {
+				return a.state.Playlists[i].ID
+			})
+			return a, a.cacheLibrary()
 		}
 		return a, nil
 	case AlbumTracksLoadResult:
 		// Handle album tracks load result and add to queue
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
-			// Add all tracks to queue
-			if a.audioManager != nil {
-				a.audioManager.AddTracksToQueue(msg.Tracks)
-				// State will be updated via the audio manager callback
-				a.logMessage(fmt.Sprintf("Added album to queue (%d tracks)", len(msg.Tracks)))
-			} else {
-				a.state.Queue = append(a.state.Queue, msg.Tracks...)
-				a.logMessage(fmt.Sprintf("Added album to queue (%d tracks, total: %d)", len(msg.Tracks), len(a.state.Queue)))
-			}
+			a.placeTracks(msg.Tracks, msg.Placement, "album")
 			a.state.LoadingError = ""
 		}
 		return a, nil
 	case PlaylistTracksQueueResult:
 		// Handle playlist tracks load result and add to queue
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
-			// Add all tracks to queue
-			if a.audioManager != nil {
-				a.audioManager.AddTracksToQueue(msg.Tracks)
-				// State will be updated via the audio manager callback
-				a.logMessage(fmt.Sprintf("Added playlist to queue (%d tracks)", len(msg.Tracks)))
-			} else {
-				a.state.Queue = append(a.state.Queue, msg.Tracks...)
-				a.logMessage(fmt.Sprintf("Added playlist to queue (%d tracks, total: %d)", len(msg.Tracks), len(a.state.Queue)))
-			}
+			a.placeTracks(msg.Tracks, msg.Placement, "playlist")
 			a.state.LoadingError = ""
 		}
 		return a, nil
 	case ArtistTracksLoadResult:
 		// Handle artist tracks load result and add to queue
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
 			// Add all tracks to queue
 			if a.audioManager != nil {
 				a.audioManager.AddTracksToQueue(msg.Tracks)
-				// State will be updated via the audio manager callback
+				// State will be updated via the audio manager events
 				a.logMessage(fmt.Sprintf("Added artist tracks to queue (%d tracks)", len(msg.Tracks)))
 			} else {
 				a.state.Queue = append(a.state.Queue, msg.Tracks...)
@@ -376,7 +1005,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle album tracks load for modal display
 		a.state.LoadingModalContent = false
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
 			a.state.AlbumTracks = msg.Tracks
 			a.state.SelectedModalIndex = 0
@@ -387,13 +1016,15 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle home data load result
 		a.state.LoadingHomeData = false
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
 			a.state.RecentlyAddedAlbums = msg.RecentlyAdded
 			a.state.TopArtistsByPlays = msg.TopArtists
 			a.state.MostPlayedAlbums = msg.MostPlayed
 			a.state.TopTracks = msg.TopTracks
+			a.state.RecentlyPlayedAlbums = msg.RecentlyPlayed
 			a.state.LoadingError = ""
+			a.clampHomeSelection()
 			a.logMessage("Home tab data loaded successfully")
 		}
 		return a, nil
@@ -401,10 +1032,13 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle artist albums load for modal display
 		a.state.LoadingModalContent = false
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
 			a.state.ArtistAlbums = msg.Albums
 			a.state.SelectedModalIndex = 0
+			if a.state.SelectedArtist != nil && a.state.SelectedArtist.AlbumCount == 0 {
+				a.state.SelectedArtist.AlbumCount = len(msg.Albums) // Artist built from track metadata
+			}
 			a.state.LoadingError = ""
 		}
 		return a, nil
@@ -412,38 +1046,75 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle playlist tracks load for modal display
 		a.state.LoadingModalContent = false
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
 			a.state.PlaylistTracks = msg.Tracks
 			a.state.SelectedModalIndex = 0
 			a.state.LoadingError = ""
 		}
 		return a, nil
+	case SearchDebounceMsg:
+		// Only search if no newer keystroke arrived during the debounce window
+		if msg.Seq != a.searchSeq || !a.state.ShowSearchModal {
+			return a, nil
+		}
+		return a, a.runSearch(msg.Seq, msg.Query)
 	case SearchResult:
+		// Ignore results for queries that have since changed
+		if msg.Seq != a.searchSeq {
+			return a, nil
+		}
 		// Handle search result
 		a.state.LoadingSearchResults = false
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
 			a.state.SearchResults = msg.Results
+			if a.state.FuzzyEnabled() {
+				query := a.state.SearchQuery
+				a.state.SearchResults.Artists = models.RankArtists(query, msg.Results.Artists)
+				a.state.SearchResults.Albums = models.RankAlbums(query, msg.Results.Albums)
+				a.state.SearchResults.Tracks = models.RankTracks(query, msg.Results.Tracks)
+			}
 			a.state.SelectedSearchIndex = 0
+			a.state.SearchArtistsOffset = len(msg.Results.Artists)
+			a.state.SearchAlbumsOffset = len(msg.Results.Albums)
+			a.state.SearchTracksOffset = len(msg.Results.Tracks)
 			a.state.LoadingError = ""
 		}
 		return a, nil
 	case SearchMoreResult:
-		// Handle search more result
+		// Ignore pages for queries that have since changed
+		if msg.Seq != a.searchSeq {
+			return a, nil
+		}
 		a.state.LoadingSearchResults = false
 		if msg.Error != nil {
-			a.state.LoadingError = msg.Error.Error()
+			a.setLoadingError(msg.Error)
 		} else {
-			// Append new results to existing ones
+			// Append new results to existing ones and advance the section offset.
+			// With fuzzy search only the new page is ranked, so rows already on
+			// screen (and the selection) stay where they are.
+			full := models.SearchPageSize
+			if a.state.FuzzyEnabled() {
+				query := a.state.SearchQuery
+				msg.Artists = models.RankArtists(query, msg.Artists)
+				msg.Albums = models.RankAlbums(query, msg.Albums)
+				msg.Tracks = models.RankTracks(query, msg.Tracks)
+			}
 			switch msg.Section {
 			case "artists":
 				a.state.SearchResults.Artists = append(a.state.SearchResults.Artists, msg.Artists...)
+				a.state.SearchArtistsOffset += len(msg.Artists)
+				a.state.SearchResults.MoreArtists = len(msg.Artists) == full
 			case "albums":
 				a.state.SearchResults.Albums = append(a.state.SearchResults.Albums, msg.Albums...)
+				a.state.SearchAlbumsOffset += len(msg.Albums)
+				a.state.SearchResults.MoreAlbums = len(msg.Albums) == full
 			case "tracks":
 				a.state.SearchResults.Tracks = append(a.state.SearchResults.Tracks, msg.Tracks...)
+				a.state.SearchTracksOffset += len(msg.Tracks)
+				a.state.SearchResults.MoreTracks = len(msg.Tracks) == full
 			}
 			a.state.LoadingError = ""
 		}
@@ -460,6 +1131,16 @@ func (a *App) View() string {
 
 // handleKeyPress processes keyboard input
 func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if a.volumePending {
+		// Second key of V<digit>: a volume preset; any other key is handled as usual
+		a.volumePending = false
+		if volume, ok := a.volumePreset(msg.String()); ok {
+			a.state.Muted = false
+			a.setVolume(volume)
+			return a, nil
+		}
+	}
+
 	// Handle global player controls FIRST (before tab-specific handlers)
 	switch msg.String() {
 	case " ":
@@ -492,8 +1173,78 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 		}
 		return a, nil
+	case "y":
+		// Global: y - Copy the playing track's info (typed normally while editing config)
+		if a.isEditingConfig() {
+			break
+		}
+		a.copyTrackInfo()
+		return a, nil
+	case "Y":
+		// Global: Y - Copy a Navidrome share link for the playing track
+		if a.isEditingConfig() {
+			break
+		}
+		return a, a.shareCurrentTrack()
+	case ".":
+		// Global: . - Toggle stop after current track (typed normally while editing config)
+		if a.isEditingConfig() {
+			break
+		}
+		enabled := !a.state.StopAfterCurrent
+		if a.audioManager != nil {
+			a.audioManager.SetStopAfterCurrent(enabled)
+		}
+		a.state.StopAfterCurrent = enabled
+		if enabled {
+			a.logMessage("Will stop after current track")
+		} else {
+			a.logMessage("Stop after current track cancelled")
+		}
+		return a, nil
+	case "alt+S", "alt+shift+s":
+		// Global: Alt+Shift+S - Reshuffle the queue without toggling shuffle off/on
+		if a.audioManager != nil {
+			if err := a.audioManager.ReshuffleQueue(); err != nil {
+				a.logMessage(fmt.Sprintf("Reshuffle: %v", err))
+			}
+			a.state.SelectedQueueIndex = 0
+		}
+		return a, nil
+	case "ctrl+z":
+		// Global: Ctrl+Z - Undo the last clear, replace or trim of the queue
+		a.undoQueueChange()
+		return a, nil
+	case "alt+r":
+		// Global: Alt+R - Refresh albums, artists, playlists and home (Ctrl+R plays a random album)
+		a.invalidateLibraryCache()
+		a.logMessage("Refreshing library")
+		return a, a.refreshAll()
+	case "alt+R", "alt+shift+r":
+		// Global: Alt+Shift+R - Start a random session from the whole library
+		a.state.ShowRandomPicker = true
+		a.state.SelectedRandomIndex = 0
+		return a, nil
+	case "alt+t":
+		// Global: Alt+T - Queue tracks filling a set number of minutes
+		a.openFitPicker()
+		return a, nil
+	case "alt+f":
+		// Global: Alt+F - Browse the library by folder
+		return a, a.openFolderBrowser()
+	case "alt+p":
+		// Global: Alt+P - Browse and play podcasts
+		return a, a.openPodcasts()
+	case "alt+e":
+		// Global: Alt+E - Equalizer presets (MPV backend)
+		a.openEqualizer()
+		return a, nil
 	case "alt+s":
-		// Global: Alt+S - Toggle shuffle
+		// Global: Alt+S - Toggle shuffle (not while a podcast episode plays)
+		if !a.state.IsShuffleMode && a.state.CurrentTrack != nil && a.state.CurrentTrack.IsPodcast {
+			a.logMessage("Shuffle stays off while a podcast episode plays")
+			return a, nil
+		}
 		if a.audioManager != nil {
 			a.audioManager.ToggleShuffle()
 			// Let normal Bubble Tea update cycle handle state sync to prevent race conditions
@@ -503,46 +1254,58 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return a, nil
 	case "right":
-		// Global: Right arrow - Seek forward (scrub)
-		if a.audioManager != nil {
-			err := a.audioManager.SeekForward(10) // 10 seconds forward
-			if err != nil {
-				a.logMessage(fmt.Sprintf("Seek forward error: %v", err))
-			}
-		}
+		// Global: Right arrow - Seek forward by the configured step (scrub)
+		a.seek(a.seekStep())
 		return a, nil
 	case "left":
-		// Global: Left arrow - Seek backward (scrub)
-		if a.audioManager != nil {
-			err := a.audioManager.SeekBackward(10) // 10 seconds backward
-			if err != nil {
-				a.logMessage(fmt.Sprintf("Seek backward error: %v", err))
-			}
-		}
+		// Global: Left arrow - Seek backward by the configured step (scrub)
+		a.seek(-a.seekStep())
+		return a, nil
+	case "ctrl+right":
+		// Global: Ctrl+Right - Jump forward
+		a.seek(seekJumpSeconds)
+		return a, nil
+	case "ctrl+left":
+		// Global: Ctrl+Left - Jump backward
+		a.seek(-seekJumpSeconds)
+		return a, nil
+	case "shift+right":
+		// Global: Shift+Right - Fine seek forward
+		a.seek(seekFineSeconds)
+		return a, nil
+	case "shift+left":
+		// Global: Shift+Left - Fine seek backward
+		a.seek(-seekFineSeconds)
 		return a, nil
 	case "shift+up":
-		// Global: Volume up
-		if a.audioManager != nil {
-			currentVolume := a.audioManager.GetVolume()
-			newVolume := currentVolume + 0.05 // Increase by 5%
-			if newVolume > 1.0 {
-				newVolume = 1.0
-			}
-			a.audioManager.SetVolume(newVolume)
-			a.state.Volume = int(newVolume * 100) // Sync UI state
-		}
+		// Global: Volume up (coarse)
+		a.adjustVolume(5)
 		return a, nil
 	case "shift+down":
-		// Global: Volume down
-		if a.audioManager != nil {
-			currentVolume := a.audioManager.GetVolume()
-			newVolume := currentVolume - 0.05 // Decrease by 5%
-			if newVolume < 0.0 {
-				newVolume = 0.0
-			}
-			a.audioManager.SetVolume(newVolume)
-			a.state.Volume = int(newVolume * 100) // Sync UI state
+		// Global: Volume down (coarse)
+		a.adjustVolume(-5)
+		return a, nil
+	case "ctrl+shift+up":
+		// Global: Volume up (fine)
+		a.adjustVolume(1)
+		return a, nil
+	case "ctrl+shift+down":
+		// Global: Volume down (fine)
+		a.adjustVolume(-1)
+		return a, nil
+	case "V", "shift+v":
+		// Global: V then 0-9 - Volume preset (V5 = 50%, V0 = 100%, V+ = boost limit)
+		if a.isEditingConfig() {
+			break
 		}
+		a.volumePending = true
+		return a, nil
+	case "m":
+		// Global: m - Mute/unmute (typed normally while editing config)
+		if a.isEditingConfig() {
+			break
+		}
+		a.toggleMute()
 		return a, nil
 	case "shift+f", "F":
 		// Global: Shift+F - Open search modal
@@ -573,6 +1336,40 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 		}
 		return a, nil
+	case "alt+l":
+		// Global: Alt+L - Open log history
+		a.state.ShowLogModal = true
+		a.state.LogFilter = ""
+		a.state.EditingLogFilter = false
+		a.state.SelectedLogIndex = len(a.state.LogMessages) - 1
+		if a.state.SelectedLogIndex < 0 {
+			a.state.SelectedLogIndex = 0
+		}
+		return a, nil
+	case "alt+n":
+		// Global: Alt+N - Show what everyone on the server is playing
+		a.state.ShowNowPlayingModal = true
+		return a, tea.Batch(a.loadNowPlaying(), nowPlayingTick())
+	case "{", "}", "\\":
+		// Global: { / } - Slower / faster playback, \ - Reset to normal speed
+		if a.isEditingConfig() {
+			break
+		}
+		a.changeSpeed(msg.String())
+		return a, nil
+	case "alt+0":
+		// Global: Alt+0 - Show/hide the log area to reclaim vertical space
+		a.state.HideLogArea = !a.state.HideLogArea
+		return a, nil
+	case "alt+m":
+		// Global: Alt+M - Toggle minimal mode (single-line player, no footer, log or borders)
+		a.state.MinimalMode = !a.state.MinimalMode
+		return a, nil
+	case "alt+h":
+		// Global: Alt+H - Show recently played tracks
+		a.state.ShowHistoryModal = true
+		a.state.SelectedHistoryIndex = 0
+		return a, nil
 	case "shift+c", "C":
 		// Global: Shift+C - Launch Cava audio visualizer in new terminal
 		if err := utils.LaunchCavaInTerminal(); err != nil {
@@ -666,6 +1463,8 @@ func (a *App) handleConfigNavigationMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "enter":
 		if cf.IsCheckboxField(cf.ActiveField) {
 			cf.ToggleCheckbox(cf.ActiveField)
+		} else if cf.ActiveField == models.AudioDeviceField && a.openDevicePicker() {
+			// Picked from the backend's device list instead of typed
 		} else {
 			cf.EditMode = true
 			cf.CurrentInput = a.getEditableValue(cf.ActiveField)
@@ -673,9 +1472,73 @@ func (a *App) handleConfigNavigationMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "f2":
 		return a.saveConfig()
 	case "f3":
+		if cf.IsScrobblingField(cf.ActiveField) {
+			return a.testScrobbling()
+		}
 		return a.testConnection()
+	case "f4":
+		return a.storePasswordInKeyring()
+	case "f5":
+		return a.convertPasswordToToken()
+	case "f6":
+		return a.startLibraryScan()
+	case "f7":
+		return a.checkConfig()
+	}
+
+	return a, nil
+}
+
+// convertPasswordToToken replaces the stored password with a Subsonic token+salt
+func (a *App) convertPasswordToToken() (tea.Model, tea.Cmd) {
+	cf := a.state.ConfigForm
+
+	if cf.Config.Navidrome.Password == "" {
+		cf.ValidationError = "Enter a password before converting it to a token"
+		return a, nil
+	}
+
+	token, salt, err := navidrome.GenerateToken(cf.Config.Navidrome.Password)
+	if err != nil {
+		cf.ValidationError = "Failed to generate token: " + err.Error()
+		return a, nil
+	}
+
+	cf.Config.Navidrome.Token = token
+	cf.Config.Navidrome.Salt = salt
+	cf.Config.Navidrome.Password = ""
+
+	if err := config.Save(cf.Config); err != nil {
+		cf.ValidationError = "Failed to save config: " + err.Error()
+		return a, nil
 	}
 
+	// Reconnect using the token
+	a.initializeNavidromeClient()
+	if a.scrobbler != nil && a.navidromeClient != nil {
+		a.scrobbler.AttachNavidromeClient(a.navidromeClient)
+	}
+
+	cf.ValidationError = ""
+	cf.ConnectionStatus = "Password converted to token; raw password removed from config"
+	return a, nil
+}
+
+// storePasswordInKeyring moves the Navidrome password out of the config file into the OS keyring
+func (a *App) storePasswordInKeyring() (tea.Model, tea.Cmd) {
+	cf := a.state.ConfigForm
+
+	if err := cf.Config.StorePasswordInKeyring(); err != nil {
+		if errors.Is(err, config.ErrKeyringUnavailable) {
+			cf.ValidationError = "No keyring available - use NAVITONE_PASSWORD instead"
+		} else {
+			cf.ValidationError = "Failed to store password: " + err.Error()
+		}
+		return a, nil
+	}
+
+	cf.ValidationError = ""
+	cf.ConnectionStatus = "Password stored in keyring and removed from config file"
 	return a, nil
 }
 
@@ -688,17 +1551,25 @@ func (a *App) handleConfigEditMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Validate and convert numeric fields using tagged switch
 		switch cf.ActiveField {
 		case models.VolumeField:
-			if vol, err := strconv.Atoi(cf.CurrentInput); err == nil && vol >= 0 && vol <= 100 {
+			if vol, err := strconv.Atoi(cf.CurrentInput); err == nil && vol >= 0 && vol <= cf.Config.Audio.MaxVolume() {
 				cf.Config.Audio.Volume = vol
 			} else {
-				cf.ValidationError = "Volume must be a number between 0 and 100"
+				cf.ValidationError = fmt.Sprintf("Volume must be a number between 0 and %d", cf.Config.Audio.MaxVolume())
 				return a, nil
 			}
 		case models.BufferSizeField:
-			if size, err := strconv.Atoi(cf.CurrentInput); err == nil && size > 0 {
+			if size, err := strconv.Atoi(cf.CurrentInput); err == nil && size >= config.MinBufferSizeMs && size <= config.MaxBufferSizeMs {
 				cf.Config.Audio.BufferSize = size
 			} else {
-				cf.ValidationError = "Buffer size must be a positive number"
+				cf.ValidationError = fmt.Sprintf("Buffer size must be between %d and %d ms", config.MinBufferSizeMs, config.MaxBufferSizeMs)
+				return a, nil
+			}
+		case models.HomeRecentCountField, models.HomeTopArtistsCountField,
+			models.HomeMostPlayedCountField, models.HomeTopTracksCountField, models.HomeRecentlyPlayedCountField:
+			if count, err := strconv.Atoi(cf.CurrentInput); err == nil && count >= 1 && count <= config.MaxHomeSectionCount {
+				*cf.HomeCountTarget(cf.ActiveField) = count
+			} else {
+				cf.ValidationError = fmt.Sprintf("Home section count must be a number between 1 and %d", config.MaxHomeSectionCount)
 				return a, nil
 			}
 		default:
@@ -762,6 +1633,9 @@ func (a *App) getEditableValue(field models.ConfigFormField) string {
 		return cf.Config.Audio.Device
 	case models.BufferSizeField:
 		return fmt.Sprintf("%d", cf.Config.Audio.BufferSize)
+	case models.HomeRecentCountField, models.HomeTopArtistsCountField,
+		models.HomeMostPlayedCountField, models.HomeTopTracksCountField, models.HomeRecentlyPlayedCountField:
+		return fmt.Sprintf("%d", *cf.HomeCountTarget(field))
 	default:
 		return ""
 	}
@@ -781,6 +1655,25 @@ func (a *App) saveConfig() (tea.Model, tea.Cmd) {
 		return a, nil
 	}
 
+	// Apply scrobbling changes without requiring a restart
+	if a.scrobbler != nil {
+		a.scrobbler.Reconfigure(cf.Config)
+		if a.navidromeClient != nil {
+			a.scrobbler.AttachNavidromeClient(a.navidromeClient)
+		}
+	}
+
+	// Apply changed track change hooks
+	if a.hooks != nil {
+		a.hooks.Reconfigure(cf.Config.Hooks)
+	}
+
+	// Apply a changed offline cache cap
+	if a.offlineStore != nil {
+		a.offlineStore.SetMaxBytes(int64(cf.Config.Cache.OfflineMaxMB) * 1024 * 1024)
+		a.refreshCachedTrackIDs()
+	}
+
 	// Update artwork manager config and display state
 	if a.artworkManager != nil {
 		a.artworkManager.UpdateConfig(cf.Config)
@@ -789,7 +1682,7 @@ func (a *App) saveConfig() (tea.Model, tea.Cmd) {
 
 	cf.ValidationError = ""
 	cf.ConnectionStatus = "Configuration saved successfully!"
-	return a, nil
+	return a, tea.Batch(a.startMarquee(), a.updateMPRISServer(), a.updatePlayerWatcher(), a.windowTitleCmd())
 }
 
 // testConnection tests the Navidrome connection
@@ -804,6 +1697,48 @@ func (a *App) testConnection() (tea.Model, tea.Cmd) {
 	})
 }
 
+// ScrobblingTestResult carries the per-service outcome of a scrobbling credentials test
+type ScrobblingTestResult struct {
+	Statuses []scrobbling.ServiceStatus
+}
+
+// testScrobbling checks the credentials of the client-side scrobblers entered in the form
+func (a *App) testScrobbling() (tea.Model, tea.Cmd) {
+	cf := a.state.ConfigForm
+	sc := cf.Config.Scrobbling
+	if !sc.LastFM.Enabled && !sc.ListenBrainz.Enabled && !sc.Custom.Enabled {
+		cf.ConnectionStatus = "ℹ Enable Last.fm or ListenBrainz to test scrobbling credentials"
+		return a, nil
+	}
+
+	cf.TestingConnection = true
+	cf.ConnectionStatus = "Testing scrobbling credentials..."
+	scrobbler := a.scrobbler
+	return a, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		return ScrobblingTestResult{Statuses: scrobbler.TestConnections(ctx)}
+	}
+}
+
+// handleScrobblingTestResult shows one status line per service in the Config tab
+func (a *App) handleScrobblingTestResult(msg ScrobblingTestResult) (tea.Model, tea.Cmd) {
+	cf := a.state.ConfigForm
+	cf.TestingConnection = false
+
+	lines := make([]string, len(msg.Statuses))
+	for i, status := range msg.Statuses {
+		icon := "✅"
+		if !status.OK {
+			icon = "❌"
+		}
+		lines[i] = fmt.Sprintf("%s %s: %s", icon, status.Service, status.Message)
+		a.logMessage(fmt.Sprintf("Scrobbling test - %s: %s", status.Service, status.Message))
+	}
+	cf.ConnectionStatus = strings.Join(lines, "\n")
+	return a, nil
+}
+
 // ConnectionTestResult represents the result of a connection test
 type ConnectionTestResult struct {
 	Success bool
@@ -829,7 +1764,7 @@ func (a *App) doConnectionTest() ConnectionTestResult {
 		}
 	}
 
-	if cf.Config.Navidrome.Password == "" {
+	if !cf.Config.HasCredentials() {
 		return ConnectionTestResult{
 			Success: false,
 			Message: "❌ Password is required",
@@ -837,17 +1772,10 @@ func (a *App) doConnectionTest() ConnectionTestResult {
 	}
 
 	// Create Navidrome client
-	client := navidrome.NewClient(
-		cf.Config.Navidrome.ServerURL,
-		cf.Config.Navidrome.Username,
-		cf.Config.Navidrome.Password,
-	)
-
-	// Set timeout from config
-	client.SetTimeout(time.Duration(cf.Config.Navidrome.Timeout) * time.Second)
+	client := newNavidromeClient(cf.Config)
 
 	// Test connection with ping
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), cf.Config.Timeouts.PingTimeout())
 	defer cancel()
 
 	if err := client.Ping(ctx); err != nil {
@@ -863,18 +1791,41 @@ func (a *App) doConnectionTest() ConnectionTestResult {
 	}
 }
 
+// reconnectClient rebuilds the Navidrome client after the server answered a ping
+// (a connection test or recovery from offline mode) and reattaches what uses it
+func (a *App) reconnectClient() {
+	a.initializeNavidromeClient()
+	a.state.PodcastsUnavailable = false // Check again, the server may have changed
+	if a.scrobbler != nil && a.navidromeClient != nil {
+		a.scrobbler.AttachNavidromeClient(a.navidromeClient)
+	}
+	// Refresh server scrobble status after reconnection
+	a.updateServerScrobbleStatus()
+	a.updateServerUserStatus()
+}
+
 // initializeNavidromeClient sets up the Navidrome client if config is valid
 func (a *App) initializeNavidromeClient() {
 	cfg := a.state.ConfigForm.Config
 
-	if cfg.Navidrome.ServerURL != "" && cfg.Navidrome.Username != "" && cfg.Navidrome.Password != "" {
-		a.navidromeClient = navidrome.NewClient(
-			cfg.Navidrome.ServerURL,
-			cfg.Navidrome.Username,
-			cfg.Navidrome.Password,
-		)
-		a.navidromeClient.SetTimeout(time.Duration(cfg.Navidrome.Timeout) * time.Second)
+	if cfg.Navidrome.ServerURL != "" && cfg.Navidrome.Username != "" && cfg.HasCredentials() {
+		a.navidromeClient = newNavidromeClient(cfg)
+		a.state.ConnectionState = models.ConnectionConnecting
+	} else if a.navidromeClient == nil {
+		a.state.ConnectionState = models.ConnectionDisconnected
+	}
+}
+
+// newNavidromeClient creates a client from config, preferring the password over a stored token
+func newNavidromeClient(cfg *config.Config) *navidrome.Client {
+	var client *navidrome.Client
+	if cfg.Navidrome.Password != "" {
+		client = navidrome.NewClient(cfg.Navidrome.ServerURL, cfg.Navidrome.Username, cfg.Navidrome.Password)
+	} else {
+		client = navidrome.NewClientWithToken(cfg.Navidrome.ServerURL, cfg.Navidrome.Username, cfg.Navidrome.Token, cfg.Navidrome.Salt)
 	}
+	client.SetTimeout(cfg.ClientTimeout())
+	return client
 }
 
 // updateServerScrobbleStatus checks Navidrome for server-side scrobbling status
@@ -883,7 +1834,7 @@ func (a *App) updateServerScrobbleStatus() {
         return
     }
 
-    ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+    ctx, cancel := context.WithTimeout(context.Background(), a.state.ConfigForm.Config.Timeouts.MetadataTimeout())
     defer cancel()
 
     caps, err := a.navidromeClient.GetScrobblingCapabilities(ctx)
@@ -897,8 +1848,124 @@ func (a *App) updateServerScrobbleStatus() {
     a.state.ConfigForm.ServerScrobblingEnabled = caps.UserScrobblingEnabled
 }
 
+// updateServerUserStatus records whether the user may trigger library scans and
+// their server-side transcode bitrate cap
+func (a *App) updateServerUserStatus() {
+    if a.navidromeClient == nil || a.state == nil || a.state.ConfigForm == nil {
+        return
+    }
+
+    ctx, cancel := context.WithTimeout(context.Background(), a.state.ConfigForm.Config.Timeouts.MetadataTimeout())
+    defer cancel()
+
+    userResp, err := a.navidromeClient.GetUser(ctx, a.state.ConfigForm.Config.Navidrome.Username)
+    if err != nil {
+        a.state.ConfigForm.ServerAdmin = false
+        return
+    }
+    a.state.ConfigForm.ServerAdmin = userResp.SubsonicResponse.User.AdminRole
+    a.state.ServerMaxBitRate = userResp.SubsonicResponse.User.MaxBitRate
+}
+
+// ScanStatusResult represents the result of starting or polling a library scan
+type ScanStatusResult struct {
+	Status  *navidrome.ScanStatus
+	Started bool
+	Error   error
+}
+
+// ScanPollMsg triggers the next library scan status poll
+type ScanPollMsg struct{}
+
+// scanPollTick schedules the next scan status poll
+func scanPollTick() tea.Cmd {
+	return tea.Tick(2*time.Second, func(time.Time) tea.Msg {
+		return ScanPollMsg{}
+	})
+}
+
+// startLibraryScan kicks off a server-side library scan (admin only)
+func (a *App) startLibraryScan() (tea.Model, tea.Cmd) {
+	cf := a.state.ConfigForm
+
+	if a.navidromeClient == nil {
+		cf.ValidationError = "Connect to a server before starting a scan"
+		return a, nil
+	}
+	if !cf.ServerAdmin {
+		cf.ValidationError = "Library scans require an admin account"
+		return a, nil
+	}
+	if cf.Scanning {
+		return a, nil
+	}
+
+	cf.ValidationError = ""
+	cf.Scanning = true
+	cf.ScanCount = 0
+	a.logMessage("Starting library scan...")
+
+	client := a.navidromeClient
+	timeout := a.state.ConfigForm.Config.Timeouts.MetadataTimeout()
+	return a, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		status, err := client.StartScan(ctx)
+		return ScanStatusResult{Status: status, Started: true, Error: err}
+	}
+}
+
+// pollScanStatus fetches the progress of the running library scan
+func (a *App) pollScanStatus() tea.Cmd {
+	if a.navidromeClient == nil {
+		return nil
+	}
+
+	client := a.navidromeClient
+	timeout := a.state.ConfigForm.Config.Timeouts.MetadataTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		status, err := client.GetScanStatus(ctx)
+		return ScanStatusResult{Status: status, Error: err}
+	}
+}
+
+// handleScanStatusResult updates scan progress and refreshes the library once the scan finishes
+func (a *App) handleScanStatusResult(msg ScanStatusResult) (tea.Model, tea.Cmd) {
+	cf := a.state.ConfigForm
+
+	if msg.Error != nil {
+		cf.Scanning = false
+		if navidrome.IsAPIError(msg.Error, navidrome.ErrorCodeNotAuthorized) {
+			a.logMessage("Library scan failed: starting a scan requires a Navidrome admin account")
+		} else {
+			a.logMessage(fmt.Sprintf("Library scan failed: %v", msg.Error))
+		}
+		return a, nil
+	}
+
+	cf.ScanCount = msg.Status.Count
+	// startScan may report scanning=false before the server has picked the scan up
+	if msg.Status.Scanning || msg.Started {
+		return a, scanPollTick()
+	}
+
+	cf.Scanning = false
+	a.logMessage(fmt.Sprintf("Library scan complete: %d items", msg.Status.Count))
+
+	// Reload albums and artists so new content shows up
+	a.state.Albums = nil
+	a.state.Artists = nil
+	return a, tea.Batch(a.loadAlbums(), a.loadArtists())
+}
+
 // handleTabChange handles actions when switching tabs
 func (a *App) handleTabChange() tea.Cmd {
+	a.clearMarks()
+
     // Load data when entering certain tabs
     switch a.state.CurrentTab {
 	case models.HomeTab:
@@ -927,8 +1994,9 @@ func (a *App) handleTabChange() tea.Cmd {
             return a.loadPlaylists()
         }
     case models.ConfigTab:
-        // Refresh server scrobbling status on entering Config tab
+        // Refresh server scrobbling status and user permissions on entering Config tab
         a.updateServerScrobbleStatus()
+        a.updateServerUserStatus()
     }
     return nil
 }
@@ -956,12 +2024,9 @@ func (a *App) handleHomeKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "pgdown":
 		// Jump to next section or move down significantly within current section
 		a.moveHomeSelectionPageDown()
-	case "enter":
-		// Handle selection based on current section
-		return a.handleHomeSelection(false) // false = play and queue
-	case "shift+enter":
-		// Handle selection with queue only
-		return a.handleHomeSelection(true) // true = queue only
+	case "enter", "shift+enter":
+		// Play and queue remaining, or queue only, as set by behavior.enter_action
+		return a.handleHomeSelection(a.queueOnlyKey(msg.String()))
 	case "r":
 		// Refresh home data
 		return a, a.loadHomeData()
@@ -970,54 +2035,65 @@ func (a *App) handleHomeKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return a, nil
 }
 
-// getTotalHomeItems returns the total number of items across all home sections (limited to 4 per section)
+// getTotalHomeItems returns the total number of items shown across all home sections
 func (a *App) getTotalHomeItems() int {
-	return a.getHomeItemsCount(0) + a.getHomeItemsCount(1) +
-		   a.getHomeItemsCount(2) + a.getHomeItemsCount(3)
+	total := 0
+	for section := 0; section < config.HomeSectionCount; section++ {
+		total += a.getHomeItemsCount(section)
+	}
+	return total
 }
 
-// getHomeItemsCount returns the number of items to display for a given section (max 4)
+// getHomeItemsCount returns the number of items to display for a given section
 func (a *App) getHomeItemsCount(section int) int {
-	maxItems := 4
+	if section < 0 || section >= config.HomeSectionCount {
+		return 0
+	}
+	maxItems := a.view.HomeSectionLimits()[section]
 	switch section {
 	case 0: // Recently Added Albums
-		if len(a.state.RecentlyAddedAlbums) < maxItems {
-			return len(a.state.RecentlyAddedAlbums)
-		}
-		return maxItems
+		return min(len(a.state.RecentlyAddedAlbums), maxItems)
 	case 1: // Top Artists
-		if len(a.state.TopArtistsByPlays) < maxItems {
-			return len(a.state.TopArtistsByPlays)
-		}
-		return maxItems
+		return min(len(a.state.TopArtistsByPlays), maxItems)
 	case 2: // Most Played Albums
-		if len(a.state.MostPlayedAlbums) < maxItems {
-			return len(a.state.MostPlayedAlbums)
-		}
-		return maxItems
+		return min(len(a.state.MostPlayedAlbums), maxItems)
 	case 3: // Top Tracks
-		if len(a.state.TopTracks) < maxItems {
-			return len(a.state.TopTracks)
-		}
-		return maxItems
+		return min(len(a.state.TopTracks), maxItems)
+	case 4: // Recently Played Albums
+		return min(len(a.state.RecentlyPlayedAlbums), maxItems)
 	default:
 		return 0
 	}
 }
 
+// homeSectionPosition returns where the selected section sits in the home tab's
+// display order, or -1 when it is hidden
+func (a *App) homeSectionPosition() int {
+	return slices.Index(a.view.HomeSectionOrder(), a.state.HomeSelectedSection)
+}
+
+// clampHomeSelection moves the home selection back onto a shown item, e.g. after a
+// refresh returned fewer items or the section was hidden
+func (a *App) clampHomeSelection() {
+	if a.homeSectionPosition() < 0 {
+		a.state.HomeSelectedSection = a.view.HomeSectionOrder()[0]
+		a.state.HomeSelectedIndex = 0
+	}
+	if a.state.HomeSelectedIndex >= a.getHomeItemsCount(a.state.HomeSelectedSection) {
+		a.state.HomeSelectedIndex = 0
+	}
+}
+
 // getGlobalHomeIndex returns the global index across all sections
 func (a *App) getGlobalHomeIndex() int {
 	globalIndex := 0
 
-	// Add items from sections before the current one (limited to 4 per section)
-	if a.state.HomeSelectedSection > 0 {
-		globalIndex += a.getHomeItemsCount(0) // Recently Added Albums
-	}
-	if a.state.HomeSelectedSection > 1 {
-		globalIndex += a.getHomeItemsCount(1) // Top Artists
-	}
-	if a.state.HomeSelectedSection > 2 {
-		globalIndex += a.getHomeItemsCount(2) // Most Played Albums
+	// Add items from sections shown before the current one
+	for _, section := range a.view.HomeSectionOrder() {
+		if section == a.state.HomeSelectedSection {
+			break
+		}
+		globalIndex += a.getHomeItemsCount(section)
 	}
 
 	// Add the current section index
@@ -1029,60 +2105,14 @@ func (a *App) getGlobalHomeIndex() int {
 // setHomeSelectionFromGlobalIndex sets the section and index from a global index
 func (a *App) setHomeSelectionFromGlobalIndex(globalIndex int) {
 	currentIndex := 0
-
-	// Section 0: Recently Added Albums (limited to 4 items)
-	section0Count := a.getHomeItemsCount(0)
-	if globalIndex < currentIndex + section0Count {
-		a.state.HomeSelectedSection = 0
-		a.state.HomeSelectedIndex = globalIndex - currentIndex
-		// Ensure index doesn't exceed available items or 4-item limit
-		maxIndex := section0Count - 1
-		if a.state.HomeSelectedIndex > maxIndex {
-			a.state.HomeSelectedIndex = maxIndex
-		}
-		return
-	}
-	currentIndex += section0Count
-
-	// Section 1: Top Artists (limited to 4 items)
-	section1Count := a.getHomeItemsCount(1)
-	if globalIndex < currentIndex + section1Count {
-		a.state.HomeSelectedSection = 1
-		a.state.HomeSelectedIndex = globalIndex - currentIndex
-		// Ensure index doesn't exceed available items or 4-item limit
-		maxIndex := section1Count - 1
-		if a.state.HomeSelectedIndex > maxIndex {
-			a.state.HomeSelectedIndex = maxIndex
-		}
-		return
-	}
-	currentIndex += section1Count
-
-	// Section 2: Most Played Albums (limited to 4 items)
-	section2Count := a.getHomeItemsCount(2)
-	if globalIndex < currentIndex + section2Count {
-		a.state.HomeSelectedSection = 2
-		a.state.HomeSelectedIndex = globalIndex - currentIndex
-		// Ensure index doesn't exceed available items or 4-item limit
-		maxIndex := section2Count - 1
-		if a.state.HomeSelectedIndex > maxIndex {
-			a.state.HomeSelectedIndex = maxIndex
-		}
-		return
-	}
-	currentIndex += section2Count
-
-	// Section 3: Top Tracks (limited to 4 items)
-	section3Count := a.getHomeItemsCount(3)
-	if globalIndex < currentIndex + section3Count {
-		a.state.HomeSelectedSection = 3
-		a.state.HomeSelectedIndex = globalIndex - currentIndex
-		// Ensure index doesn't exceed available items or 4-item limit
-		maxIndex := section3Count - 1
-		if a.state.HomeSelectedIndex > maxIndex {
-			a.state.HomeSelectedIndex = maxIndex
+	for _, section := range a.view.HomeSectionOrder() {
+		count := a.getHomeItemsCount(section)
+		if globalIndex < currentIndex+count {
+			a.state.HomeSelectedSection = section
+			a.state.HomeSelectedIndex = globalIndex - currentIndex
+			return
 		}
-		return
+		currentIndex += count
 	}
 }
 
@@ -1106,9 +2136,9 @@ func (a *App) moveHomeSelectionDown() {
 // moveHomeSelectionPageUp moves selection up by sections or large jumps
 func (a *App) moveHomeSelectionPageUp() {
 	// Jump to the beginning of the current section, or previous section if already at beginning
-	if a.state.HomeSelectedIndex == 0 && a.state.HomeSelectedSection > 0 {
+	if position := a.homeSectionPosition(); a.state.HomeSelectedIndex == 0 && position > 0 {
 		// Jump to previous section
-		a.state.HomeSelectedSection--
+		a.state.HomeSelectedSection = a.view.HomeSectionOrder()[position-1]
 		// Set to last item of the previous section (limited to 4 items)
 		a.state.HomeSelectedIndex = a.getHomeItemsCount(a.state.HomeSelectedSection) - 1
 		if a.state.HomeSelectedIndex < 0 {
@@ -1124,10 +2154,11 @@ func (a *App) moveHomeSelectionPageUp() {
 func (a *App) moveHomeSelectionPageDown() {
 	// Jump to the end of the current section, or next section if already at end
 	currentSectionSize := a.getHomeItemsCount(a.state.HomeSelectedSection)
+	order := a.view.HomeSectionOrder()
 
-	if a.state.HomeSelectedIndex == currentSectionSize-1 && a.state.HomeSelectedSection < 3 {
+	if position := a.homeSectionPosition(); a.state.HomeSelectedIndex == currentSectionSize-1 && position >= 0 && position < len(order)-1 {
 		// Jump to next section
-		a.state.HomeSelectedSection++
+		a.state.HomeSelectedSection = order[position+1]
 		a.state.HomeSelectedIndex = 0
 	} else {
 		// Jump to end of current section
@@ -1165,9 +2196,7 @@ func (a *App) handleHomeSelection(queueOnly bool) (tea.Model, tea.Cmd) {
 				// Play track and queue remaining
 				remainingTracks := a.state.TopTracks[a.state.HomeSelectedIndex:]
 				if a.audioManager != nil {
-					a.audioManager.ClearQueue()
-					a.audioManager.AddTracksToQueue(remainingTracks)
-					a.audioManager.PlayTrackAtIndex(0)
+					a.audioManager.ReplaceQueue(remainingTracks, true)
 					a.logMessage(fmt.Sprintf("Playing: %s - %s (%d tracks queued)", 
 						track.Artist, track.Title, len(remainingTracks)))
 				} else {
@@ -1178,6 +2207,11 @@ func (a *App) handleHomeSelection(queueOnly bool) (tea.Model, tea.Cmd) {
 				}
 			}
 		}
+	case 4: // Recently Played Albums
+		if a.state.HomeSelectedIndex < len(a.state.RecentlyPlayedAlbums) {
+			album := a.state.RecentlyPlayedAlbums[a.state.HomeSelectedIndex]
+			return a, a.showAlbumModal(album)
+		}
 	}
 	return a, nil
 }
@@ -1223,18 +2257,50 @@ func (a *App) handleAlbumsKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if a.state.SelectedAlbumIndex < len(a.state.Albums) {
 			return a, a.showAlbumModal(a.state.Albums[a.state.SelectedAlbumIndex])
 		}
-	case "alt+enter":
-		// Queue entire album immediately (Alt+Enter)
+	case "*":
+		// Jump to a random album
+		a.jumpToRandomAlbum()
+	case "ctrl+r":
+		// Play a random album now, replacing the queue
+		return a, a.playRandomAlbum()
+	case "n":
+		// Show only albums added within ui.new_badge_days, newest first
+		a.logMessage("Sorting by: New Additions Only...")
+		return a, a.sortAlbumsAsync("new", false)
+	case "x", "v":
+		// Mark/unmark the selected album for batch queueing
+		a.toggleMark(a.state.SelectedAlbumIndex, len(a.state.Albums), &a.state.SelectedAlbumIndex)
+		a.loadCurrentArtwork()
+	case "esc":
+		a.clearMarks()
+	case "alt+enter", "a", "alt+a":
+		// Append or replace the queue with the marked albums, or the selected album when none are marked
+		placement := a.queueKeyPlacement(msg.String())
+		if len(a.state.MarkedItems) > 0 {
+			return a, a.queueMarkedAlbums(placement)
+		}
+		if a.state.SelectedAlbumIndex < len(a.state.Albums) {
+			return a, a.queueAlbum(a.state.Albums[a.state.SelectedAlbumIndex], placement)
+		}
+	case "A", "shift+a":
+		// Play the selected album right after the current track
+		if a.state.SelectedAlbumIndex < len(a.state.Albums) {
+			return a, a.playAlbumNext(a.state.Albums[a.state.SelectedAlbumIndex])
+		}
+	case "w":
+		// Download the selected album to the downloads folder
 		if a.state.SelectedAlbumIndex < len(a.state.Albums) {
-			return a, a.addAlbumToQueue(a.state.Albums[a.state.SelectedAlbumIndex])
+			return a, a.downloadAlbum(a.state.Albums[a.state.SelectedAlbumIndex])
 		}
-	case "a":
-		// Alternative: 'A' key to queue entire album immediately
+	case "0", "1", "2", "3", "4", "5":
+		// Rate the selected album (0 clears)
 		if a.state.SelectedAlbumIndex < len(a.state.Albums) {
-			return a, a.addAlbumToQueue(a.state.Albums[a.state.SelectedAlbumIndex])
+			rating, _ := ratingKey(msg.String())
+			return a, a.rateAlbum(a.state.Albums[a.state.SelectedAlbumIndex], rating)
 		}
 	case "r":
-		// Refresh albums
+		// Refresh albums, dropping the cached copy
+		a.invalidateLibraryCache()
 		return a, a.loadAlbums()
 	}
 
@@ -1250,8 +2316,9 @@ func (a *App) loadAlbums() tea.Cmd {
 	a.state.LoadingAlbums = true
 	a.state.LoadingError = ""
 
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
 	return tea.Cmd(func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second) // Longer timeout for all albums
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
 		// Load all albums by setting a very high limit
@@ -1275,6 +2342,8 @@ func (a *App) loadAlbums() tea.Cmd {
 				PlayCount:  album.PlayCount,
 				CreatedAt:  album.Created,
 				CoverArt:   album.CoverArt,
+				UserRating: album.UserRating,
+				MusicBrainzID: album.MusicBrainzID,
 			}
 		}
 
@@ -1291,8 +2360,9 @@ func (a *App) loadArtists() tea.Cmd {
 	a.state.LoadingArtists = true
 	a.state.LoadingError = ""
 
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
 	return tea.Cmd(func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
 		resp, err := a.navidromeClient.GetArtists(ctx)
@@ -1350,8 +2420,9 @@ func (a *App) loadPlaylists() tea.Cmd {
 	a.state.LoadingPlaylists = true
 	a.state.LoadingError = ""
 
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
 	return tea.Cmd(func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
 		resp, err := a.navidromeClient.GetPlaylists(ctx)
@@ -1388,14 +2459,17 @@ func (a *App) loadHomeData() tea.Cmd {
 	a.state.LoadingHomeData = true
 	a.state.LoadingError = ""
 
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
 	return tea.Cmd(func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 45*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
 		var homeData HomeDataLoadResult
+		counts := a.state.ConfigForm.Config.UI.HomeSectionCounts()
+		shown := a.state.ConfigForm.Config.UI.HomeSectionOrder()
 		
 		// Load Recently Added Albums
-		recentResp, err := a.navidromeClient.GetAlbumsByType(ctx, "newest", 8, 0)
+		recentResp, err := a.navidromeClient.GetAlbumsByType(ctx, "newest", counts[0], 0)
 		if err != nil {
 			homeData.Error = err
 			return homeData
@@ -1417,14 +2491,16 @@ func (a *App) loadHomeData() tea.Cmd {
 				PlayCount:  album.PlayCount,
 				CreatedAt:  album.Created,
 				CoverArt:   album.CoverArt,
+				UserRating: album.UserRating,
+				MusicBrainzID: album.MusicBrainzID,
 			}
 		}
 
 		// Load Most Played Albums
-		frequentResp, err := a.navidromeClient.GetAlbumsByType(ctx, "frequent", 8, 0)
+		frequentResp, err := a.navidromeClient.GetAlbumsByType(ctx, "frequent", counts[2], 0)
 		if err != nil {
 			// If frequent doesn't work, try recent or newest as fallback
-			frequentResp, err = a.navidromeClient.GetAlbumsByType(ctx, "recent", 8, 0)
+			frequentResp, err = a.navidromeClient.GetAlbumsByType(ctx, "recent", counts[2], 0)
 			if err != nil {
 				// Final fallback to newest
 				frequentResp = recentResp // Reuse recently added as fallback
@@ -1446,6 +2522,34 @@ func (a *App) loadHomeData() tea.Cmd {
 				PlayCount:  album.PlayCount,
 				CreatedAt:  album.Created,
 				CoverArt:   album.CoverArt,
+				UserRating: album.UserRating,
+				MusicBrainzID: album.MusicBrainzID,
+			}
+		}
+
+		// Load Recently Played Albums unless hidden; older servers may not track
+		// this, so an error just leaves the section empty
+		if slices.Contains(shown, 4) {
+			playedResp, err := a.navidromeClient.GetAlbumsByType(ctx, "recent", counts[4], 0)
+			if err == nil {
+				homeData.RecentlyPlayed = make([]models.Album, len(playedResp.SubsonicResponse.AlbumList2.Album))
+				for i, album := range playedResp.SubsonicResponse.AlbumList2.Album {
+					homeData.RecentlyPlayed[i] = models.Album{
+						ID:         album.ID,
+						Name:       album.Name,
+						Artist:     album.Artist,
+						ArtistID:   album.ArtistID,
+						Year:       album.Year,
+						Genre:      album.Genre,
+						Duration:   album.Duration,
+						TrackCount: album.SongCount,
+						PlayCount:  album.PlayCount,
+						CreatedAt:  album.Created,
+						CoverArt:   album.CoverArt,
+						UserRating: album.UserRating,
+						MusicBrainzID: album.MusicBrainzID,
+					}
+				}
 			}
 		}
 
@@ -1482,13 +2586,16 @@ func (a *App) loadHomeData() tea.Cmd {
 							BitRate:   song.BitRate,
 							PlayCount: song.PlayCount,
 							Path:      song.Path,
+							UserRating: song.UserRating,
+							RecordingMBID: song.MusicBrainzID,
+							ArtistMBIDs: song.ArtistMBIDs(),
 						})
 					}
 				}
 			}
 		}
 		
-		// Sort by play count (descending) and take top 10
+		// Sort by play count (descending) and take the configured number
 		if len(allTopTracks) > 0 {
 			for i := 0; i < len(allTopTracks)-1; i++ {
 				for j := 0; j < len(allTopTracks)-i-1; j++ {
@@ -1497,14 +2604,14 @@ func (a *App) loadHomeData() tea.Cmd {
 					}
 				}
 			}
-			maxTracks := 10
+			maxTracks := counts[3]
 			if len(allTopTracks) < maxTracks {
 				maxTracks = len(allTopTracks)
 			}
 			homeData.TopTracks = allTopTracks[:maxTracks]
 		} else {
 			// Final fallback to random songs
-			tracksResp, err := a.navidromeClient.GetSongs(ctx, 10, 0)
+			tracksResp, err := a.navidromeClient.GetSongs(ctx, counts[3], 0)
 			if err != nil {
 				homeData.Error = err
 				return homeData
@@ -1529,11 +2636,18 @@ func (a *App) loadHomeData() tea.Cmd {
 					BitRate:   song.BitRate,
 					PlayCount: song.PlayCount,
 					Path:      song.Path,
+					UserRating: song.UserRating,
+					RecordingMBID: song.MusicBrainzID,
+					ArtistMBIDs: song.ArtistMBIDs(),
 				}
 			}
 		}
 
-		// Load Top Artists (aggregate play counts from albums)
+		// Load Top Artists (aggregate play counts from albums), which scans the
+		// whole library, so skip it when the section is hidden
+		if !slices.Contains(shown, 1) {
+			return homeData
+		}
 		artistsResp, err := a.navidromeClient.GetArtists(ctx)
 		if err != nil {
 			homeData.Error = err
@@ -1589,8 +2703,8 @@ func (a *App) loadHomeData() tea.Cmd {
 			}
 		}
 		
-		// Take top 5 artists
-		maxArtists := 5
+		// Take the configured number of top artists
+		maxArtists := counts[1]
 		if len(allArtists) < maxArtists {
 			maxArtists = len(allArtists)
 		}
@@ -1606,11 +2720,17 @@ type HomeDataLoadResult struct {
 	MostPlayed    []models.Album
 	TopTracks     []models.Track
 	TopArtists    []models.Artist
+	RecentlyPlayed []models.Album
 	Error         error
 }
 
-// addAlbumToQueue adds all tracks from an album to the queue
-func (a *App) addAlbumToQueue(album models.Album) tea.Cmd {
+// playAlbumNext inserts all tracks from an album right after the current track
+func (a *App) playAlbumNext(album models.Album) tea.Cmd {
+	return a.queueAlbum(album, placeNext)
+}
+
+// queueAlbum fetches an album's tracks and places them in the queue
+func (a *App) queueAlbum(album models.Album, placement queuePlacement) tea.Cmd {
 	return tea.Batch(
 		func() tea.Msg {
 			if a.navidromeClient == nil {
@@ -1642,16 +2762,26 @@ func (a *App) addAlbumToQueue(album models.Album) tea.Cmd {
 					Suffix:   song.Suffix,
 					BitRate:  song.BitRate,
 					Path:     song.Path,
+					UserRating: song.UserRating,
+					RecordingMBID: song.MusicBrainzID,
+					ArtistMBIDs: song.ArtistMBIDs(),
+					ReleaseMBID: album.MusicBrainzID,
 				}
 			}
 
-			return AlbumTracksLoadResult{Tracks: tracks}
+			return AlbumTracksLoadResult{Tracks: tracks, Placement: placement}
 		},
 	)
 }
 
-// addPlaylistToQueue adds all tracks from a playlist to the queue
-func (a *App) addPlaylistToQueue(playlist models.Playlist) tea.Cmd {
+// playPlaylistNext inserts all tracks from a playlist right after the current track
+func (a *App) playPlaylistNext(playlist models.Playlist) tea.Cmd {
+	return a.queuePlaylist(playlist, placeNext)
+}
+
+// queuePlaylist fetches a playlist's tracks and places them in the queue
+func (a *App) queuePlaylist(playlist models.Playlist, placement queuePlacement) tea.Cmd {
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
 	return tea.Batch(
 		func() tea.Msg {
 			if a.navidromeClient == nil {
@@ -1659,7 +2789,7 @@ func (a *App) addPlaylistToQueue(playlist models.Playlist) tea.Cmd {
 			}
 
 			// Add timeout context to prevent hanging
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
 			defer cancel()
 
 			// Fetch actual tracks from the playlist
@@ -1702,18 +2832,22 @@ func (a *App) addPlaylistToQueue(playlist models.Playlist) tea.Cmd {
 					Suffix:   song.Suffix,
 					BitRate:  song.BitRate,
 					Path:     song.Path,
+					UserRating: song.UserRating,
+					RecordingMBID: song.MusicBrainzID,
+					ArtistMBIDs: song.ArtistMBIDs(),
 				}
 			}
 
-			return PlaylistTracksQueueResult{Tracks: tracks}
+			return PlaylistTracksQueueResult{Tracks: tracks, Placement: placement}
 		},
 	)
 }
 
 // AlbumTracksLoadResult represents the result of loading album tracks
 type AlbumTracksLoadResult struct {
-	Tracks []models.Track
-	Error  error
+	Tracks    []models.Track
+	Placement queuePlacement
+	Error     error
 }
 
 // handleArtistsKeyPress handles keyboard input for the artists tab
@@ -1756,8 +2890,24 @@ func (a *App) handleArtistsKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if a.state.SelectedArtistIndex < len(a.state.Artists) {
 			return a, a.showArtistModal(a.state.Artists[a.state.SelectedArtistIndex])
 		}
+	case "*":
+		// Jump to a random artist
+		a.jumpToRandomArtist()
+	case "x", "v":
+		// Mark/unmark the selected artist for batch queueing (Shift+X/V still jump)
+		a.toggleMark(a.state.SelectedArtistIndex, len(a.state.Artists), &a.state.SelectedArtistIndex)
+		a.loadCurrentArtwork()
+	case "esc":
+		a.clearMarks()
+	case "alt+enter", "alt+a":
+		// Append or replace the queue with every track of the marked artists, or of the selected artist when none are marked
+		if len(a.state.MarkedItems) == 0 && a.state.SelectedArtistIndex < len(a.state.Artists) {
+			a.state.MarkedItems = map[int]bool{a.state.SelectedArtistIndex: true}
+		}
+		return a, a.queueMarkedArtists(a.queueKeyPlacement(msg.String()))
 	case "r":
-		// Refresh artists
+		// Refresh artists, dropping the cached copy
+		a.invalidateLibraryCache()
 		return a, a.loadArtists()
 	default:
 		// Alpha-jump navigation: press any letter to jump to first artist starting with that letter
@@ -1870,18 +3020,33 @@ func (a *App) handlePlaylistsKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if a.state.SelectedPlaylistIndex < len(a.state.Playlists) {
 			return a, a.showPlaylistModal(a.state.Playlists[a.state.SelectedPlaylistIndex])
 		}
-	case "alt+enter":
-		// Queue entire playlist immediately (Alt+Enter)
+	case "x", "v":
+		// Mark/unmark the selected playlist for batch queueing
+		a.toggleMark(a.state.SelectedPlaylistIndex, len(a.state.Playlists), &a.state.SelectedPlaylistIndex)
+	case "esc":
+		a.clearMarks()
+	case "alt+enter", "a", "alt+a":
+		// Append or replace the queue with the marked playlists, or the selected playlist when none are marked
+		placement := a.queueKeyPlacement(msg.String())
+		if len(a.state.MarkedItems) > 0 {
+			return a, a.queueMarkedPlaylists(placement)
+		}
+		if a.state.SelectedPlaylistIndex < len(a.state.Playlists) {
+			return a, a.queuePlaylist(a.state.Playlists[a.state.SelectedPlaylistIndex], placement)
+		}
+	case "A", "shift+a":
+		// Play the selected playlist right after the current track
 		if a.state.SelectedPlaylistIndex < len(a.state.Playlists) {
-			return a, a.addPlaylistToQueue(a.state.Playlists[a.state.SelectedPlaylistIndex])
+			return a, a.playPlaylistNext(a.state.Playlists[a.state.SelectedPlaylistIndex])
 		}
-	case "a":
-		// Alternative: 'A' key to queue entire playlist immediately
+	case "w":
+		// Download the selected playlist to the downloads folder
 		if a.state.SelectedPlaylistIndex < len(a.state.Playlists) {
-			return a, a.addPlaylistToQueue(a.state.Playlists[a.state.SelectedPlaylistIndex])
+			return a, a.downloadPlaylist(a.state.Playlists[a.state.SelectedPlaylistIndex])
 		}
 	case "r":
-		// Refresh playlists
+		// Refresh playlists, dropping the cached copy
+		a.invalidateLibraryCache()
 		return a, a.loadPlaylists()
 	}
 
@@ -1899,16 +3064,40 @@ type ArtistTracksLoadResult struct {
 func (a *App) addTrackToQueue(track models.Track) tea.Cmd {
 	if a.audioManager != nil {
 		a.audioManager.AddToQueue(track)
-		// State will be updated via the audio manager callback
+		// State will be updated via the audio manager events
 	} else {
 		a.state.Queue = append(a.state.Queue, track)
 	}
 	return nil
 }
 
+// queueOnlyKey reports whether key (Enter or Shift+Enter) should queue the
+// selection rather than play it, as set by behavior.enter_action
+func (a *App) queueOnlyKey(key string) bool {
+	queueOnly := key == "shift+enter"
+	if a.state.ConfigForm.Config.Behavior.EnterAction == config.EnterActionQueue {
+		return !queueOnly
+	}
+	return queueOnly
+}
+
 // handleQueueKeyPress handles keyboard input for the queue tab
 func (a *App) handleQueueKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if a.goPending {
+		// Second key of ga/gA: jump from the playing track to its album or artist
+		a.goPending = false
+		switch msg.String() {
+		case "a":
+			return a, a.goToCurrentAlbum()
+		case "A", "shift+a":
+			return a, a.goToCurrentArtist()
+		}
+	}
+
 	switch msg.String() {
+	case "g":
+		a.goPending = true
+		return a, nil
 	case "ctrl+c", "q":
 		return a, a.cleanup()
 	case "tab":
@@ -1942,6 +3131,40 @@ func (a *App) handleQueueKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if a.audioManager != nil && a.state.SelectedQueueIndex < len(a.state.Queue) {
 			a.audioManager.RemoveFromQueue(a.state.SelectedQueueIndex)
 		}
+	case "P", "shift+p":
+		// Add selected track to a playlist
+		if a.state.SelectedQueueIndex < len(a.state.Queue) {
+			track := a.state.Queue[a.state.SelectedQueueIndex]
+			return a, a.openPlaylistPicker([]models.Track{track})
+		}
+	case "o":
+		// Download selected track for offline playback
+		if a.state.SelectedQueueIndex < len(a.state.Queue) {
+			return a, a.cacheForOffline([]models.Track{a.state.Queue[a.state.SelectedQueueIndex]})
+		}
+	case "O", "shift+o":
+		// Download the whole queue for offline playback
+		return a, a.cacheForOffline(a.state.Queue)
+	case "w":
+		// Save the selected track to the downloads folder
+		if a.state.SelectedQueueIndex < len(a.state.Queue) {
+			return a, a.downloadTrack(a.state.Queue[a.state.SelectedQueueIndex])
+		}
+	case "0", "1", "2", "3", "4", "5":
+		// Rate the selected track (0 clears)
+		if a.state.SelectedQueueIndex < len(a.state.Queue) {
+			rating, _ := ratingKey(msg.String())
+			return a, a.rateTrack(a.state.Queue[a.state.SelectedQueueIndex], rating)
+		}
+	case "e":
+		// Export the queue as an M3U playlist for other players
+		a.exportQueue(exportM3U)
+	case "E", "shift+e":
+		// Export the queue as JSON
+		a.exportQueue(exportJSON)
+	case "u":
+		// Undo the last clear, replace or trim of the queue
+		a.undoQueueChange()
 	case "c":
 		// Clear entire queue
 		if a.audioManager != nil {
@@ -1950,6 +3173,22 @@ func (a *App) handleQueueKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			a.state.Queue = make([]models.Track, 0)
 		}
 		a.state.SelectedQueueIndex = 0
+	case "[":
+		// Clear already-played tracks before the current one
+		if a.audioManager != nil {
+			removed := a.audioManager.ClearBeforeCurrent()
+			a.logMessage(fmt.Sprintf("Removed %d played tracks", removed))
+			a.state.SelectedQueueIndex = 0
+		}
+	case "]":
+		// Clear upcoming tracks after the current one
+		if a.audioManager != nil {
+			removed := a.audioManager.ClearAfterCurrent()
+			a.logMessage(fmt.Sprintf("Removed %d upcoming tracks", removed))
+			if current := a.audioManager.GetCurrentIndex(); current >= 0 && a.state.SelectedQueueIndex > current {
+				a.state.SelectedQueueIndex = current
+			}
+		}
 	case "enter":
 		// Play selected track (Enter only, Space is handled globally for play/pause)
 		if a.audioManager != nil {
@@ -2001,11 +3240,13 @@ type PlaylistTracksModalResult struct {
 }
 
 type PlaylistTracksQueueResult struct {
-	Tracks []models.Track
-	Error  error
+	Tracks    []models.Track
+	Placement queuePlacement
+	Error     error
 }
 
 type SearchResult struct {
+	Seq     int // Search generation; results from older generations are dropped
 	Results models.SearchResults
 	Error   error
 }
@@ -2067,6 +3308,10 @@ func (a *App) showAlbumModal(album models.Album) tea.Cmd {
 				Suffix:   song.Suffix,
 				BitRate:  song.BitRate,
 				Path:     song.Path,
+				UserRating: song.UserRating,
+				RecordingMBID: song.MusicBrainzID,
+				ArtistMBIDs: song.ArtistMBIDs(),
+				ReleaseMBID: album.MusicBrainzID,
 			}
 		}
 
@@ -2074,6 +3319,33 @@ func (a *App) showAlbumModal(album models.Album) tea.Cmd {
 	})
 }
 
+// goToCurrentAlbum opens the album modal for the playing track
+func (a *App) goToCurrentAlbum() tea.Cmd {
+	track := a.state.CurrentTrack
+	if track == nil || track.AlbumID == "" {
+		a.logMessage("No album link available")
+		return nil
+	}
+	return a.showAlbumModal(models.Album{
+		ID:       track.AlbumID,
+		Name:     track.Album,
+		Artist:   track.Artist,
+		ArtistID: track.ArtistID,
+		Year:     track.Year,
+		Genre:    track.Genre,
+	})
+}
+
+// goToCurrentArtist opens the artist modal for the playing track
+func (a *App) goToCurrentArtist() tea.Cmd {
+	track := a.state.CurrentTrack
+	if track == nil || track.ArtistID == "" {
+		a.logMessage("No artist link available")
+		return nil
+	}
+	return a.showArtistModal(models.Artist{ID: track.ArtistID, Name: track.Artist})
+}
+
 // showArtistModal displays the artist albums modal
 func (a *App) showArtistModal(artist models.Artist) tea.Cmd {
 	a.state.ShowArtistModal = true
@@ -2105,6 +3377,8 @@ func (a *App) showArtistModal(artist models.Artist) tea.Cmd {
 				TrackCount: album.SongCount,
 				CreatedAt:  album.Created,
 				CoverArt:   album.CoverArt,
+				UserRating: album.UserRating,
+				MusicBrainzID: album.MusicBrainzID,
 			}
 		}
 
@@ -2120,13 +3394,14 @@ func (a *App) showPlaylistModal(playlist models.Playlist) tea.Cmd {
 	a.state.PlaylistTracks = nil
 	a.state.SelectedModalIndex = 0
 
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
 	return tea.Cmd(func() tea.Msg {
 		if a.navidromeClient == nil {
 			return PlaylistTracksModalResult{Error: fmt.Errorf("navidrome client not initialized")}
 		}
 
 		// Add timeout context to prevent hanging
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
 		resp, err := a.navidromeClient.GetPlaylistTracks(ctx, playlist.ID)
@@ -2172,6 +3447,9 @@ func (a *App) showPlaylistModal(playlist models.Playlist) tea.Cmd {
 				Suffix:   song.Suffix,
 				BitRate:  song.BitRate,
 				Path:     song.Path,
+				UserRating: song.UserRating,
+				RecordingMBID: song.MusicBrainzID,
+				ArtistMBIDs: song.ArtistMBIDs(),
 			}
 		}
 
@@ -2181,6 +3459,16 @@ func (a *App) showPlaylistModal(playlist models.Playlist) tea.Cmd {
 
 // handleModalKeyPress handles keyboard input when a modal is open
 func (a *App) handleModalKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// A confirm prompt is answered before anything else
+	if a.state.ShowConfirm {
+		return a.handleConfirmKeyPress(msg)
+	}
+
+	// Playlist picker opens on top of other modals
+	if a.state.ShowPlaylistPicker {
+		return a.handlePlaylistPickerKeyPress(msg)
+	}
+
 	// Handle search modal first
 	if a.state.ShowSearchModal {
 		return a.handleSearchModalKeyPress(msg)
@@ -2190,6 +3478,57 @@ func (a *App) handleModalKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if a.state.ShowSortModal {
 		return a.handleSortModalKeyPress(msg)
 	}
+
+	// Handle log history modal
+	if a.state.ShowLogModal {
+		return a.handleLogModalKeyPress(msg)
+	}
+
+	// Handle play history modal
+	if a.state.ShowHistoryModal {
+		return a.handleHistoryModalKeyPress(msg)
+	}
+
+	// Handle audio device picker
+	if a.state.ShowDevicePicker {
+		return a.handleDevicePickerKeyPress(msg)
+	}
+
+	// Handle random session picker
+	if a.state.ShowRandomPicker {
+		return a.handleRandomPickerKeyPress(msg)
+	}
+
+	// Handle equalizer modal
+	if a.state.ShowEqualizer {
+		return a.handleEqualizerKeyPress(msg)
+	}
+
+	// Handle folder browser
+	if a.state.ShowFolderBrowser {
+		return a.handleFolderBrowserKeyPress(msg)
+	}
+
+	// Handle podcast browser
+	if a.state.ShowPodcasts {
+		return a.handlePodcastsKeyPress(msg)
+	}
+
+	// Handle fit-to-time prompt
+	if a.state.ShowFitPicker {
+		return a.handleFitPickerKeyPress(msg)
+	}
+
+	// Handle server now playing modal
+	if a.state.ShowNowPlayingModal {
+		switch msg.String() {
+		case "esc", "q", "alt+n":
+			a.state.ShowNowPlayingModal = false
+		case "r", "R":
+			return a, a.loadNowPlaying()
+		}
+		return a, nil
+	}
 	
 	switch msg.String() {
 	case "esc", "q":
@@ -2246,7 +3585,21 @@ func (a *App) handleModalKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if a.state.SelectedModalIndex > maxIndex {
 			a.state.SelectedModalIndex = maxIndex
 		}
-	case "enter":
+	case "enter", "shift+enter":
+		// Queue only: add the selected track and keep the modal open to pick more
+		if a.queueOnlyKey(msg.String()) {
+			var track *models.Track
+			if a.state.ShowAlbumModal && a.state.SelectedModalIndex < len(a.state.AlbumTracks) {
+				track = &a.state.AlbumTracks[a.state.SelectedModalIndex]
+			} else if a.state.ShowPlaylistModal && a.state.SelectedModalIndex < len(a.state.PlaylistTracks) {
+				track = &a.state.PlaylistTracks[a.state.SelectedModalIndex]
+			}
+			if track != nil {
+				a.logMessage(fmt.Sprintf("Queued: %s - %s", track.Artist, track.Title))
+				return a, a.addTrackToQueue(*track)
+			}
+		}
+
 		// Handle different modal behaviors
 		if a.state.ShowAlbumModal && a.state.SelectedModalIndex < len(a.state.AlbumTracks) {
 			// Album modal: Play selected track immediately and queue remainder
@@ -2261,11 +3614,8 @@ func (a *App) handleModalKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			a.state.SelectedModalIndex = 0
 			
 			if a.audioManager != nil {
-				// Clear current queue and add the track selection
-				a.audioManager.ClearQueue()
-				a.audioManager.AddTracksToQueue(remainingTracks)
-				// Start playing the first track (selected one)
-				a.audioManager.PlayTrackAtIndex(0)
+				// Replace the queue with the selection and play the selected track
+				a.audioManager.ReplaceQueue(remainingTracks, true)
 				
 				// Log the action for user feedback
 				trackNum := selectedTrack.Track
@@ -2314,11 +3664,8 @@ func (a *App) handleModalKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			a.state.SelectedModalIndex = 0
 			
 			if a.audioManager != nil {
-				// Clear current queue and add the track selection
-				a.audioManager.ClearQueue()
-				a.audioManager.AddTracksToQueue(remainingTracks)
-				// Start playing the first track (selected one)
-				a.audioManager.PlayTrackAtIndex(0)
+				// Replace the queue with the selection and play the selected track
+				a.audioManager.ReplaceQueue(remainingTracks, true)
 				
 				// Log the action for user feedback
 				trackNum := selectedIndex + 1
@@ -2338,41 +3685,436 @@ func (a *App) handleModalKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			
 			return a, nil
 		}
-	case "a", "alt+enter":
-		// Add all items to queue
-		if a.state.ShowAlbumModal && len(a.state.AlbumTracks) > 0 {
-			if a.audioManager != nil {
-				a.audioManager.AddTracksToQueue(a.state.AlbumTracks)
-				// State will be updated via the audio manager callback
-				a.logMessage(fmt.Sprintf("Added %d tracks to queue", len(a.state.AlbumTracks)))
-			} else {
-				a.state.Queue = append(a.state.Queue, a.state.AlbumTracks...)
-				a.logMessage(fmt.Sprintf("Added %d tracks to queue (total: %d)", len(a.state.AlbumTracks), len(a.state.Queue)))
+	case "w":
+		// Download the selected track (or album, in the artist modal)
+		if a.state.ShowAlbumModal && a.state.SelectedModalIndex < len(a.state.AlbumTracks) {
+			return a, a.downloadTrack(a.state.AlbumTracks[a.state.SelectedModalIndex])
+		} else if a.state.ShowPlaylistModal && a.state.SelectedModalIndex < len(a.state.PlaylistTracks) {
+			return a, a.downloadTrack(a.state.PlaylistTracks[a.state.SelectedModalIndex])
+		} else if a.state.ShowArtistModal && a.state.SelectedModalIndex < len(a.state.ArtistAlbums) {
+			return a, a.downloadAlbum(a.state.ArtistAlbums[a.state.SelectedModalIndex])
+		}
+	case "0", "1", "2", "3", "4", "5":
+		// Rate the selected track (or album, in the artist modal); 0 clears
+		rating, _ := ratingKey(msg.String())
+		if a.state.ShowAlbumModal && a.state.SelectedModalIndex < len(a.state.AlbumTracks) {
+			return a, a.rateTrack(a.state.AlbumTracks[a.state.SelectedModalIndex], rating)
+		} else if a.state.ShowPlaylistModal && a.state.SelectedModalIndex < len(a.state.PlaylistTracks) {
+			return a, a.rateTrack(a.state.PlaylistTracks[a.state.SelectedModalIndex], rating)
+		} else if a.state.ShowArtistModal && a.state.SelectedModalIndex < len(a.state.ArtistAlbums) {
+			return a, a.rateAlbum(a.state.ArtistAlbums[a.state.SelectedModalIndex], rating)
+		}
+	case "W", "shift+w":
+		// Download every track in the modal
+		if a.state.ShowAlbumModal && a.state.SelectedAlbum != nil {
+			return a, a.downloadTracks(a.state.SelectedAlbum.Name, a.state.AlbumTracks)
+		} else if a.state.ShowPlaylistModal && a.state.SelectedPlaylist != nil {
+			return a, a.downloadTracks(a.state.SelectedPlaylist.Name, a.state.PlaylistTracks)
+		}
+	case "P", "shift+p":
+		// Add album tracks to a playlist
+		if a.state.ShowAlbumModal && len(a.state.AlbumTracks) > 0 {
+			return a, a.openPlaylistPicker(a.state.AlbumTracks)
+		}
+	case "A", "shift+a":
+		// Play all modal tracks right after the current track
+		if a.state.ShowAlbumModal && len(a.state.AlbumTracks) > 0 {
+			a.insertTracksNext(a.state.AlbumTracks)
+			a.logMessage(fmt.Sprintf("Playing %d tracks next", len(a.state.AlbumTracks)))
+		} else if a.state.ShowPlaylistModal && len(a.state.PlaylistTracks) > 0 {
+			a.insertTracksNext(a.state.PlaylistTracks)
+			a.logMessage(fmt.Sprintf("Playing %d tracks next", len(a.state.PlaylistTracks)))
+		}
+	case "R", "shift+r":
+		// Replace the queue with a radio mixing this artist and similar ones
+		if a.state.ShowArtistModal && a.state.SelectedArtist != nil {
+			return a, a.startArtistRadio(*a.state.SelectedArtist)
+		}
+	case "a", "alt+enter", "alt+a":
+		// Append all items to the queue, or replace the queue with them
+		placement := a.queueKeyPlacement(msg.String())
+		if a.state.ShowAlbumModal && len(a.state.AlbumTracks) > 0 {
+			a.placeTracks(a.state.AlbumTracks, placement, "album")
+		} else if a.state.ShowArtistModal && len(a.state.ArtistAlbums) > 0 {
+			// Load every album of this artist in order
+			return a, a.queueAlbums(a.state.ArtistAlbums, placement)
+		} else if a.state.ShowPlaylistModal && len(a.state.PlaylistTracks) > 0 {
+			a.placeTracks(a.state.PlaylistTracks, placement, "playlist")
+		}
+	}
+
+	return a, nil
+}
+
+// NowPlayingLoadResult represents the result of loading server-wide now playing
+type NowPlayingLoadResult struct {
+	Entries []models.NowPlayingEntry
+	Error   error
+}
+
+// NowPlayingTickMsg triggers a refresh of the now playing modal
+type NowPlayingTickMsg struct{}
+
+// nowPlayingTick schedules the next now playing refresh
+func nowPlayingTick() tea.Cmd {
+	return tea.Tick(15*time.Second, func(time.Time) tea.Msg {
+		return NowPlayingTickMsg{}
+	})
+}
+
+// loadNowPlaying fetches what other users and clients are streaming
+func (a *App) loadNowPlaying() tea.Cmd {
+	if a.navidromeClient == nil {
+		return nil
+	}
+
+	a.state.LoadingNowPlaying = true
+	client := a.navidromeClient
+
+	timeout := a.state.ConfigForm.Config.Timeouts.MetadataTimeout()
+	return tea.Cmd(func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		resp, err := client.GetNowPlaying(ctx)
+		if err != nil {
+			return NowPlayingLoadResult{Error: err}
+		}
+
+		entries := make([]models.NowPlayingEntry, len(resp.SubsonicResponse.NowPlaying.Entry))
+		for i, entry := range resp.SubsonicResponse.NowPlaying.Entry {
+			entries[i] = models.NowPlayingEntry{
+				Username:   entry.Username,
+				PlayerName: entry.PlayerName,
+				Track:      convertSongs([]navidrome.Song{entry.Song})[0],
+				MinutesAgo: entry.MinutesAgo,
+			}
+		}
+		return NowPlayingLoadResult{Entries: entries}
+	})
+}
+
+// openPlaylistPicker shows the playlist picker for tracks, loading playlists first if needed
+func (a *App) openPlaylistPicker(tracks []models.Track) tea.Cmd {
+	if len(tracks) == 0 {
+		return nil
+	}
+
+	a.state.ShowPlaylistPicker = true
+	a.state.PlaylistPickerTracks = tracks
+	a.state.SelectedPickerIndex = 0
+	a.state.NamingNewPlaylist = false
+	a.state.NewPlaylistName = ""
+
+	if len(a.state.Playlists) == 0 && !a.state.LoadingPlaylists {
+		return a.loadPlaylists()
+	}
+	return nil
+}
+
+// handlePlaylistPickerKeyPress handles navigation in the playlist picker; the last
+// row below the playlists starts naming a new one
+func (a *App) handlePlaylistPickerKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if a.state.NamingNewPlaylist {
+		return a.handleNewPlaylistNameKeyPress(msg)
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		// Close picker and return to the previous view
+		a.state.ShowPlaylistPicker = false
+		a.state.PlaylistPickerTracks = nil
+		a.state.SelectedPickerIndex = 0
+	case "up":
+		if a.state.SelectedPickerIndex > 0 {
+			a.state.SelectedPickerIndex--
+		}
+	case "down":
+		if a.state.SelectedPickerIndex < len(a.state.Playlists) && !a.state.LoadingPlaylists {
+			a.state.SelectedPickerIndex++
+		}
+	case "enter":
+		if a.state.SelectedPickerIndex == len(a.state.Playlists) && !a.state.LoadingPlaylists {
+			a.state.NamingNewPlaylist = true
+			a.state.NewPlaylistName = ""
+			return a, nil
+		}
+		if a.state.SelectedPickerIndex < len(a.state.Playlists) {
+			playlist := a.state.Playlists[a.state.SelectedPickerIndex]
+			tracks := a.state.PlaylistPickerTracks
+			a.state.ShowPlaylistPicker = false
+			a.state.PlaylistPickerTracks = nil
+			a.state.SelectedPickerIndex = 0
+			return a, a.addTracksToPlaylist(playlist, tracks)
+		}
+	}
+	return a, nil
+}
+
+// PlaylistAddResult represents the result of appending tracks to a playlist
+type PlaylistAddResult struct {
+	PlaylistID   string
+	PlaylistName string
+	Added        int
+	Duration     int
+	Error        error
+}
+
+// addTracksToPlaylist appends tracks to a playlist server-side
+func (a *App) addTracksToPlaylist(playlist models.Playlist, tracks []models.Track) tea.Cmd {
+	if a.navidromeClient == nil {
+		return nil
+	}
+
+	ids := make([]string, len(tracks))
+	duration := 0
+	for i, track := range tracks {
+		ids[i] = track.ID
+		duration += track.Duration
+	}
+
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return tea.Cmd(func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		err := a.navidromeClient.UpdatePlaylist(ctx, playlist.ID, ids)
+		return PlaylistAddResult{
+			PlaylistID:   playlist.ID,
+			PlaylistName: playlist.Name,
+			Added:        len(ids),
+			Duration:     duration,
+			Error:        err,
+		}
+	})
+}
+
+// handleNewPlaylistNameKeyPress edits the new playlist's name; Enter creates it with
+// the picker's tracks, Esc goes back to the list
+func (a *App) handleNewPlaylistNameKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		a.state.NamingNewPlaylist = false
+		a.state.NewPlaylistName = ""
+	case "backspace":
+		if a.state.NewPlaylistName != "" {
+			runes := []rune(a.state.NewPlaylistName)
+			a.state.NewPlaylistName = string(runes[:len(runes)-1])
+		}
+	case "enter":
+		name := strings.TrimSpace(a.state.NewPlaylistName)
+		if name == "" {
+			return a, nil
+		}
+		// The server would happily make a second playlist with the same name
+		for i, playlist := range a.state.Playlists {
+			if strings.EqualFold(playlist.Name, name) {
+				a.state.NamingNewPlaylist = false
+				a.state.SelectedPickerIndex = i
+				a.logMessage(fmt.Sprintf("Playlist %s already exists - press Enter to add to it", playlist.Name))
+				return a, nil
 			}
-		} else if a.state.ShowArtistModal && len(a.state.ArtistAlbums) > 0 {
-			// Add all albums from this artist to queue
-			totalTracks := 0
-			for _, album := range a.state.ArtistAlbums {
-				cmd := a.addAlbumToQueue(album)
-				if cmd != nil {
-					// Execute the command to get tracks (this is async, but we'll log the albums)
-					totalTracks += album.TrackCount
-				}
+		}
+		tracks := a.state.PlaylistPickerTracks
+		a.state.ShowPlaylistPicker = false
+		a.state.PlaylistPickerTracks = nil
+		a.state.SelectedPickerIndex = 0
+		a.state.NamingNewPlaylist = false
+		a.state.NewPlaylistName = ""
+		return a, a.createPlaylist(name, tracks)
+	default:
+		if len(msg.Runes) > 0 {
+			a.state.NewPlaylistName += string(msg.Runes)
+		}
+	}
+	return a, nil
+}
+
+// PlaylistCreateResult represents the result of creating a playlist from the picker
+type PlaylistCreateResult struct {
+	Name  string
+	Added int
+	Error error
+}
+
+// createPlaylist creates a playlist server-side holding tracks
+func (a *App) createPlaylist(name string, tracks []models.Track) tea.Cmd {
+	client := a.navidromeClient
+	if client == nil {
+		return nil
+	}
+
+	ids := make([]string, len(tracks))
+	for i, track := range tracks {
+		ids[i] = track.ID
+	}
+
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		_, err := client.CreatePlaylist(ctx, name, ids)
+		return PlaylistCreateResult{Name: name, Added: len(ids), Error: err}
+	}
+}
+
+// handlePlaylistCreateResult reports a new playlist and reloads the playlists
+func (a *App) handlePlaylistCreateResult(msg PlaylistCreateResult) (tea.Model, tea.Cmd) {
+	if msg.Error != nil {
+		a.logMessage(fmt.Sprintf("Failed to create playlist %s: %v", msg.Name, msg.Error))
+		return a, nil
+	}
+	a.logMessage(fmt.Sprintf("Created playlist %s with %d tracks", msg.Name, msg.Added))
+	return a, a.loadPlaylists()
+}
+
+// PlaylistPickerTracksResult carries album tracks fetched for the playlist picker
+type PlaylistPickerTracksResult struct {
+	Tracks []models.Track
+	Error  error
+}
+
+// loadPlaylistPickerAlbum fetches an album's tracks and then opens the playlist picker
+func (a *App) loadPlaylistPickerAlbum(album models.Album) tea.Cmd {
+	if a.navidromeClient == nil {
+		return nil
+	}
+
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return tea.Cmd(func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		resp, err := a.navidromeClient.GetAlbumTracks(ctx, album.ID)
+		if err != nil {
+			return PlaylistPickerTracksResult{Error: err}
+		}
+		return PlaylistPickerTracksResult{Tracks: convertSongs(resp.SubsonicResponse.SongsByGenre.Song)}
+	})
+}
+
+// convertSongs converts Navidrome songs to our track model
+func convertSongs(songs []navidrome.Song) []models.Track {
+	tracks := make([]models.Track, len(songs))
+	for i, song := range songs {
+		tracks[i] = models.Track{
+			ID:       song.ID,
+			Title:    song.Title,
+			Artist:   song.Artist,
+			ArtistID: song.ArtistID,
+			Album:    song.Album,
+			AlbumID:  song.AlbumID,
+			Genre:    song.Genre,
+			Year:     song.Year,
+			Duration: song.Duration,
+			Track:    song.Track,
+			Disc:     song.DiscNumber,
+			Size:     song.Size,
+			Suffix:   song.Suffix,
+			BitRate:  song.BitRate,
+			Path:     song.Path,
+			UserRating: song.UserRating,
+			RecordingMBID: song.MusicBrainzID,
+			ArtistMBIDs: song.ArtistMBIDs(),
+		}
+	}
+	return tracks
+}
+
+// selectedSearchItem returns the album or track under the search selection, if any
+func (a *App) selectedSearchItem() (*models.Album, *models.Track) {
+	results := a.state.SearchResults
+	index := a.state.SelectedSearchIndex
+
+	// Skip artists and their MORE button
+	index -= len(results.Artists)
+	if results.MoreArtists {
+		index--
+	}
+	if index < 0 {
+		return nil, nil
+	}
+
+	if index < len(results.Albums) {
+		return &results.Albums[index], nil
+	}
+	index -= len(results.Albums)
+	if results.MoreAlbums {
+		index--
+	}
+
+	if index >= 0 && index < len(results.Tracks) {
+		return nil, &results.Tracks[index]
+	}
+	return nil, nil
+}
+
+// handleLogModalKeyPress handles scrolling and filtering in the log history modal
+func (a *App) handleLogModalKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Filter input mode: keystrokes edit the filter
+	if a.state.EditingLogFilter {
+		switch msg.String() {
+		case "esc":
+			a.state.EditingLogFilter = false
+			a.state.LogFilter = ""
+		case "enter":
+			a.state.EditingLogFilter = false
+		case "backspace":
+			if a.state.LogFilter != "" {
+				runes := []rune(a.state.LogFilter)
+				a.state.LogFilter = string(runes[:len(runes)-1])
 			}
-			a.logMessage(fmt.Sprintf("Queued %d albums (~%d tracks)", len(a.state.ArtistAlbums), totalTracks))
-		} else if a.state.ShowPlaylistModal && len(a.state.PlaylistTracks) > 0 {
-			// Add all playlist tracks to queue
-			if a.audioManager != nil {
-				a.audioManager.AddTracksToQueue(a.state.PlaylistTracks)
-				// State will be updated via the audio manager callback
-				a.logMessage(fmt.Sprintf("Added %d tracks to queue", len(a.state.PlaylistTracks)))
-			} else {
-				a.state.Queue = append(a.state.Queue, a.state.PlaylistTracks...)
-				a.logMessage(fmt.Sprintf("Added %d tracks to queue (total: %d)", len(a.state.PlaylistTracks), len(a.state.Queue)))
+		default:
+			if len(msg.Runes) > 0 {
+				a.state.LogFilter += string(msg.Runes)
 			}
 		}
+		a.state.SelectedLogIndex = len(a.state.FilteredLogMessages()) - 1
+		if a.state.SelectedLogIndex < 0 {
+			a.state.SelectedLogIndex = 0
+		}
+		return a, nil
 	}
 
+	count := len(a.state.FilteredLogMessages())
+	switch msg.String() {
+	case "esc", "q", "alt+l":
+		// Close log history
+		a.state.ShowLogModal = false
+		a.state.LogFilter = ""
+		a.state.SelectedLogIndex = 0
+		return a, nil
+	case "/":
+		// Start filtering
+		a.state.EditingLogFilter = true
+		return a, nil
+	case "up":
+		if a.state.SelectedLogIndex > 0 {
+			a.state.SelectedLogIndex--
+		}
+	case "down":
+		if a.state.SelectedLogIndex < count-1 {
+			a.state.SelectedLogIndex++
+		}
+	case "pgup":
+		a.state.SelectedLogIndex -= 25
+		if a.state.SelectedLogIndex < 0 {
+			a.state.SelectedLogIndex = 0
+		}
+	case "pgdown":
+		a.state.SelectedLogIndex += 25
+		if a.state.SelectedLogIndex > count-1 {
+			a.state.SelectedLogIndex = count - 1
+		}
+	case "home":
+		a.state.SelectedLogIndex = 0
+	case "end":
+		a.state.SelectedLogIndex = count - 1
+	}
+	if a.state.SelectedLogIndex < 0 {
+		a.state.SelectedLogIndex = 0
+	}
 	return a, nil
 }
 
@@ -2390,12 +4132,24 @@ func (a *App) handleSearchModalKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		a.state.SearchAlbumsOffset = 0
 		a.state.SearchTracksOffset = 0
 		return a, nil
-	case "enter":
-		// Handle search result selection - Play and queue remaining
-		return a.handleSearchSelection(false)
-	case "shift+enter":
-		// Handle search result selection - Queue only
-		return a.handleSearchSelection(true)
+	case "enter", "shift+enter":
+		// Handle search result selection - Play and queue remaining, or queue only
+		return a.handleSearchSelection(a.queueOnlyKey(msg.String()))
+	case "ctrl+p":
+		// Add selected track or album to a playlist
+		album, track := a.selectedSearchItem()
+		if track != nil {
+			return a, a.openPlaylistPicker([]models.Track{*track})
+		}
+		if album != nil {
+			return a, a.loadPlaylistPickerAlbum(*album)
+		}
+		return a, nil
+	case "tab":
+		// Cycle search scope: All → Artists → Albums → Tracks
+		a.state.SearchScope = a.state.SearchScope.Next()
+		a.state.SelectedSearchIndex = 0
+		return a, a.performSearch()
 	case "up":
 		// Navigate up in search results
 		if a.state.SelectedSearchIndex > 0 {
@@ -2407,13 +4161,13 @@ func (a *App) handleSearchModalKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		totalResults := len(a.state.SearchResults.Artists) + len(a.state.SearchResults.Albums) + len(a.state.SearchResults.Tracks)
 		
 		// Add MORE buttons to total count
-		if len(a.state.SearchResults.Artists) == 5 {
+		if a.state.SearchResults.MoreArtists {
 			totalResults++ // Add MORE artists button
 		}
-		if len(a.state.SearchResults.Albums) == 5 {
+		if a.state.SearchResults.MoreAlbums {
 			totalResults++ // Add MORE albums button  
 		}
-		if len(a.state.SearchResults.Tracks) == 5 {
+		if a.state.SearchResults.MoreTracks {
 			totalResults++ // Add MORE tracks button
 		}
 		
@@ -2502,7 +4256,7 @@ func (a *App) applySorting() (tea.Model, tea.Cmd) {
 	// Apply sorting based on context and option - return command for async operation
 	switch currentContext {
 	case "albums":
-		return a, a.sortAlbumsAsync(selectedOption.ID)
+		return a, a.sortAlbumsAsync(selectedOption.ID, false)
 	case "artists":
 		return a, a.sortArtistsAsync(selectedOption.ID)
 	case "playlists":
@@ -2515,88 +4269,67 @@ func (a *App) applySorting() (tea.Model, tea.Cmd) {
 // performSearch performs the actual search with a timeout
 func (a *App) performSearch() tea.Cmd {
 	if a.navidromeClient == nil || len(a.state.SearchQuery) == 0 {
-		// Clear results if no query
+		// Clear results if no query and drop any in-flight search
+		a.searchSeq++
 		a.state.SearchResults = models.SearchResults{}
 		a.state.LoadingSearchResults = false
 		return nil
 	}
 
+	// Each keystroke starts a new search generation; only the latest one fires
+	a.searchSeq++
+	seq := a.searchSeq
 	query := a.state.SearchQuery
 	a.state.LoadingSearchResults = true
 
-	return tea.Cmd(func() tea.Msg {
-		// Add a small delay to allow for more typing (debounce)
-		time.Sleep(300 * time.Millisecond)
-		
-		// Check if query has changed (debounce logic)
-		if query != a.state.SearchQuery {
-			return SearchResult{Results: models.SearchResults{}, Error: nil}
-		}
+	return tea.Tick(300*time.Millisecond, func(time.Time) tea.Msg {
+		return SearchDebounceMsg{Seq: seq, Query: query}
+	})
+}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-		defer cancel()
+// SearchDebounceMsg fires when typing has paused long enough to search
+type SearchDebounceMsg struct {
+	Seq   int
+	Query string
+}
 
-		// Limit to 5 results per section for initial search
-		resp, err := a.navidromeClient.Search(ctx, query, 5, 5, 5) // 5 artists, 5 albums, 5 tracks
-		if err != nil {
-			return SearchResult{Error: err}
-		}
+// runSearch performs the search request for a debounced query
+func (a *App) runSearch(seq int, query string) tea.Cmd {
+	client := a.navidromeClient
+	if client == nil {
+		return nil
+	}
 
-		// Convert Navidrome search results to our models
-		results := models.SearchResults{
-			Artists: make([]models.Artist, len(resp.SubsonicResponse.SearchResult3.Artist)),
-			Albums:  make([]models.Album, len(resp.SubsonicResponse.SearchResult3.Album)),
-			Tracks:  make([]models.Track, len(resp.SubsonicResponse.SearchResult3.Song)),
-		}
+	// Limit to one page per section for initial search; 0 excludes out-of-scope sections
+	size := models.SearchPageSize
+	scope := a.state.SearchScope
+	var artistCount, albumCount, songCount int
+	if scope.Includes("artists") {
+		artistCount = size
+	}
+	if scope.Includes("albums") {
+		albumCount = size
+	}
+	if scope.Includes("tracks") {
+		songCount = size
+	}
 
-		// Convert artists
-		for i, artist := range resp.SubsonicResponse.SearchResult3.Artist {
-			results.Artists[i] = models.Artist{
-				ID:         artist.ID,
-				Name:       artist.Name,
-				AlbumCount: artist.AlbumCount,
-				StarredAt:  artist.Starred,
-			}
-		}
+	timeout := a.state.ConfigForm.Config.Timeouts.SearchTimeout()
+	return tea.Cmd(func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
 
-		// Convert albums
-		for i, album := range resp.SubsonicResponse.SearchResult3.Album {
-			results.Albums[i] = models.Album{
-				ID:         album.ID,
-				Name:       album.Name,
-				Artist:     album.Artist,
-				ArtistID:   album.ArtistID,
-				Year:       album.Year,
-				Genre:      album.Genre,
-				Duration:   album.Duration,
-				TrackCount: album.SongCount,
-				CreatedAt:  album.Created,
-				CoverArt:   album.CoverArt,
-			}
+		resp, err := client.Search(ctx, query, artistCount, albumCount, songCount)
+		if err != nil {
+			return SearchResult{Seq: seq, Error: err}
 		}
 
-		// Convert tracks
-		for i, song := range resp.SubsonicResponse.SearchResult3.Song {
-			results.Tracks[i] = models.Track{
-				ID:       song.ID,
-				Title:    song.Title,
-				Artist:   song.Artist,
-				ArtistID: song.ArtistID,
-				Album:    song.Album,
-				AlbumID:  song.AlbumID,
-				Genre:    song.Genre,
-				Year:     song.Year,
-				Duration: song.Duration,
-				Track:    song.Track,
-				Disc:     song.DiscNumber,
-				Size:     song.Size,
-				Suffix:   song.Suffix,
-				BitRate:  song.BitRate,
-				Path:     song.Path,
-			}
-		}
+		results := convertSearchResults(resp)
+		results.MoreArtists = len(results.Artists) == size
+		results.MoreAlbums = len(results.Albums) == size
+		results.MoreTracks = len(results.Tracks) == size
 
-		return SearchResult{Results: results, Error: nil}
+		return SearchResult{Seq: seq, Results: results, Error: nil}
 	})
 }
 
@@ -2619,10 +4352,10 @@ func (a *App) handleSearchSelection(queueOnly bool) (tea.Model, tea.Cmd) {
 	currentIndex += totalArtists
 	
 	// Check artists MORE button
-	if totalArtists == 5 && selectedIndex == currentIndex {
+	if a.state.SearchResults.MoreArtists && selectedIndex == currentIndex {
 		return a, a.loadMoreSearchResults("artists")
 	}
-	if totalArtists == 5 {
+	if a.state.SearchResults.MoreArtists {
 		currentIndex++
 	}
 	
@@ -2637,10 +4370,10 @@ func (a *App) handleSearchSelection(queueOnly bool) (tea.Model, tea.Cmd) {
 	currentIndex += totalAlbums
 	
 	// Check albums MORE button
-	if totalAlbums == 5 && selectedIndex == currentIndex {
+	if a.state.SearchResults.MoreAlbums && selectedIndex == currentIndex {
 		return a, a.loadMoreSearchResults("albums")
 	}
-	if totalAlbums == 5 {
+	if a.state.SearchResults.MoreAlbums {
 		currentIndex++
 	}
 	
@@ -2660,11 +4393,8 @@ func (a *App) handleSearchSelection(queueOnly bool) (tea.Model, tea.Cmd) {
 				remainingTracks := a.state.SearchResults.Tracks[trackIndex:]
 				
 				if a.audioManager != nil {
-					// Clear current queue and add the track selection
-					a.audioManager.ClearQueue()
-					a.audioManager.AddTracksToQueue(remainingTracks)
-					// Start playing the first track (selected one)
-					a.audioManager.PlayTrackAtIndex(0)
+					// Replace the queue with the selection and play the selected track
+					a.audioManager.ReplaceQueue(remainingTracks, true)
 					
 					// Log the action for user feedback
 					a.logMessage(fmt.Sprintf("Playing: %s - %s (%d tracks queued from search)", 
@@ -2685,7 +4415,7 @@ func (a *App) handleSearchSelection(queueOnly bool) (tea.Model, tea.Cmd) {
 	currentIndex += totalTracks
 	
 	// Check tracks MORE button
-	if totalTracks == 5 && selectedIndex == currentIndex {
+	if a.state.SearchResults.MoreTracks && selectedIndex == currentIndex {
 		return a, a.loadMoreSearchResults("tracks")
 	}
 	
@@ -2694,6 +4424,7 @@ func (a *App) handleSearchSelection(queueOnly bool) (tea.Model, tea.Cmd) {
 
 // SearchMoreResult represents the result of loading more search results
 type SearchMoreResult struct {
+	Seq     int // The searchSeq the page was requested under
 	Section string
 	Artists []models.Artist
 	Albums  []models.Album
@@ -2701,120 +4432,121 @@ type SearchMoreResult struct {
 	Error   error
 }
 
-// loadMoreSearchResults loads the next 5 results for the specified section
+// loadMoreSearchResults loads the next page of results for the specified section.
+// It does nothing while a search or page is loading, so the same offset is never
+// requested twice.
 func (a *App) loadMoreSearchResults(section string) tea.Cmd {
-	if a.navidromeClient == nil || len(a.state.SearchQuery) == 0 {
+	client := a.navidromeClient
+	if client == nil || len(a.state.SearchQuery) == 0 || a.state.LoadingSearchResults {
 		return nil
 	}
 
+	seq := a.searchSeq
 	query := a.state.SearchQuery
 	a.state.LoadingSearchResults = true
 
+	// Request only the section being expanded, starting at its current offset
+	size := models.SearchPageSize
+	var artistCount, albumCount, songCount int
+	var artistOffset, albumOffset, songOffset int
+	switch section {
+	case "artists":
+		artistCount, artistOffset = size, a.state.SearchArtistsOffset
+	case "albums":
+		albumCount, albumOffset = size, a.state.SearchAlbumsOffset
+	case "tracks":
+		songCount, songOffset = size, a.state.SearchTracksOffset
+	}
+
+	timeout := a.state.ConfigForm.Config.Timeouts.SearchTimeout()
 	return tea.Cmd(func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
-
-		// For now, we'll simulate offset by searching with higher limits and taking only the new results
-		// This is a simplification - ideally the Navidrome client would support offset parameters
-		totalArtistsNeeded := len(a.state.SearchResults.Artists)
-		totalAlbumsNeeded := len(a.state.SearchResults.Albums) 
-		totalTracksNeeded := len(a.state.SearchResults.Tracks)
-		
-		if section == "artists" {
-			totalArtistsNeeded += 5
-		}
-		if section == "albums" {
-			totalAlbumsNeeded += 5
-		}
-		if section == "tracks" {
-			totalTracksNeeded += 5
-		}
-
-		resp, err := a.navidromeClient.Search(ctx, query, totalArtistsNeeded, totalAlbumsNeeded, totalTracksNeeded)
+		resp, err := client.SearchPaged(ctx, query,
+			artistCount, artistOffset, albumCount, albumOffset, songCount, songOffset)
 		if err != nil {
-			return SearchMoreResult{Section: section, Error: err}
-		}
-
-		// Extract only the new results
-		var newArtists []models.Artist
-		var newAlbums []models.Album
-		var newTracks []models.Track
-
-		switch section {
-		case "artists":
-			// Get artists beyond what we already have
-			startIdx := len(a.state.SearchResults.Artists)
-			if len(resp.SubsonicResponse.SearchResult3.Artist) > startIdx {
-				for i := startIdx; i < len(resp.SubsonicResponse.SearchResult3.Artist); i++ {
-					artist := resp.SubsonicResponse.SearchResult3.Artist[i]
-					newArtists = append(newArtists, models.Artist{
-						ID:         artist.ID,
-						Name:       artist.Name,
-						AlbumCount: artist.AlbumCount,
-						StarredAt:  artist.Starred,
-					})
-				}
-			}
-		case "albums":
-			// Get albums beyond what we already have
-			startIdx := len(a.state.SearchResults.Albums)
-			if len(resp.SubsonicResponse.SearchResult3.Album) > startIdx {
-				for i := startIdx; i < len(resp.SubsonicResponse.SearchResult3.Album); i++ {
-					album := resp.SubsonicResponse.SearchResult3.Album[i]
-					newAlbums = append(newAlbums, models.Album{
-						ID:         album.ID,
-						Name:       album.Name,
-						Artist:     album.Artist,
-						ArtistID:   album.ArtistID,
-						Year:       album.Year,
-						Genre:      album.Genre,
-						Duration:   album.Duration,
-						TrackCount: album.SongCount,
-						CreatedAt:  album.Created,
-						CoverArt:   album.CoverArt,
-					})
-				}
-			}
-		case "tracks":
-			// Get tracks beyond what we already have
-			startIdx := len(a.state.SearchResults.Tracks)
-			if len(resp.SubsonicResponse.SearchResult3.Song) > startIdx {
-				for i := startIdx; i < len(resp.SubsonicResponse.SearchResult3.Song); i++ {
-					song := resp.SubsonicResponse.SearchResult3.Song[i]
-					newTracks = append(newTracks, models.Track{
-						ID:       song.ID,
-						Title:    song.Title,
-						Artist:   song.Artist,
-						ArtistID: song.ArtistID,
-						Album:    song.Album,
-						AlbumID:  song.AlbumID,
-						Genre:    song.Genre,
-						Year:     song.Year,
-						Duration: song.Duration,
-						Track:    song.Track,
-						Disc:     song.DiscNumber,
-						Size:     song.Size,
-						Suffix:   song.Suffix,
-						BitRate:  song.BitRate,
-						Path:     song.Path,
-					})
-				}
-			}
+			return SearchMoreResult{Seq: seq, Section: section, Error: err}
 		}
 
+		results := convertSearchResults(resp)
 		return SearchMoreResult{
+			Seq:     seq,
 			Section: section,
-			Artists: newArtists,
-			Albums:  newAlbums,
-			Tracks:  newTracks,
+			Artists: results.Artists,
+			Albums:  results.Albums,
+			Tracks:  results.Tracks,
 			Error:   nil,
 		}
 	})
 }
 
-// sortAlbumsAsync sorts albums using Navidrome API calls for accurate sorting
-func (a *App) sortAlbumsAsync(sortBy string) tea.Cmd {
+// convertSearchResults converts a search3 response to our models
+func convertSearchResults(resp *navidrome.SearchResponse) models.SearchResults {
+	results := models.SearchResults{
+		Artists: make([]models.Artist, len(resp.SubsonicResponse.SearchResult3.Artist)),
+		Albums:  make([]models.Album, len(resp.SubsonicResponse.SearchResult3.Album)),
+		Tracks:  make([]models.Track, len(resp.SubsonicResponse.SearchResult3.Song)),
+	}
+
+	// Convert artists
+	for i, artist := range resp.SubsonicResponse.SearchResult3.Artist {
+		results.Artists[i] = models.Artist{
+			ID:         artist.ID,
+			Name:       artist.Name,
+			AlbumCount: artist.AlbumCount,
+			StarredAt:  artist.Starred,
+		}
+	}
+
+	// Convert albums
+	for i, album := range resp.SubsonicResponse.SearchResult3.Album {
+		results.Albums[i] = models.Album{
+			ID:         album.ID,
+			Name:       album.Name,
+			Artist:     album.Artist,
+			ArtistID:   album.ArtistID,
+			Year:       album.Year,
+			Genre:      album.Genre,
+			Duration:   album.Duration,
+			TrackCount: album.SongCount,
+			CreatedAt:  album.Created,
+			CoverArt:   album.CoverArt,
+			UserRating: album.UserRating,
+			MusicBrainzID: album.MusicBrainzID,
+		}
+	}
+
+	// Convert tracks
+	for i, song := range resp.SubsonicResponse.SearchResult3.Song {
+		results.Tracks[i] = models.Track{
+			ID:       song.ID,
+			Title:    song.Title,
+			Artist:   song.Artist,
+			ArtistID: song.ArtistID,
+			Album:    song.Album,
+			AlbumID:  song.AlbumID,
+			Genre:    song.Genre,
+			Year:     song.Year,
+			Duration: song.Duration,
+			Track:    song.Track,
+			Disc:     song.DiscNumber,
+			Size:     song.Size,
+			Suffix:   song.Suffix,
+			BitRate:  song.BitRate,
+			Path:     song.Path,
+			UserRating: song.UserRating,
+			RecordingMBID: song.MusicBrainzID,
+			ArtistMBIDs: song.ArtistMBIDs(),
+		}
+	}
+
+	return results
+}
+
+// sortAlbumsAsync sorts albums using Navidrome API calls for accurate sorting.
+// keepSelection keeps the selected album selected, for re-sorting after a reload.
+func (a *App) sortAlbumsAsync(sortBy string, keepSelection bool) tea.Cmd {
 	if a.navidromeClient == nil {
 		return nil
 	}
@@ -2822,31 +4554,27 @@ func (a *App) sortAlbumsAsync(sortBy string) tea.Cmd {
 	a.state.LoadingAlbums = true
 	a.state.LoadingError = ""
 
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	newDays := a.state.ConfigForm.Config.UI.NewBadgeDays
+	if newDays == 0 {
+		newDays = config.DefaultNewBadgeDays
+	}
 	return tea.Cmd(func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
-		var albumType string
-		switch sortBy {
-		case "alpha":
-			albumType = "alphabeticalByName"
-		case "album_artist":
-			albumType = "alphabeticalByArtist"
-		case "date_added":
-			albumType = "newest"
-		case "play_count":
-			// Play count sorting filters to only played albums using "frequent", so use in-memory sorting instead
-			return AlbumsSortResult{SortBy: sortBy, UseInMemorySort: true}
-		case "year":
-			// Year sorting not directly supported by API, fallback to in-memory
-			return AlbumsSortResult{SortBy: sortBy, UseInMemorySort: true}
-		default:
-			albumType = "alphabeticalByName"
+		albumType := albumListType(sortBy)
+		if albumType == "" {
+			return AlbumsSortResult{SortBy: sortBy, UseInMemorySort: true, KeepSelection: keepSelection}
 		}
 
 		// Load ALL albums for sorting
 		resp, err := a.navidromeClient.GetAlbumsByType(ctx, albumType, 10000, 0)
 		if err != nil {
+			if sortBy == "year" || sortBy == "rating" {
+				// Older servers may lack these list types; sort what is loaded instead
+				return AlbumsSortResult{SortBy: sortBy, UseInMemorySort: true, KeepSelection: keepSelection}
+			}
 			return AlbumsSortResult{Error: err, SortBy: sortBy}
 		}
 
@@ -2865,44 +4593,94 @@ func (a *App) sortAlbumsAsync(sortBy string) tea.Cmd {
 				PlayCount:  album.PlayCount,
 				CreatedAt:  album.Created,
 				CoverArt:   album.CoverArt,
+				UserRating: album.UserRating,
+				MusicBrainzID: album.MusicBrainzID,
 			}
 		}
 
-		return AlbumsSortResult{Albums: albums, SortBy: sortBy}
+		if sortBy == "new" {
+			albums = recentAlbums(albums, newDays, time.Now())
+		}
+
+		return AlbumsSortResult{Albums: albums, SortBy: sortBy, KeepSelection: keepSelection}
 	})
 }
 
+// albumListType returns the getAlbumList2 type that lists albums in sortBy's
+// order, or "" for sorts only done in memory
+func albumListType(sortBy string) string {
+	switch sortBy {
+	case "album_artist":
+		return "alphabeticalByArtist"
+	case "date_added":
+		return "newest"
+	case "play_count":
+		// "frequent" leaves out albums never played, so sort what is loaded instead
+		return ""
+	case "year":
+		return "byYear" // Newest first
+	case "rating":
+		return "highest"
+	case "starred":
+		return "starred"
+	case "new":
+		return "newest" // Then cut off at ui.new_badge_days
+	default:
+		return "alphabeticalByName"
+	}
+}
+
+// recentAlbums keeps the albums added within days, in order
+func recentAlbums(albums []models.Album, days int, now time.Time) []models.Album {
+	var recent []models.Album
+	for _, album := range albums {
+		if album.AddedWithin(days, now) {
+			recent = append(recent, album)
+		}
+	}
+	return recent
+}
+
 // AlbumsSortResult represents the result of an album sort operation
 type AlbumsSortResult struct {
 	Albums          []models.Album
 	SortBy          string
 	UseInMemorySort bool // Flag to indicate fallback to in-memory sorting
+	KeepSelection   bool // Re-sorting a reload rather than a sort picked from the menu
 	Error           error
 }
 
+// reapplyAlbumSort sorts freshly loaded albums the way they were last sorted: in
+// memory when that is how the sort was applied, otherwise by asking the server
+// for its list again (albums load newest first, so that sort needs nothing)
+func (a *App) reapplyAlbumSort() tea.Cmd {
+	sortBy := a.state.ActiveSorts["albums"]
+	switch {
+	case sortBy == "" || sortBy == "date_added":
+		return nil
+	case a.albumSortInMemory || albumListType(sortBy) == "":
+		a.sortAlbumsInMemory(sortBy)
+		return nil
+	default:
+		return a.sortAlbumsAsync(sortBy, true)
+	}
+}
+
 // sortAlbumsInMemory sorts albums in memory (fallback for API-unsupported sorts)
 func (a *App) sortAlbumsInMemory(sortBy string) {
 	albums := a.state.Albums
 	switch sortBy {
 	case "year":
-		// Sort by year (descending - newest first)
-		for i := 0; i < len(albums)-1; i++ {
-			for j := 0; j < len(albums)-i-1; j++ {
-				if albums[j].Year < albums[j+1].Year {
-					albums[j], albums[j+1] = albums[j+1], albums[j]
-				}
-			}
-		}
+		// Newest first
+		sort.SliceStable(albums, func(i, j int) bool { return albums[i].Year > albums[j].Year })
 	case "play_count":
-		// Sort by play count (descending - most played first)
-		// This includes albums with 0 play count, unlike API "frequent" sort
-		for i := 0; i < len(albums)-1; i++ {
-			for j := 0; j < len(albums)-i-1; j++ {
-				if albums[j].PlayCount < albums[j+1].PlayCount {
-					albums[j], albums[j+1] = albums[j+1], albums[j]
-				}
-			}
-		}
+		// Most played first, including albums with no plays, unlike the API's "frequent"
+		sort.SliceStable(albums, func(i, j int) bool { return albums[i].PlayCount > albums[j].PlayCount })
+	case "rating":
+		// Highest rated first
+		sort.SliceStable(albums, func(i, j int) bool {
+			return a.state.Rating(albums[i].ID, albums[i].UserRating) > a.state.Rating(albums[j].ID, albums[j].UserRating)
+		})
 	// Add other fallback sorts if needed
 	}
 	
@@ -2930,32 +4708,13 @@ func (a *App) sortArtistsInMemory(sortBy string) {
 	artists := a.state.Artists
 	switch sortBy {
 	case "alpha":
-		// Sort alphabetically by artist name
-		for i := 0; i < len(artists)-1; i++ {
-			for j := 0; j < len(artists)-i-1; j++ {
-				if artists[j].Name > artists[j+1].Name {
-					artists[j], artists[j+1] = artists[j+1], artists[j]
-				}
-			}
-		}
+		sort.SliceStable(artists, func(i, j int) bool { return artists[i].Name < artists[j].Name })
 	case "play_count":
-		// Sort by play count (descending - most played first)
-		for i := 0; i < len(artists)-1; i++ {
-			for j := 0; j < len(artists)-i-1; j++ {
-				if artists[j].PlayCount < artists[j+1].PlayCount {
-					artists[j], artists[j+1] = artists[j+1], artists[j]
-				}
-			}
-		}
+		// Most played first
+		sort.SliceStable(artists, func(i, j int) bool { return artists[i].PlayCount > artists[j].PlayCount })
 	case "date_added":
 		// For artists, sort by album count as a proxy for date added
-		for i := 0; i < len(artists)-1; i++ {
-			for j := 0; j < len(artists)-i-1; j++ {
-				if artists[j].AlbumCount < artists[j+1].AlbumCount {
-					artists[j], artists[j+1] = artists[j+1], artists[j]
-				}
-			}
-		}
+		sort.SliceStable(artists, func(i, j int) bool { return artists[i].AlbumCount > artists[j].AlbumCount })
 	}
 	
 	// Reset selection to the beginning after sorting
@@ -2982,32 +4741,13 @@ func (a *App) sortPlaylistsInMemory(sortBy string) {
 	playlists := a.state.Playlists
 	switch sortBy {
 	case "alpha":
-		// Sort alphabetically by playlist name
-		for i := 0; i < len(playlists)-1; i++ {
-			for j := 0; j < len(playlists)-i-1; j++ {
-				if playlists[j].Name > playlists[j+1].Name {
-					playlists[j], playlists[j+1] = playlists[j+1], playlists[j]
-				}
-			}
-		}
+		sort.SliceStable(playlists, func(i, j int) bool { return playlists[i].Name < playlists[j].Name })
 	case "date_added":
-		// Sort by creation date (descending - newest first)
-		for i := 0; i < len(playlists)-1; i++ {
-			for j := 0; j < len(playlists)-i-1; j++ {
-				if playlists[j].CreatedAt.Before(playlists[j+1].CreatedAt) {
-					playlists[j], playlists[j+1] = playlists[j+1], playlists[j]
-				}
-			}
-		}
+		// Newest first
+		sort.SliceStable(playlists, func(i, j int) bool { return playlists[i].CreatedAt.After(playlists[j].CreatedAt) })
 	case "play_count":
 		// Sort by song count as a proxy for activity level
-		for i := 0; i < len(playlists)-1; i++ {
-			for j := 0; j < len(playlists)-i-1; j++ {
-				if playlists[j].SongCount < playlists[j+1].SongCount {
-					playlists[j], playlists[j+1] = playlists[j+1], playlists[j]
-				}
-			}
-		}
+		sort.SliceStable(playlists, func(i, j int) bool { return playlists[i].SongCount > playlists[j].SongCount })
 	}
 	
 	// Reset selection to the beginning after sorting  
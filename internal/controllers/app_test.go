@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"navitone-cli/internal/audio"
+	"navitone-cli/internal/config"
+	"navitone-cli/internal/models"
+	"navitone-cli/internal/views"
+	"navitone-cli/pkg/navidrome"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestApp returns an app with the default config, no server and no audio,
+// sized like a typical terminal
+func newTestApp(t *testing.T) *App {
+	t.Helper()
+	cfg := config.DefaultConfig()
+	state := &models.AppState{
+		CurrentTab:  models.HomeTab,
+		Volume:      cfg.Audio.Volume,
+		ConfigForm:  models.NewConfigFormState(cfg),
+		ActiveSorts: map[string]string{},
+	}
+	theme := views.NewTheme(cfg.UI.Theme, cfg.UI.AccentIndex)
+	app := &App{state: state}
+	app.view = views.NewMainViewWithDirectTheme(state, theme, views.NewThemedStyles(theme))
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	return app
+}
+
+// fakeBackend is an audio backend that only delivers events; calling anything
+// else panics
+type fakeBackend struct {
+	audio.AudioBackend
+	events chan models.PlaybackEvent
+}
+
+func (f *fakeBackend) Events() <-chan models.PlaybackEvent { return f.events }
+
+// TestPlaybackEventsWhileRendering feeds playback events from several goroutines
+// while the UI loop applies and renders them; run with -race
+func TestPlaybackEventsWhileRendering(t *testing.T) {
+	const producers, perProducer = 4, 50
+	app := newTestApp(t)
+	backend := &fakeBackend{events: make(chan models.PlaybackEvent, models.PlaybackEventBuffer)}
+	app.audioManager = backend
+	app.state.CurrentTab = models.QueueTab
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				if i%10 == 0 {
+					backend.events <- models.PlaybackEvent{Log: fmt.Sprintf("producer %d event %d", p, i)}
+					continue
+				}
+				queue := make([]models.Track, i%7+1)
+				for j := range queue {
+					queue[j] = models.Track{ID: fmt.Sprintf("%d-%d", p, j), Title: fmt.Sprintf("Track %d", j), Artist: "Artist", Duration: 180}
+				}
+				current := queue[0]
+				backend.events <- models.PlaybackEvent{State: &models.PlaybackState{
+					Queue:        queue,
+					CurrentTrack: &current,
+					IsPlaying:    i%2 == 0,
+					Speed:        1,
+				}}
+			}
+		}(p)
+	}
+
+	for n := 0; n < producers*perProducer; n++ {
+		app.Update(listenForPlaybackEvents(backend.Events())())
+		if app.View() == "" {
+			t.Fatal("View rendered nothing")
+		}
+	}
+	wg.Wait()
+
+	if app.state.CurrentTrack == nil || len(app.state.Queue) == 0 {
+		t.Fatal("playback state was not applied")
+	}
+}
+
+// closingBackend counts Close calls and fails any use after the first
+type closingBackend struct {
+	fakeBackend
+	closes int
+}
+
+func (c *closingBackend) GetPosition() time.Duration {
+	if c.closes > 0 {
+		panic("position read after Close")
+	}
+	return 5 * time.Minute
+}
+
+func (c *closingBackend) Close() error {
+	c.closes++
+	return nil
+}
+
+// TestCleanupOnce checks that quitting and main both cleaning up saves the
+// bookmark and closes the backend only once
+func TestCleanupOnce(t *testing.T) {
+	var bookmarks atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/createBookmark" {
+			bookmarks.Add(1)
+		}
+		fmt.Fprint(w, `{"subsonic-response":{"status":"ok"}}`)
+	}))
+	defer server.Close()
+
+	app := newTestApp(t)
+	app.state.ConfigForm.Config.Behavior.RestoreSession = false
+	app.navidromeClient = navidrome.NewClient(server.URL, "user", "pass")
+	backend := &closingBackend{}
+	app.audioManager = backend
+	episode := models.Track{ID: "ep1", Title: "Episode", Duration: 2 * resumeMinDuration}
+	app.state.CurrentTrack = &episode
+	app.resumeTrack = episode
+
+	app.cleanup()
+	app.Cleanup()
+
+	if backend.closes != 1 {
+		t.Errorf("backend closed %d times, want 1", backend.closes)
+	}
+	if n := bookmarks.Load(); n != 1 {
+		t.Errorf("bookmark saved %d times, want 1", n)
+	}
+}
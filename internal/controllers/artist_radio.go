@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Artist radio mix: songs from the seed artist fill every radioSeedEvery-th slot
+// and the similar artists the rest, so the seed is heard without taking over
+const (
+	radioSimilarArtists  = 8
+	radioSeedTracks      = 10
+	radioTracksPerArtist = 5
+	radioSeedEvery       = 3
+)
+
+// ArtistRadioResult carries the mixed queue for an artist radio
+type ArtistRadioResult struct {
+	Seed    models.Artist
+	Similar []string // Names of the similar artists that contributed tracks
+	Tracks  []models.Track
+	Error   error
+}
+
+// startArtistRadio builds a queue from the artist's top songs and songs by
+// similar artists in the background
+func (a *App) startArtistRadio(seed models.Artist) tea.Cmd {
+	client := a.navidromeClient
+	if client == nil {
+		return nil
+	}
+
+	a.logMessage(fmt.Sprintf("Building artist radio from %s...", seed.Name))
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		seedTracks, err := artistSongs(ctx, client, seed.ID, seed.Name, radioSeedTracks)
+		if err != nil {
+			return ArtistRadioResult{Seed: seed, Error: err}
+		}
+
+		similar, err := client.GetSimilarArtists(ctx, seed.ID, radioSimilarArtists)
+		if err != nil {
+			return ArtistRadioResult{Seed: seed, Error: err}
+		}
+
+		var names []string
+		var lists [][]models.Track
+		for _, artist := range similar {
+			tracks, err := artistSongs(ctx, client, artist.ID, artist.Name, radioTracksPerArtist)
+			if err != nil || len(tracks) == 0 {
+				continue // A missing artist shouldn't sink the whole radio
+			}
+			names = append(names, artist.Name)
+			lists = append(lists, tracks)
+		}
+
+		return ArtistRadioResult{Seed: seed, Similar: names, Tracks: mixRadio(seedTracks, interleave(lists))}
+	}
+}
+
+// artistSongs returns up to count popular songs by an artist, or random songs from
+// their albums when the server has no top songs for them
+func artistSongs(ctx context.Context, client *navidrome.Client, id, name string, count int) ([]models.Track, error) {
+	if songs, err := client.GetArtistTopSongs(ctx, name, count); err == nil && len(songs) > 0 {
+		return convertSongs(songs), nil
+	}
+
+	resp, err := client.GetArtistTracks(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	tracks := convertSongs(resp.SubsonicResponse.SongsByGenre.Song)
+	rand.Shuffle(len(tracks), func(i, j int) { tracks[i], tracks[j] = tracks[j], tracks[i] })
+	if len(tracks) > count {
+		tracks = tracks[:count]
+	}
+	return tracks, nil
+}
+
+// interleave takes one track from each list in turn, so no artist plays twice in a row
+// while others still have tracks left
+func interleave(lists [][]models.Track) []models.Track {
+	var mixed []models.Track
+	for i := 0; ; i++ {
+		added := false
+		for _, list := range lists {
+			if i < len(list) {
+				mixed = append(mixed, list[i])
+				added = true
+			}
+		}
+		if !added {
+			return mixed
+		}
+	}
+}
+
+// mixRadio starts with a seed track and puts one in every radioSeedEvery slots
+// among the others; seed tracks left over once the others run out are dropped,
+// unless there are no others at all
+func mixRadio(seed, others []models.Track) []models.Track {
+	if len(others) == 0 {
+		return seed
+	}
+
+	mixed := make([]models.Track, 0, len(seed)+len(others))
+	for len(others) > 0 {
+		if len(mixed)%radioSeedEvery == 0 && len(seed) > 0 {
+			mixed = append(mixed, seed[0])
+			seed = seed[1:]
+			continue
+		}
+		mixed = append(mixed, others[0])
+		others = others[1:]
+	}
+	return mixed
+}
+
+// handleArtistRadioResult replaces the queue with the radio and starts playing
+func (a *App) handleArtistRadioResult(msg ArtistRadioResult) (tea.Model, tea.Cmd) {
+	if msg.Error != nil {
+		a.logMessage(fmt.Sprintf("Artist radio from %s failed: %v", msg.Seed.Name, msg.Error))
+		return a, nil
+	}
+	if len(msg.Tracks) == 0 {
+		a.logMessage(fmt.Sprintf("Artist radio: no tracks found for %s", msg.Seed.Name))
+		return a, nil
+	}
+
+	a.state.SelectedQueueIndex = 0
+	a.replaceQueue(msg.Tracks)
+	if len(msg.Similar) == 0 {
+		a.logMessage(fmt.Sprintf("Artist radio from %s: no similar artists on the server, playing %s only (%d tracks)", msg.Seed.Name, msg.Seed.Name, len(msg.Tracks)))
+	} else {
+		a.logMessage(fmt.Sprintf("Artist radio from %s with %s (%d tracks)", msg.Seed.Name, strings.Join(msg.Similar, ", "), len(msg.Tracks)))
+	}
+	return a, nil
+}
@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+
+	"navitone-cli/internal/audio"
+	legacy "navitone-cli/internal/audio/legacy"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// reportAudioBackend logs which backend plays audio and shows it in the Config tab,
+// pointing at installing mpv when it was asked for (or would be picked) but is missing
+func (a *App) reportAudioBackend(requested, backend string) {
+	var status string
+	switch {
+	case backend == audio.BackendMPV:
+		version, err := audio.MPVVersion()
+		if err != nil {
+			version = "(unknown version)"
+		}
+		status = "[OK] Audio backend: mpv " + version
+	case requested == audio.BackendMPV:
+		status = `[!] mpv not found - install mpv or set audio.backend = "oto" (playing through oto for now)`
+	case requested == audio.BackendOto:
+		status = "[OK] Audio backend: oto"
+	default:
+		status = "[i] Audio backend: oto (mpv not found - install mpv for gapless playback and more formats)"
+	}
+
+	a.state.ConfigForm.AudioStatus = status
+	a.logMessage(status)
+}
+
+// checkAudioDevice warns when the configured output device is not available and
+// falls back to the default device
+func (a *App) checkAudioDevice(device string) {
+	if device == "" || device == "auto" || a.audioManager == nil {
+		return
+	}
+
+	devices, err := a.audioManager.ListAudioDevices()
+	if errors.Is(err, legacy.ErrUnsupported) {
+		a.logMessage(fmt.Sprintf("Audio device %q ignored: the oto backend always uses the default output", device))
+		return
+	}
+	if err != nil {
+		a.logMessage(fmt.Sprintf("Could not list audio devices: %v", err))
+		return
+	}
+
+	for _, d := range devices {
+		if d.Name == device {
+			return
+		}
+	}
+
+	a.logMessage(fmt.Sprintf("Warning: audio device %q not found - using the default device", device))
+	if err := a.audioManager.SetAudioDevice(""); err != nil {
+		a.logMessage(fmt.Sprintf("Failed to reset audio device: %v", err))
+	}
+}
+
+// openDevicePicker lists the backend's audio outputs for the Config tab's device field.
+// It reports false when the backend cannot list devices, so the field is edited as text.
+func (a *App) openDevicePicker() bool {
+	if a.audioManager == nil {
+		return false
+	}
+
+	devices, err := a.audioManager.ListAudioDevices()
+	if err != nil || len(devices) == 0 {
+		if err != nil && !errors.Is(err, legacy.ErrUnsupported) {
+			a.logMessage(fmt.Sprintf("Could not list audio devices: %v", err))
+		}
+		return false
+	}
+
+	a.state.AudioDevices = devices
+	a.state.SelectedDeviceIndex = 0
+	current := a.state.ConfigForm.Config.Audio.Device
+	for i, d := range devices {
+		if d.Name == current || (current == "" && d.Name == "auto") {
+			a.state.SelectedDeviceIndex = i
+			break
+		}
+	}
+	a.state.ShowDevicePicker = true
+	return true
+}
+
+// handleDevicePickerKeyPress selects an audio device; the choice applies immediately
+// and is written to the config on the next save (F2)
+func (a *App) handleDevicePickerKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		a.state.ShowDevicePicker = false
+	case "up":
+		if a.state.SelectedDeviceIndex > 0 {
+			a.state.SelectedDeviceIndex--
+		}
+	case "down":
+		if a.state.SelectedDeviceIndex < len(a.state.AudioDevices)-1 {
+			a.state.SelectedDeviceIndex++
+		}
+	case "enter":
+		if a.state.SelectedDeviceIndex < len(a.state.AudioDevices) {
+			device := a.state.AudioDevices[a.state.SelectedDeviceIndex]
+			a.state.ShowDevicePicker = false
+
+			name := device.Name
+			if name == "auto" {
+				name = "" // Empty means auto-detect in the config
+			}
+			if err := a.audioManager.SetAudioDevice(name); err != nil {
+				a.logMessage(fmt.Sprintf("Failed to switch audio device: %v", err))
+				return a, nil
+			}
+			a.state.ConfigForm.Config.Audio.Device = name
+			a.logMessage(fmt.Sprintf("Audio device: %s (F2 to save)", device.Description))
+		}
+	}
+	return a, nil
+}
@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Resume limits in seconds: tracks at least resumeMinDuration long (podcast
+// episodes, mostly) keep a server bookmark and offer to resume from it, unless the
+// position is within resumeMargin of either end
+const (
+	resumeMinDuration = 10 * 60
+	resumeMargin      = 30
+)
+
+// BookmarkResult carries the saved position of a long track that just started;
+// Position is 0 when it has none
+type BookmarkResult struct {
+	Track    models.Track
+	Position time.Duration
+	Error    error
+}
+
+// BookmarkSaveResult reports a failed attempt to save a track's position
+type BookmarkSaveResult struct {
+	Track models.Track
+	Error error
+}
+
+// resumable reports whether track is long enough for bookmarks
+func resumable(track *models.Track) bool {
+	return track != nil && track.Duration >= resumeMinDuration
+}
+
+// watchBookmarks runs every session tick and playback state change. It keeps the
+// position of a long track while it plays, and once playback moves to another track
+// it saves where the old one was left and looks up the new one's bookmark. Long
+// tracks start paused (see resumable) until handleBookmarkResult decides.
+func (a *App) watchBookmarks() tea.Cmd {
+	if a.audioManager == nil || a.navidromeClient == nil {
+		return nil
+	}
+	current := a.state.CurrentTrack
+	if current != nil && a.resumeTrack.ID != "" && current.ID == a.resumeTrack.ID {
+		a.resumePosition = a.audioManager.GetPosition()
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	if a.resumeTrack.ID != "" {
+		cmds = append(cmds, a.saveBookmark(a.resumeTrack, a.resumePosition))
+	}
+	a.resumeTrack, a.resumePosition = models.Track{}, 0
+	if resumable(current) {
+		a.resumeTrack = *current
+		cmds = append(cmds, a.fetchBookmark(*current))
+	}
+	return tea.Batch(cmds...)
+}
+
+// saveBookmark stores where a long track was left in the background. A track
+// stopped near its end counts as finished and loses its bookmark; one barely
+// started keeps the bookmark it had.
+func (a *App) saveBookmark(track models.Track, position time.Duration) tea.Cmd {
+	client := a.navidromeClient
+	seconds := int(position.Seconds())
+	if client == nil || seconds < resumeMargin {
+		return nil
+	}
+	finished := seconds > track.Duration-resumeMargin
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if finished {
+			// Usually there was no bookmark to delete, which the server reports as not found
+			if err := client.DeleteBookmark(ctx, track.ID); err != nil && !navidrome.IsAPIError(err, navidrome.ErrorCodeNotFound) {
+				return BookmarkSaveResult{Track: track, Error: err}
+			}
+			return nil
+		}
+		if err := client.CreateBookmark(ctx, track.ID, position); err != nil {
+			return BookmarkSaveResult{Track: track, Error: err}
+		}
+		return nil
+	}
+}
+
+// fetchBookmark looks up the saved position of a track that just started
+func (a *App) fetchBookmark(track models.Track) tea.Cmd {
+	client := a.navidromeClient
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		bookmarks, err := client.GetBookmarks(ctx)
+		if err != nil {
+			return BookmarkResult{Track: track, Error: err}
+		}
+		for _, bookmark := range bookmarks {
+			if bookmark.Entry.ID == track.ID {
+				return BookmarkResult{Track: track, Position: time.Duration(bookmark.Position) * time.Millisecond}
+			}
+		}
+		return BookmarkResult{Track: track}
+	}
+}
+
+// handleBookmarkResult asks whether to resume a track that started paused at its
+// saved position or start over, and just starts it when there is nothing to resume
+func (a *App) handleBookmarkResult(msg BookmarkResult) (tea.Model, tea.Cmd) {
+	if !a.isCurrentTrack(msg.Track.ID) {
+		return a, nil // Playback moved on; the new track has its own lookup
+	}
+	if msg.Error != nil {
+		log.Printf("Bookmark lookup for %s failed: %v", msg.Track.ID, msg.Error)
+		a.audioManager.Resume()
+		return a, nil
+	}
+	seconds := int(msg.Position.Seconds())
+	if a.state.ShowConfirm || seconds < resumeMargin || seconds > msg.Track.Duration-resumeMargin {
+		a.audioManager.Resume()
+		return a, nil
+	}
+
+	prompt := fmt.Sprintf("%s - %s\n\nResume at %s? (y/n)", msg.Track.Artist, msg.Track.Title, formatClock(seconds))
+	a.confirm(prompt, func(yes bool) tea.Cmd {
+		if !a.isCurrentTrack(msg.Track.ID) {
+			return nil
+		}
+		target := time.Duration(0)
+		if yes {
+			target = msg.Position
+		}
+		if delta := int((target - a.audioManager.GetPosition()).Seconds()); delta != 0 {
+			a.seek(delta)
+		}
+		a.audioManager.Resume()
+		if yes {
+			a.logMessage(fmt.Sprintf("Resumed %s at %s", msg.Track.Title, formatClock(seconds)))
+		}
+		return nil
+	})
+	return a, nil
+}
+
+// handleBookmarkSaveResult logs a position that couldn't be saved
+func (a *App) handleBookmarkSaveResult(msg BookmarkSaveResult) (tea.Model, tea.Cmd) {
+	a.logMessage(fmt.Sprintf("Couldn't save your place in %s: %v", msg.Track.Title, msg.Error))
+	return a, nil
+}
+
+// saveBookmarkOnExit saves the long track playing at exit, waiting for the server
+func (a *App) saveBookmarkOnExit() {
+	if a.audioManager == nil || !a.isCurrentTrack(a.resumeTrack.ID) {
+		return
+	}
+	if cmd := a.saveBookmark(a.resumeTrack, a.audioManager.GetPosition()); cmd != nil {
+		if msg, ok := cmd().(BookmarkSaveResult); ok {
+			log.Printf("Failed to save bookmark for %s: %v", msg.Track.ID, msg.Error)
+		}
+	}
+}
+
+// isCurrentTrack reports whether the track with id is the one playing (or paused)
+func (a *App) isCurrentTrack(id string) bool {
+	return id != "" && a.state.CurrentTrack != nil && a.state.CurrentTrack.ID == id
+}
+
+// formatClock formats seconds as m:ss, or h:mm:ss from an hour up
+func formatClock(seconds int) string {
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+)
+
+// TestSaveBookmarkFinishedTrack checks that deleting the bookmark of a finished
+// track ignores only the server's not found error
+func TestSaveBookmarkFinishedTrack(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		wantErr  bool
+	}{
+		{"deleted", `{"status":"ok"}`, false},
+		{"no bookmark", `{"status":"failed","error":{"code":70,"message":"not found"}}`, false},
+		{"not authorized", `{"status":"failed","error":{"code":50,"message":"not authorized"}}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/rest/deleteBookmark" {
+					http.NotFound(w, r)
+					return
+				}
+				fmt.Fprintf(w, `{"subsonic-response":%s}`, tt.response)
+			}))
+			defer server.Close()
+
+			app := newTestApp(t)
+			app.navidromeClient = navidrome.NewClient(server.URL, "user", "pass")
+			track := models.Track{ID: "ep1", Title: "Episode", Duration: resumeMinDuration}
+
+			msg := app.saveBookmark(track, time.Duration(track.Duration)*time.Second)()
+			result, failed := msg.(BookmarkSaveResult)
+			if failed != tt.wantErr {
+				t.Fatalf("got %#v, want an error: %v", msg, tt.wantErr)
+			}
+			if failed && result.Track.ID != track.ID {
+				t.Errorf("error reported for track %q, want %q", result.Track.ID, track.ID)
+			}
+		})
+	}
+}
+
+// heldBackend records whether a track that started paused was resumed
+type heldBackend struct {
+	fakeBackend
+	resumed bool
+}
+
+func (h *heldBackend) Resume() { h.resumed = true }
+
+// TestHandleBookmarkResult checks that a long track held paused is either resumed
+// right away or held for the prompt, never played before the answer
+func TestHandleBookmarkResult(t *testing.T) {
+	episode := models.Track{ID: "ep1", Title: "Episode", Artist: "Podcast", Duration: 3600}
+	tests := []struct {
+		name       string
+		result     BookmarkResult
+		wantPrompt bool
+	}{
+		{"bookmark", BookmarkResult{Track: episode, Position: 42 * time.Minute}, true},
+		{"no bookmark", BookmarkResult{Track: episode}, false},
+		{"near the end", BookmarkResult{Track: episode, Position: 3590 * time.Second}, false},
+		{"lookup failed", BookmarkResult{Track: episode, Error: fmt.Errorf("offline")}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newTestApp(t)
+			backend := &heldBackend{}
+			app.audioManager = backend
+			app.state.CurrentTrack = &episode
+
+			app.Update(tt.result)
+
+			if app.state.ShowConfirm != tt.wantPrompt {
+				t.Errorf("prompt shown: %v, want %v", app.state.ShowConfirm, tt.wantPrompt)
+			}
+			if backend.resumed == tt.wantPrompt {
+				t.Errorf("resumed: %v, want %v", backend.resumed, !tt.wantPrompt)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"navitone-cli/internal/audio"
+	"navitone-cli/internal/config"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ConfigCheckResult carries the outcome of the Config tab's F7 check
+type ConfigCheckResult struct {
+	Results []config.CheckResult
+}
+
+// CheckConfig loads the config file without creating or repairing it, checks its
+// values, paths and audio setup, and prints a report to w. Nothing contacts the
+// server. It returns an error when any check fails, warnings alone pass.
+func CheckConfig(opts Options, w io.Writer) error {
+	if opts.ConfigPath != "" {
+		config.SetConfigPath(opts.ConfigPath)
+	}
+	if path, err := config.GetConfigPath(); err == nil {
+		fmt.Fprintf(w, "Checking %s\n", path)
+	}
+
+	cfg, unknown, err := config.LoadForCheck()
+	if err != nil {
+		printCheckResults(w, []config.CheckResult{{Name: "config file", Err: err}})
+		return fmt.Errorf("config check failed")
+	}
+	applyOptions(cfg, opts)
+
+	results := []config.CheckResult{{Name: "config file", Detail: "parsed"}}
+	if len(unknown) > 0 {
+		results[0].Warning = fmt.Sprintf("unknown keys ignored: %s", strings.Join(unknown, ", "))
+	}
+	results = append(results, checkConfigSetup(cfg)...)
+
+	if failed := printCheckResults(w, results); failed > 0 {
+		return fmt.Errorf("config check failed: %d problem(s)", failed)
+	}
+	fmt.Fprintln(w, "Config OK")
+	return nil
+}
+
+// checkConfigSetup runs the config's own checks plus the audio backend ones: that
+// mpv runs when it would be used, and that audio.device names one of its outputs
+func checkConfigSetup(cfg *config.Config) []config.CheckResult {
+	results := cfg.Check()
+
+	backend := config.CheckResult{Name: "audio backend"}
+	resolved, err := audio.ResolveBackend(cfg.Audio.Backend)
+	mpvUsable := false
+	switch {
+	case err != nil:
+		backend.Err = err
+	case resolved == audio.BackendMPV:
+		if version, err := audio.MPVVersion(); err != nil {
+			backend.Warning = fmt.Sprintf("%v - playback falls back to oto", err)
+		} else {
+			backend.Detail = "mpv " + version
+			mpvUsable = true
+		}
+	default:
+		backend.Detail = "oto"
+	}
+	results = append(results, backend)
+
+	device := config.CheckResult{Name: "audio device"}
+	switch name := cfg.Audio.Device; {
+	case name == "" || name == "auto":
+		device.Detail = "default output"
+	case !mpvUsable:
+		device.Warning = fmt.Sprintf("%q ignored: the oto backend always uses the default output", name)
+	default:
+		names, err := audio.MPVDeviceNames()
+		if err != nil {
+			device.Warning = fmt.Sprintf("could not list devices: %v", err)
+		} else if !slices.Contains(names, name) {
+			device.Err = fmt.Errorf("%q not found (mpv has: %s)", name, strings.Join(names, ", "))
+		} else {
+			device.Detail = name
+		}
+	}
+	return append(results, device)
+}
+
+// printCheckResults writes one line per check and returns how many failed
+func printCheckResults(w io.Writer, results []config.CheckResult) int {
+	failed := 0
+	for _, r := range results {
+		switch {
+		case r.Err != nil:
+			failed++
+			fmt.Fprintf(w, "  FAIL  %s: %v\n", r.Name, r.Err)
+		case r.Warning != "":
+			fmt.Fprintf(w, "  WARN  %s: %s\n", r.Name, r.Warning)
+		default:
+			fmt.Fprintf(w, "  ok    %s: %s\n", r.Name, r.Detail)
+		}
+	}
+	return failed
+}
+
+// checkConfig runs the same checks as navitone --check-config on the values in the
+// form, including unsaved edits
+func (a *App) checkConfig() (tea.Model, tea.Cmd) {
+	cf := a.state.ConfigForm
+	cf.TestingConnection = true
+	cf.ConnectionStatus = "Checking config..."
+	cfg := *cf.Config // The form may change while the checks run
+	return a, func() tea.Msg {
+		return ConfigCheckResult{Results: checkConfigSetup(&cfg)}
+	}
+}
+
+// handleConfigCheckResult shows the check in the Config tab's status area and logs
+// anything that needs attention
+func (a *App) handleConfigCheckResult(msg ConfigCheckResult) (tea.Model, tea.Cmd) {
+	cf := a.state.ConfigForm
+	cf.TestingConnection = false
+
+	lines := make([]string, len(msg.Results))
+	problems := 0
+	for i, r := range msg.Results {
+		icon, text := "✅", r.Detail
+		switch {
+		case r.Err != nil:
+			icon, text = "❌", r.Err.Error()
+		case r.Warning != "":
+			icon, text = "⚠️", r.Warning
+		}
+		lines[i] = fmt.Sprintf("%s %s: %s", icon, r.Name, text)
+		if icon != "✅" {
+			problems++
+			a.logMessage(fmt.Sprintf("Config check - %s: %s", r.Name, text))
+		}
+	}
+	cf.ConnectionStatus = strings.Join(lines, "\n")
+	if problems == 0 {
+		a.logMessage("Config check passed")
+	}
+	return a, nil
+}
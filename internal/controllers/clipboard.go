@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ShareLinkResult carries a share link created for the current track
+type ShareLinkResult struct {
+	Title string
+	URL   string
+	Error error
+}
+
+// copyToClipboard copies text and confirms in the log. Headless sessions (no X11
+// or Wayland clipboard tool) get the text in the log instead.
+func (a *App) copyToClipboard(what, text string) {
+	if err := clipboard.WriteAll(text); err != nil {
+		a.logMessage(fmt.Sprintf("Clipboard unavailable (%v) - %s: %s", err, what, text))
+		return
+	}
+	a.logMessage(fmt.Sprintf("Copied %s: %s", what, text))
+}
+
+// copyTrackInfo copies "Artist - Title (Album)" for the playing track
+func (a *App) copyTrackInfo() {
+	track := a.state.CurrentTrack
+	if track == nil {
+		a.logMessage("Nothing playing to copy")
+		return
+	}
+	text := fmt.Sprintf("%s - %s", track.Artist, track.Title)
+	if track.Album != "" {
+		text += fmt.Sprintf(" (%s)", track.Album)
+	}
+	a.copyToClipboard("track info", text)
+}
+
+// shareCurrentTrack creates a Navidrome share link for the playing track
+func (a *App) shareCurrentTrack() tea.Cmd {
+	track := a.state.CurrentTrack
+	if track == nil {
+		a.logMessage("Nothing playing to share")
+		return nil
+	}
+	client := a.navidromeClient
+	if client == nil {
+		a.logMessage("Share unavailable - check the server config")
+		return nil
+	}
+
+	id, title := track.ID, fmt.Sprintf("%s - %s", track.Artist, track.Title)
+	a.logMessage(fmt.Sprintf("Creating share link for %s...", title))
+	timeout := a.state.ConfigForm.Config.Timeouts.MetadataTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		share, err := client.CreateShare(ctx, []string{id})
+		if err != nil {
+			return ShareLinkResult{Title: title, Error: err}
+		}
+		return ShareLinkResult{Title: title, URL: share.URL}
+	}
+}
+
+// handleShareLinkResult copies a created share link
+func (a *App) handleShareLinkResult(msg ShareLinkResult) (tea.Model, tea.Cmd) {
+	if msg.Error != nil {
+		a.logMessage(fmt.Sprintf("Failed to share %s: %v (is sharing enabled on the server?)", msg.Title, msg.Error))
+		return a, nil
+	}
+	a.copyToClipboard("share link", msg.URL)
+	return a, nil
+}
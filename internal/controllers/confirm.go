@@ -0,0 +1,34 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// confirm asks a yes/no question in a modal; answer runs with the reply
+func (a *App) confirm(prompt string, answer func(yes bool) tea.Cmd) {
+	a.state.ShowConfirm = true
+	a.state.ConfirmPrompt = prompt
+	a.confirmAnswer = answer
+}
+
+// handleConfirmKeyPress answers the confirm prompt: y or Enter for yes, n or Esc
+// for no
+func (a *App) handleConfirmKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var yes bool
+	switch msg.String() {
+	case "y", "Y", "enter":
+		yes = true
+	case "n", "N", "esc", "q":
+	default:
+		return a, nil
+	}
+
+	answer := a.confirmAnswer
+	a.state.ShowConfirm = false
+	a.state.ConfirmPrompt = ""
+	a.confirmAnswer = nil
+	if answer == nil {
+		return a, nil
+	}
+	return a, answer(yes)
+}
@@ -0,0 +1,88 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	"navitone-cli/internal/models"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RandomAlbumPlayResult carries the tracks of a randomly picked album to play now
+type RandomAlbumPlayResult struct {
+	Album  models.Album
+	Tracks []models.Track
+	Error  error
+}
+
+// jumpToRandomAlbum moves the Albums selection to a random album; the viewport
+// centering brings it into view
+func (a *App) jumpToRandomAlbum() {
+	if len(a.state.Albums) == 0 {
+		return
+	}
+	a.state.SelectedAlbumIndex = rand.Intn(len(a.state.Albums))
+	album := a.state.Albums[a.state.SelectedAlbumIndex]
+	a.logMessage(fmt.Sprintf("Random pick: %s - %s", album.Artist, album.Name))
+	a.loadCurrentArtwork()
+}
+
+// jumpToRandomArtist moves the Artists selection to a random artist
+func (a *App) jumpToRandomArtist() {
+	if len(a.state.Artists) == 0 {
+		return
+	}
+	a.state.SelectedArtistIndex = rand.Intn(len(a.state.Artists))
+	a.logMessage(fmt.Sprintf("Random pick: %s", a.state.Artists[a.state.SelectedArtistIndex].Name))
+	a.loadCurrentArtwork()
+}
+
+// playRandomAlbum jumps to a random album and replaces the queue with it
+func (a *App) playRandomAlbum() tea.Cmd {
+	if len(a.state.Albums) == 0 || a.navidromeClient == nil {
+		return nil
+	}
+	a.jumpToRandomAlbum()
+	album := a.state.Albums[a.state.SelectedAlbumIndex]
+
+	client := a.navidromeClient
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		resp, err := client.GetAlbumTracks(ctx, album.ID)
+		if err != nil {
+			return RandomAlbumPlayResult{Album: album, Error: err}
+		}
+		return RandomAlbumPlayResult{Album: album, Tracks: convertSongs(resp.SubsonicResponse.SongsByGenre.Song)}
+	}
+}
+
+// handleRandomAlbumPlayResult starts playing the random album from its first track
+func (a *App) handleRandomAlbumPlayResult(msg RandomAlbumPlayResult) (tea.Model, tea.Cmd) {
+	if msg.Error != nil {
+		a.logMessage(fmt.Sprintf("Failed to load %s: %v", msg.Album.Name, msg.Error))
+		return a, nil
+	}
+	if len(msg.Tracks) == 0 {
+		a.logMessage(fmt.Sprintf("%s has no tracks", msg.Album.Name))
+		return a, nil
+	}
+
+	a.state.SelectedQueueIndex = 0
+	if a.audioManager == nil {
+		a.state.Queue = msg.Tracks
+		a.state.CurrentTrack = &msg.Tracks[0]
+		a.state.IsPlaying = true
+	} else {
+		if err := a.audioManager.ReplaceQueue(msg.Tracks, true); err != nil {
+			a.logMessage(fmt.Sprintf("Failed to play %s: %v", msg.Album.Name, err))
+			return a, nil
+		}
+	}
+	a.logMessage(fmt.Sprintf("Playing random album: %s - %s (%d tracks)", msg.Album.Artist, msg.Album.Name, len(msg.Tracks)))
+	return a, nil
+}
@@ -0,0 +1,153 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"navitone-cli/internal/downloads"
+	"navitone-cli/internal/models"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// downloadBatch tracks one download request (a track, album or playlist)
+type downloadBatch struct {
+	name    string
+	total   int
+	done    int
+	skipped int
+	failed  int
+	results <-chan downloads.Result
+}
+
+// DownloadTracksLoadResult carries the tracks of an album or playlist to download
+type DownloadTracksLoadResult struct {
+	Name   string
+	Tracks []models.Track
+	Error  error
+}
+
+// DownloadProgressMsg reports one finished track of a download batch; Done is set
+// once the whole batch has finished
+type DownloadProgressMsg struct {
+	batch  *downloadBatch
+	Result downloads.Result
+	Done   bool
+}
+
+// downloadTracks saves tracks into the configured downloads folder in the background
+func (a *App) downloadTracks(name string, tracks []models.Track) tea.Cmd {
+	if a.navidromeClient == nil {
+		a.logMessage("Download unavailable - check the server config")
+		return nil
+	}
+	if len(tracks) == 0 {
+		return nil
+	}
+
+	root, err := a.state.ConfigForm.Config.Downloads.ResolvePath()
+	if err != nil {
+		a.logMessage(fmt.Sprintf("Download folder unavailable: %v", err))
+		return nil
+	}
+	if a.downloader == nil {
+		a.downloader = downloads.NewDownloader(a.state.ConfigForm.Config.Downloads.Workers)
+	}
+
+	batch := &downloadBatch{
+		name:    name,
+		total:   len(tracks),
+		results: a.downloader.Download(a.navidromeClient, root, tracks),
+	}
+	a.logMessage(fmt.Sprintf("Downloading %s (%d tracks) to %s", name, len(tracks), root))
+	return waitForDownload(batch)
+}
+
+// waitForDownload delivers the batch's next finished track
+func waitForDownload(batch *downloadBatch) tea.Cmd {
+	return func() tea.Msg {
+		result, ok := <-batch.results
+		return DownloadProgressMsg{batch: batch, Result: result, Done: !ok}
+	}
+}
+
+// handleDownloadProgress logs download progress and waits for the next track
+func (a *App) handleDownloadProgress(msg DownloadProgressMsg) (tea.Model, tea.Cmd) {
+	batch := msg.batch
+	if msg.Done {
+		summary := fmt.Sprintf("Downloaded %s: %d saved", batch.name, batch.done-batch.skipped-batch.failed)
+		if batch.skipped > 0 {
+			summary += fmt.Sprintf(", %d already present", batch.skipped)
+		}
+		if batch.failed > 0 {
+			summary += fmt.Sprintf(", %d failed", batch.failed)
+		}
+		a.logMessage(summary)
+		return a, nil
+	}
+
+	batch.done++
+	track := msg.Result.Track
+	switch {
+	case msg.Result.Error != nil:
+		batch.failed++
+		a.logMessage(fmt.Sprintf("Download failed for %s: %v", track.Title, msg.Result.Error))
+	case msg.Result.Skipped:
+		batch.skipped++
+	default:
+		a.logMessage(fmt.Sprintf("Downloaded %d/%d: %s - %s", batch.done, batch.total, track.Artist, track.Title))
+	}
+	return a, waitForDownload(batch)
+}
+
+// downloadAlbum fetches an album's tracks and downloads them
+func (a *App) downloadAlbum(album models.Album) tea.Cmd {
+	client := a.navidromeClient
+	if client == nil {
+		return nil
+	}
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		resp, err := client.GetAlbumTracks(ctx, album.ID)
+		if err != nil {
+			return DownloadTracksLoadResult{Name: album.Name, Error: err}
+		}
+		return DownloadTracksLoadResult{Name: album.Name, Tracks: convertSongs(resp.SubsonicResponse.SongsByGenre.Song)}
+	}
+}
+
+// downloadPlaylist fetches a playlist's tracks and downloads them
+func (a *App) downloadPlaylist(playlist models.Playlist) tea.Cmd {
+	client := a.navidromeClient
+	if client == nil {
+		return nil
+	}
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		resp, err := client.GetPlaylistTracks(ctx, playlist.ID)
+		if err != nil {
+			return DownloadTracksLoadResult{Name: playlist.Name, Error: err}
+		}
+		return DownloadTracksLoadResult{Name: playlist.Name, Tracks: convertSongs(resp.SubsonicResponse.Playlist.Entry)}
+	}
+}
+
+// handleDownloadTracksLoaded starts downloading a fetched album or playlist
+func (a *App) handleDownloadTracksLoaded(msg DownloadTracksLoadResult) (tea.Model, tea.Cmd) {
+	if msg.Error != nil {
+		a.logMessage(fmt.Sprintf("Failed to load %s for download: %v", msg.Name, msg.Error))
+		return a, nil
+	}
+	return a, a.downloadTracks(msg.Name, msg.Tracks)
+}
+
+// downloadTrack saves a single track
+func (a *App) downloadTrack(track models.Track) tea.Cmd {
+	return a.downloadTracks(fmt.Sprintf("%q", track.Title), []models.Track{track})
+}
@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// accentDebounce is how long an album has to keep playing before its cover is
+// sampled, so skipping through tracks doesn't fetch every cover on the way
+const accentDebounce = 1500 * time.Millisecond
+
+// AccentDebounceMsg fires once the playing album has settled
+type AccentDebounceMsg struct {
+	Cover string
+}
+
+// AccentResult carries the dominant color of a cover
+type AccentResult struct {
+	Cover string
+	Color string
+	Error error
+}
+
+// accentCmd schedules a new accent when config.UI.DynamicAccent is on and the
+// playing album changed, and restores the theme's accent when it no longer applies
+func (a *App) accentCmd() tea.Cmd {
+	cover := ""
+	if track := a.state.CurrentTrack; track != nil && a.artworkManager != nil && a.state.ConfigForm.Config.UI.DynamicAccent {
+		cover = track.AlbumID
+		if cover == "" {
+			cover = track.ID // Navidrome serves a song's own cover too
+		}
+	}
+	if cover == a.accentCover {
+		return nil
+	}
+	a.accentCover = cover
+	if cover == "" {
+		a.view.SetAccent("")
+		return nil
+	}
+	return tea.Tick(accentDebounce, func(time.Time) tea.Msg {
+		return AccentDebounceMsg{Cover: cover}
+	})
+}
+
+// handleAccentDebounce samples the cover in the background if the album is still playing
+func (a *App) handleAccentDebounce(msg AccentDebounceMsg) (tea.Model, tea.Cmd) {
+	if msg.Cover != a.accentCover {
+		return a, nil
+	}
+	manager := a.artworkManager
+	timeout := a.state.ConfigForm.Config.Timeouts.MetadataTimeout()
+	return a, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		color, err := manager.DominantColor(ctx, msg.Cover)
+		return AccentResult{Cover: msg.Cover, Color: color, Error: err}
+	}
+}
+
+// handleAccentResult applies the cover's color, or the theme's own accent when the
+// cover couldn't be loaded or has no distinct color
+func (a *App) handleAccentResult(msg AccentResult) (tea.Model, tea.Cmd) {
+	if msg.Cover != a.accentCover {
+		return a, nil
+	}
+	if msg.Error != nil {
+		a.view.SetAccent("")
+		return a, nil
+	}
+	a.view.SetAccent(msg.Color)
+	return a, nil
+}
@@ -0,0 +1,160 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+
+	"navitone-cli/internal/audio"
+	legacy "navitone-cli/internal/audio/legacy"
+	"navitone-cli/internal/config"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// applyEqualizer sets audio.equalizer on the backend at startup
+func (a *App) applyEqualizer() {
+	preset := a.state.ConfigForm.Config.Audio.Equalizer
+	err := a.setEqualizer(preset)
+	if errors.Is(err, legacy.ErrUnsupported) {
+		if preset != "" && preset != "flat" {
+			a.logMessage(fmt.Sprintf("Equalizer %q ignored: the oto backend doesn't support it", preset))
+		}
+		return
+	}
+	if err != nil {
+		a.logMessage(fmt.Sprintf("Failed to set equalizer: %v", err))
+	}
+}
+
+// applySkipSilence sets audio.skip_silence on the backend at startup
+func (a *App) applySkipSilence() {
+	if !a.state.ConfigForm.Config.Audio.SkipSilence {
+		return
+	}
+	err := a.audioManager.SetSkipSilence(true)
+	if errors.Is(err, legacy.ErrUnsupported) {
+		a.logMessage("Skip silence ignored: the oto backend doesn't support it")
+		return
+	}
+	if err != nil {
+		a.logMessage(fmt.Sprintf("Failed to set skip silence: %v", err))
+	}
+}
+
+// setEqualizer applies a preset, or audio.equalizer_bands for "custom"
+func (a *App) setEqualizer(preset string) error {
+	if preset == config.EqualizerCustom {
+		return a.audioManager.SetEqualizerBands(a.state.ConfigForm.Config.Audio.EqualizerBands)
+	}
+	if preset == "" {
+		preset = "flat"
+	}
+	return a.audioManager.SetEqualizer(preset)
+}
+
+// openEqualizer shows the equalizer modal on the current preset
+func (a *App) openEqualizer() {
+	if a.audioManager == nil {
+		a.logMessage("Equalizer unavailable: audio is not running")
+		return
+	}
+	if a.audioBackend != audio.BackendMPV {
+		a.logMessage("The equalizer needs the mpv backend (oto doesn't support it)")
+		return
+	}
+
+	current := a.state.ConfigForm.Config.Audio.Equalizer
+	a.state.SelectedEqualizerIndex = len(config.EqualizerPresetNames) // Custom
+	for i, name := range config.EqualizerPresetNames {
+		if name == current {
+			a.state.SelectedEqualizerIndex = i
+		}
+	}
+	a.state.SelectedEqualizerBand = 0
+	a.state.ShowEqualizer = true
+}
+
+// handleEqualizerKeyPress picks a preset with Enter, or on the custom row moves
+// between bands with ←→ and changes the gain with +/-. Changes play immediately
+// and are saved to the config file.
+func (a *App) handleEqualizerKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	custom := a.state.SelectedEqualizerIndex == len(config.EqualizerPresetNames)
+	switch msg.String() {
+	case "esc", "q", "alt+e":
+		a.state.ShowEqualizer = false
+	case "up":
+		if a.state.SelectedEqualizerIndex > 0 {
+			a.state.SelectedEqualizerIndex--
+		}
+	case "down":
+		if !custom {
+			a.state.SelectedEqualizerIndex++
+		}
+	case "left":
+		if custom && a.state.SelectedEqualizerBand > 0 {
+			a.state.SelectedEqualizerBand--
+		}
+	case "right":
+		if custom && a.state.SelectedEqualizerBand < len(config.EqualizerFrequencies)-1 {
+			a.state.SelectedEqualizerBand++
+		}
+	case "+", "=", "-":
+		if custom {
+			step := 1.0
+			if msg.String() == "-" {
+				step = -1
+			}
+			a.adjustEqualizerBand(step)
+		}
+	case "enter":
+		if custom {
+			a.customEqualizerBands()
+			a.chooseEqualizer(config.EqualizerCustom)
+		} else {
+			a.chooseEqualizer(config.EqualizerPresetNames[a.state.SelectedEqualizerIndex])
+		}
+	}
+	return a, nil
+}
+
+// customEqualizerBands returns audio.equalizer_bands, starting it from the current
+// preset the first time so custom tweaks begin from what is playing
+func (a *App) customEqualizerBands() []float64 {
+	cfg := &a.state.ConfigForm.Config.Audio
+	if len(cfg.EqualizerBands) != len(config.EqualizerFrequencies) {
+		start, ok := config.EqualizerPresets[cfg.Equalizer]
+		if !ok {
+			start = config.EqualizerPresets["flat"]
+		}
+		cfg.EqualizerBands = append([]float64(nil), start...)
+	}
+	return cfg.EqualizerBands
+}
+
+// adjustEqualizerBand changes the selected custom band by step dB and switches to
+// the custom equalizer
+func (a *App) adjustEqualizerBand(step float64) {
+	bands := a.customEqualizerBands()
+	band := a.state.SelectedEqualizerBand
+	bands[band] = max(config.MinEqualizerGain, min(config.MaxEqualizerGain, bands[band]+step))
+	a.chooseEqualizer(config.EqualizerCustom)
+}
+
+// chooseEqualizer applies a preset (or "custom") and saves it as audio.equalizer
+func (a *App) chooseEqualizer(preset string) {
+	if err := a.setEqualizer(preset); err != nil {
+		a.logMessage(fmt.Sprintf("Failed to set equalizer: %v", err))
+		return
+	}
+
+	cfg := a.state.ConfigForm.Config
+	changed := cfg.Audio.Equalizer != preset
+	cfg.Audio.Equalizer = preset
+	if err := config.Save(cfg); err != nil {
+		a.logMessage(fmt.Sprintf("Failed to save equalizer: %v", err))
+		return
+	}
+	if changed {
+		a.logMessage(fmt.Sprintf("Equalizer: %s", preset))
+	}
+}
@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"navitone-cli/internal/downloads"
+	"navitone-cli/internal/models"
+)
+
+// Queue export formats
+const (
+	exportM3U  = "m3u"
+	exportJSON = "json"
+)
+
+// exportedQueue is the JSON export of the queue
+type exportedQueue struct {
+	Exported time.Time       `json:"exported"`
+	Tracks   []exportedTrack `json:"tracks"`
+}
+
+// exportedTrack is one queue entry in a JSON export. Location is the downloaded
+// file when there is one, otherwise a stream URL.
+type exportedTrack struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Artist   string `json:"artist"`
+	Album    string `json:"album"`
+	Duration int    `json:"duration"`
+	Location string `json:"location"`
+}
+
+// exportQueue writes the queue to downloads.export_path and logs where it went
+func (a *App) exportQueue(format string) {
+	if len(a.state.Queue) == 0 {
+		a.logMessage("Queue is empty - nothing to export")
+		return
+	}
+	dir, err := a.state.ConfigForm.Config.Downloads.ResolveExportPath()
+	if err != nil {
+		a.logMessage(fmt.Sprintf("Export folder unavailable: %v", err))
+		return
+	}
+
+	path, err := a.ExportQueue(format, dir)
+	if err != nil {
+		a.logMessage(fmt.Sprintf("Queue export failed: %v", err))
+		return
+	}
+	a.logMessage(fmt.Sprintf("Exported %d tracks to %s", len(a.state.Queue), path))
+	if streamed := a.countStreamedTracks(); streamed > 0 {
+		a.logMessage(fmt.Sprintf("Warning: the export streams %d of its tracks with your login token - anyone with the file can stream as you", streamed))
+	}
+}
+
+// ExportQueue writes the queue to a new file in dir, as an extended M3U playlist or
+// JSON, and returns its path. Files are named after the current time, with " (2)",
+// " (3)"... added rather than overwriting an earlier export. They are readable by
+// the user only, since stream URLs carry the login token.
+func (a *App) ExportQueue(format, dir string) (string, error) {
+	var data []byte
+	switch format {
+	case exportM3U:
+		data = a.queueM3U()
+	case exportJSON:
+		var err error
+		if data, err = a.queueJSON(); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("unknown export format %q (expected m3u or json)", format)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	base := filepath.Join(dir, "navitone-queue-"+time.Now().Format("2006-01-02-1504"))
+	for n := 1; ; n++ {
+		path := base + "." + format
+		if n > 1 {
+			path = fmt.Sprintf("%s (%d).%s", base, n, format)
+		}
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if errors.Is(err, os.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if _, err := file.Write(data); err != nil {
+			file.Close()
+			return "", err
+		}
+		return path, file.Close()
+	}
+}
+
+// queueM3U formats the queue as an extended M3U playlist
+func (a *App) queueM3U() []byte {
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n")
+	for _, track := range a.state.Queue {
+		location := a.exportLocation(track)
+		if location == "" {
+			continue
+		}
+		duration := track.Duration
+		if duration <= 0 {
+			duration = -1 // Unknown length
+		}
+		fmt.Fprintf(&b, "#EXTINF:%d,%s - %s\n%s\n", duration, track.Artist, track.Title, location)
+	}
+	return []byte(b.String())
+}
+
+// queueJSON formats the queue as JSON
+func (a *App) queueJSON() ([]byte, error) {
+	export := exportedQueue{Exported: time.Now(), Tracks: make([]exportedTrack, len(a.state.Queue))}
+	for i, track := range a.state.Queue {
+		export.Tracks[i] = exportedTrack{
+			ID:       track.ID,
+			Title:    track.Title,
+			Artist:   track.Artist,
+			Album:    track.Album,
+			Duration: track.Duration,
+			Location: a.exportLocation(track),
+		}
+	}
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// exportLocation returns where another player can find track: its file in the
+// downloads folder when it was downloaded, otherwise a Navidrome stream URL
+// (which carries the login token), or "" without either
+func (a *App) exportLocation(track models.Track) string {
+	if path := a.downloadedPath(track); path != "" {
+		return path
+	}
+	if a.navidromeClient == nil {
+		return ""
+	}
+	return a.navidromeClient.GetStreamURL(track.ID)
+}
+
+// downloadedPath returns track's file in the downloads folder, or "" when it
+// wasn't downloaded
+func (a *App) downloadedPath(track models.Track) string {
+	if root, err := a.state.ConfigForm.Config.Downloads.ResolvePath(); err == nil {
+		if path := downloads.TrackPath(root, track); fileExists(path) {
+			return path
+		}
+	}
+	return ""
+}
+
+// countStreamedTracks returns how many queued tracks export as stream URLs
+func (a *App) countStreamedTracks() int {
+	if a.navidromeClient == nil {
+		return 0
+	}
+	count := 0
+	for _, track := range a.state.Queue {
+		if a.downloadedPath(track) == "" {
+			count++
+		}
+	}
+	return count
+}
+
+// fileExists reports whether path is an existing regular file
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"navitone-cli/internal/downloads"
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+)
+
+// TestExportQueueStreamURLs checks that an export holding stream URLs is private
+// to the user and that the log warns about the login token in them
+func TestExportQueueStreamURLs(t *testing.T) {
+	dir := t.TempDir()
+	app := newTestApp(t)
+	app.navidromeClient = navidrome.NewClient("http://navidrome.invalid", "user", "pass")
+	app.state.ConfigForm.Config.Downloads.Path = filepath.Join(dir, "music")
+	app.state.ConfigForm.Config.Downloads.ExportPath = filepath.Join(dir, "exports")
+
+	downloaded := models.Track{ID: "tr1", Title: "Kept", Artist: "Artist", Album: "Album", Suffix: "mp3", Duration: 200}
+	streamed := models.Track{ID: "tr2", Title: "Streamed", Artist: "Artist", Album: "Album", Suffix: "mp3", Duration: 180}
+	local := downloads.TrackPath(app.state.ConfigForm.Config.Downloads.Path, downloaded)
+	if err := os.MkdirAll(filepath.Dir(local), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(local, []byte("audio"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	app.state.Queue = []models.Track{downloaded, streamed}
+
+	app.exportQueue(exportM3U)
+
+	files, _ := filepath.Glob(filepath.Join(dir, "exports", "*.m3u"))
+	if len(files) != 1 {
+		t.Fatalf("got %d exports, want 1", len(files))
+	}
+	info, err := os.Stat(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0600 {
+		t.Errorf("export mode %o, want 600", mode)
+	}
+	data, _ := os.ReadFile(files[0])
+	if !strings.Contains(string(data), local+"\n") || !strings.Contains(string(data), "id=tr2") {
+		t.Errorf("export missing the local path or the stream URL:\n%s", data)
+	}
+
+	logs := strings.Join(app.state.LogMessages, "\n")
+	if !strings.Contains(logs, "streams 1 of its tracks") || !strings.Contains(logs, "login token") {
+		t.Errorf("no login token warning in the log:\n%s", logs)
+	}
+}
@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Fit-to-time limits: the target in minutes and how far a finished queue may fall
+// short of it before another candidate is worth looking for
+const (
+	minFitMinutes = 5
+	maxFitMinutes = 600
+	fitSlack      = 60 // Seconds
+)
+
+// FitToTimeResult carries the tracks picked to fill a target time
+type FitToTimeResult struct {
+	Minutes int
+	Tracks  []models.Track
+	Error   error
+}
+
+// openFitPicker shows the fit-to-time prompt, keeping the last target
+func (a *App) openFitPicker() {
+	if a.state.FitMinutes < minFitMinutes {
+		a.state.FitMinutes = 60
+	}
+	a.state.FitTyped = false
+	a.state.ShowFitPicker = true
+}
+
+// handleFitPickerKeyPress edits the target minutes (typed, or ↑↓ in steps of 5)
+// and the track source (←→), and builds the queue on Enter
+func (a *App) handleFitPickerKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	switch key {
+	case "esc", "q", "alt+t":
+		a.state.ShowFitPicker = false
+	case "up":
+		a.state.FitMinutes = min(maxFitMinutes, a.state.FitMinutes+5)
+	case "down":
+		a.state.FitMinutes = max(minFitMinutes, a.state.FitMinutes-5)
+	case "pgup":
+		a.state.FitMinutes = min(maxFitMinutes, a.state.FitMinutes+30)
+	case "pgdown":
+		a.state.FitMinutes = max(minFitMinutes, a.state.FitMinutes-30)
+	case "left", "right", "tab":
+		a.state.FitFromTopTracks = !a.state.FitFromTopTracks
+	case "backspace":
+		a.state.FitMinutes /= 10
+		a.state.FitTyped = true
+	case "enter":
+		if a.state.FitMinutes < minFitMinutes {
+			a.logMessage(fmt.Sprintf("Fit to time needs at least %d minutes", minFitMinutes))
+			return a, nil
+		}
+		a.state.ShowFitPicker = false
+		return a, a.startFitToTime(a.state.FitMinutes, a.state.FitFromTopTracks)
+	default:
+		if digit, err := strconv.Atoi(key); err == nil && len(key) == 1 {
+			// The first digit typed replaces the suggested target
+			if !a.state.FitTyped {
+				a.state.FitMinutes = 0
+				a.state.FitTyped = true
+			}
+			a.state.FitMinutes = min(maxFitMinutes, a.state.FitMinutes*10+digit)
+		}
+	}
+	return a, nil
+}
+
+// startFitToTime fetches candidate tracks (random, or the most played) and picks
+// enough of them to fill minutes
+func (a *App) startFitToTime(minutes int, topTracks bool) tea.Cmd {
+	client := a.navidromeClient
+	if client == nil {
+		a.logMessage("Fit to time unavailable: not connected to a server")
+		return nil
+	}
+
+	// Roughly twice as many candidates as needed at ~4 minutes a track, so there
+	// is room to choose; getRandomSongs returns at most 500
+	count := max(50, min(500, minutes/2))
+	source := "random"
+	if topTracks {
+		source = "most played"
+	}
+	a.logMessage(fmt.Sprintf("Filling %d minutes with %s tracks...", minutes, source))
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		var resp *navidrome.SongsResponse
+		var err error
+		if topTracks {
+			resp, err = client.GetTopTracks(ctx, count)
+		} else {
+			resp, err = client.GetSongs(ctx, count, 0)
+		}
+		if err != nil {
+			return FitToTimeResult{Minutes: minutes, Error: err}
+		}
+		tracks := fitToTime(convertSongs(resp.SubsonicResponse.SongsByGenre.Song), minutes*60)
+		return FitToTimeResult{Minutes: minutes, Tracks: tracks}
+	}
+}
+
+// fitToTime greedily takes candidates in order while they fit in target seconds,
+// then fills what is left with the longest remaining track that still fits.
+// Tracks without a known duration are skipped, since they can't be counted.
+func fitToTime(candidates []models.Track, target int) []models.Track {
+	var picked, rest []models.Track
+	total := 0
+	for _, track := range candidates {
+		if track.Duration <= 0 {
+			continue
+		}
+		if total+track.Duration <= target {
+			picked = append(picked, track)
+			total += track.Duration
+		} else {
+			rest = append(rest, track)
+		}
+	}
+
+	for target-total > fitSlack {
+		best := -1
+		for i, track := range rest {
+			if total+track.Duration <= target && (best < 0 || track.Duration > rest[best].Duration) {
+				best = i
+			}
+		}
+		if best < 0 {
+			break
+		}
+		picked = append(picked, rest[best])
+		total += rest[best].Duration
+		rest = append(rest[:best], rest[best+1:]...)
+	}
+	return picked
+}
+
+// handleFitToTimeResult replaces the queue with the fitted tracks and plays them
+func (a *App) handleFitToTimeResult(msg FitToTimeResult) (tea.Model, tea.Cmd) {
+	if msg.Error != nil {
+		a.logMessage(fmt.Sprintf("Fit to time failed: %v", msg.Error))
+		return a, nil
+	}
+	if len(msg.Tracks) == 0 {
+		a.logMessage(fmt.Sprintf("Fit to time: no tracks fit in %d minutes", msg.Minutes))
+		return a, nil
+	}
+
+	total := 0
+	for _, track := range msg.Tracks {
+		total += track.Duration
+	}
+	a.state.SelectedQueueIndex = 0
+	a.replaceQueue(msg.Tracks)
+	a.logMessage(fmt.Sprintf("Fit to %d minutes: %d tracks, %d:%02d total", msg.Minutes, len(msg.Tracks), total/60, total%60))
+	return a, nil
+}
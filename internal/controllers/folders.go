@@ -0,0 +1,281 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxFolderTracks caps how many tracks queueing a folder collects from its
+// subdirectories, so queueing the top of a huge tree doesn't walk all of it
+const maxFolderTracks = 1000
+
+// FolderLoadResult carries the contents of a directory in the folder browser
+type FolderLoadResult struct {
+	ID      string // Directory the entries belong to, "" for the top level
+	Entries []models.FolderEntry
+	Select  string // Entry to select once shown (the directory just left)
+	Error   error
+}
+
+// FolderTracksResult carries every track under a folder, for queueing it
+type FolderTracksResult struct {
+	Name      string
+	Tracks    []models.Track
+	Placement queuePlacement
+	Error     error
+}
+
+// openFolderBrowser shows the folder browser at the top of the tree
+func (a *App) openFolderBrowser() tea.Cmd {
+	if a.navidromeClient == nil {
+		a.logMessage("Folder browser unavailable: not connected to a server")
+		return nil
+	}
+	a.state.ShowFolderBrowser = true
+	a.state.FolderPath = nil
+	a.state.FolderEntries = nil
+	a.state.SelectedFolderIndex = 0
+	return a.loadFolder(models.FolderEntry{}, "")
+}
+
+// currentFolder returns the directory the browser shows; the zero entry is the top
+func (a *App) currentFolder() models.FolderEntry {
+	if len(a.state.FolderPath) == 0 {
+		return models.FolderEntry{}
+	}
+	return a.state.FolderPath[len(a.state.FolderPath)-1]
+}
+
+// loadFolder fetches a directory's entries in the background, selecting selectID
+// among them when given
+func (a *App) loadFolder(dir models.FolderEntry, selectID string) tea.Cmd {
+	client := a.navidromeClient
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	a.state.LoadingFolder = true
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		entries, err := folderEntries(ctx, client, dir)
+		return FolderLoadResult{ID: dir.ID, Entries: entries, Select: selectID, Error: err}
+	}
+}
+
+// folderEntries lists a directory. The top level lists the music folders, or goes
+// straight to the index when there is only one.
+func folderEntries(ctx context.Context, client *navidrome.Client, dir models.FolderEntry) ([]models.FolderEntry, error) {
+	switch {
+	case dir.ID == "":
+		folders, err := client.GetMusicFolders(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(folders) > 1 {
+			entries := make([]models.FolderEntry, len(folders))
+			for i, folder := range folders {
+				entries[i] = models.FolderEntry{ID: strconv.Itoa(folder.ID), Name: folder.Name, Kind: models.FolderLibrary}
+			}
+			return entries, nil
+		}
+		return indexEntries(ctx, client, "")
+	case dir.Kind == models.FolderLibrary:
+		return indexEntries(ctx, client, dir.ID)
+	default:
+		directory, err := client.GetMusicDirectory(ctx, dir.ID)
+		if err != nil {
+			return nil, err
+		}
+		return childEntries(directory.Child), nil
+	}
+}
+
+// indexEntries lists the top-level directories of a music folder ("" for all of
+// them), followed by any files stored directly in it
+func indexEntries(ctx context.Context, client *navidrome.Client, folderID string) ([]models.FolderEntry, error) {
+	indexes, err := client.GetIndexes(ctx, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []models.FolderEntry
+	for _, index := range indexes.Index {
+		for _, dir := range index.Artist {
+			entries = append(entries, models.FolderEntry{ID: dir.ID, Name: dir.Name, Kind: models.FolderDirectory})
+		}
+	}
+	return append(entries, childEntries(indexes.Child)...), nil
+}
+
+// childEntries turns a directory's children into subdirectory and track entries
+func childEntries(children []navidrome.Song) []models.FolderEntry {
+	entries := make([]models.FolderEntry, len(children))
+	for i, child := range children {
+		if child.IsDir {
+			entries[i] = models.FolderEntry{ID: child.ID, Name: child.Title, Kind: models.FolderDirectory}
+			continue
+		}
+		entries[i] = models.FolderEntry{ID: child.ID, Name: child.Title, Kind: models.FolderTrack, Track: convertSongs([]navidrome.Song{child})[0]}
+	}
+	return entries
+}
+
+// handleFolderLoadResult shows a loaded directory, unless the browser has moved on
+func (a *App) handleFolderLoadResult(msg FolderLoadResult) (tea.Model, tea.Cmd) {
+	if !a.state.ShowFolderBrowser || msg.ID != a.currentFolder().ID {
+		return a, nil
+	}
+	a.state.LoadingFolder = false
+	if msg.Error != nil {
+		a.logMessage(fmt.Sprintf("Failed to load folder: %v", msg.Error))
+		return a, nil
+	}
+
+	a.state.FolderEntries = msg.Entries
+	a.state.SelectedFolderIndex = reselect(msg.Select, 0, len(msg.Entries), func(i int) string {
+		return msg.Entries[i].ID
+	})
+	return a, nil
+}
+
+// handleFolderBrowserKeyPress descends into folders with Enter (or →), goes back up
+// with Backspace (or ←), plays or queues files, and queues whole folders with a
+func (a *App) handleFolderBrowserKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	entries := a.state.FolderEntries
+	switch msg.String() {
+	case "esc", "q", "alt+f":
+		a.state.ShowFolderBrowser = false
+		a.state.FolderPath = nil
+		a.state.FolderEntries = nil
+	case "up":
+		if a.state.SelectedFolderIndex > 0 {
+			a.state.SelectedFolderIndex--
+		}
+	case "down":
+		if a.state.SelectedFolderIndex < len(entries)-1 {
+			a.state.SelectedFolderIndex++
+		}
+	case "pgup":
+		a.state.SelectedFolderIndex = max(0, a.state.SelectedFolderIndex-10)
+	case "pgdown":
+		a.state.SelectedFolderIndex = max(0, min(len(entries)-1, a.state.SelectedFolderIndex+10))
+	case "home":
+		a.state.SelectedFolderIndex = 0
+	case "end":
+		a.state.SelectedFolderIndex = max(0, len(entries)-1)
+	case "backspace", "left":
+		if len(a.state.FolderPath) == 0 {
+			break
+		}
+		left := a.currentFolder()
+		a.state.FolderPath = a.state.FolderPath[:len(a.state.FolderPath)-1]
+		a.state.FolderEntries = nil
+		return a, a.loadFolder(a.currentFolder(), left.ID)
+	case "enter", "shift+enter", "right":
+		if a.state.SelectedFolderIndex >= len(entries) {
+			break
+		}
+		entry := entries[a.state.SelectedFolderIndex]
+		if entry.Kind != models.FolderTrack {
+			a.state.FolderPath = append(a.state.FolderPath, entry)
+			a.state.FolderEntries = nil
+			a.state.SelectedFolderIndex = 0
+			return a, a.loadFolder(entry, "")
+		}
+		if msg.String() == "right" {
+			break
+		}
+		if a.queueOnlyKey(msg.String()) {
+			a.logMessage(fmt.Sprintf("Queued: %s - %s", entry.Track.Artist, entry.Track.Title))
+			return a, a.addTrackToQueue(entry.Track)
+		}
+		// Play the file and queue the ones after it in this folder
+		var tracks []models.Track
+		for _, e := range entries[a.state.SelectedFolderIndex:] {
+			if e.Kind == models.FolderTrack {
+				tracks = append(tracks, e.Track)
+			}
+		}
+		a.state.ShowFolderBrowser = false
+		a.replaceQueue(tracks)
+		a.logMessage(fmt.Sprintf("Playing: %s - %s (%d tracks queued)", entry.Track.Artist, entry.Track.Title, len(tracks)))
+	case "a", "alt+a":
+		// The selected folder, or the one being shown when a file is selected
+		target := a.currentFolder()
+		if a.state.SelectedFolderIndex < len(entries) && entries[a.state.SelectedFolderIndex].Kind != models.FolderTrack {
+			target = entries[a.state.SelectedFolderIndex]
+		}
+		return a, a.queueFolder(target, a.queueKeyPlacement(msg.String()))
+	}
+	return a, nil
+}
+
+// queueFolder collects the tracks in a folder and its subdirectories in the background
+func (a *App) queueFolder(dir models.FolderEntry, placement queuePlacement) tea.Cmd {
+	client := a.navidromeClient
+	if client == nil {
+		return nil
+	}
+	name := dir.Name
+	if dir.ID == "" {
+		name = "the whole library"
+	}
+	a.logMessage(fmt.Sprintf("Collecting tracks in %s...", name))
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		tracks, err := folderTracks(ctx, client, dir)
+		return FolderTracksResult{Name: name, Tracks: tracks, Placement: placement, Error: err}
+	}
+}
+
+// folderTracks walks a folder depth first, in the server's order, and returns its
+// tracks (at most maxFolderTracks)
+func folderTracks(ctx context.Context, client *navidrome.Client, dir models.FolderEntry) ([]models.Track, error) {
+	entries, err := folderEntries(ctx, client, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var tracks []models.Track
+	for _, entry := range entries {
+		if len(tracks) >= maxFolderTracks {
+			break
+		}
+		if entry.Kind == models.FolderTrack {
+			tracks = append(tracks, entry.Track)
+			continue
+		}
+		sub, err := folderTracks(ctx, client, entry)
+		if err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, sub...)
+	}
+	if len(tracks) > maxFolderTracks {
+		tracks = tracks[:maxFolderTracks]
+	}
+	return tracks, nil
+}
+
+// handleFolderTracksResult puts a queued folder's tracks into the queue
+func (a *App) handleFolderTracksResult(msg FolderTracksResult) (tea.Model, tea.Cmd) {
+	if msg.Error != nil {
+		a.logMessage(fmt.Sprintf("Failed to queue %s: %v", msg.Name, msg.Error))
+		return a, nil
+	}
+	if len(msg.Tracks) == 0 {
+		a.logMessage(fmt.Sprintf("No tracks in %s", msg.Name))
+		return a, nil
+	}
+	if msg.Placement == placeReplace {
+		a.state.ShowFolderBrowser = false
+	}
+	a.placeTracks(msg.Tracks, msg.Placement, msg.Name)
+	return a, nil
+}
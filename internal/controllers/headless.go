@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"navitone-cli/internal/audio"
+	"navitone-cli/internal/config"
+	"navitone-cli/internal/hooks"
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+	"navitone-cli/pkg/scrobbling"
+)
+
+// CommandUsage describes the subcommands RunCommand accepts
+const CommandUsage = `commands:
+  search <query>        print matching artists, albums and tracks with their IDs
+  play [-n N] <query>   play the first N matching tracks (default 10); "artist - title" works
+  album <id>            play an album by ID (see search)`
+
+// queueEndGrace is how long playback must stay stopped before a headless play
+// counts the queue as finished, so the gap between two tracks doesn't end it
+const queueEndGrace = 3 * time.Second
+
+// RunCommand runs a subcommand without the TUI, reusing the config, Navidrome
+// client and audio backend: search prints results to w, play and album play
+// tracks, printing each to w, until the queue ends or the process is interrupted
+func RunCommand(opts Options, args []string, w io.Writer) error {
+	cfg := loadConfig(opts)
+	setupDebugLogging(cfg)
+	if cfg.Navidrome.ServerURL == "" || cfg.Navidrome.Username == "" || !cfg.HasCredentials() {
+		return errors.New("no server configured - run navitone once to set it up, or pass -server, -username and -password")
+	}
+	client := newNavidromeClient(cfg)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	switch args[0] {
+	case "search":
+		if len(args) < 2 {
+			return errors.New("usage: navitone search <query>")
+		}
+		return runSearch(ctx, w, client, strings.Join(args[1:], " "))
+	case "play":
+		flags := flag.NewFlagSet("play", flag.ContinueOnError)
+		count := flags.Int("n", 10, "number of matching tracks to play")
+		if err := flags.Parse(args[1:]); err != nil {
+			return err
+		}
+		if flags.NArg() == 0 {
+			return errors.New("usage: navitone play [-n N] <query>")
+		}
+		tracks, err := searchTracks(ctx, client, strings.Join(flags.Args(), " "), *count)
+		if err != nil {
+			return err
+		}
+		return playHeadless(ctx, w, cfg, opts, client, tracks)
+	case "album":
+		if len(args) != 2 {
+			return errors.New("usage: navitone album <id>")
+		}
+		resp, err := client.GetAlbumTracks(ctx, args[1])
+		if err != nil {
+			return fmt.Errorf("loading album %s: %w", args[1], err)
+		}
+		return playHeadless(ctx, w, cfg, opts, client, convertSongs(resp.SubsonicResponse.SongsByGenre.Song))
+	default:
+		return fmt.Errorf("unknown command %q\n\n%s", args[0], CommandUsage)
+	}
+}
+
+// runSearch prints the artists, albums and tracks matching query to out, with
+// the IDs the album command takes
+func runSearch(ctx context.Context, out io.Writer, client *navidrome.Client, query string) error {
+	resp, err := client.Search(ctx, query, 10, 10, 20)
+	if err != nil {
+		return fmt.Errorf("searching: %w", err)
+	}
+	result := resp.SubsonicResponse.SearchResult3
+	if len(result.Artist) == 0 && len(result.Album) == 0 && len(result.Song) == 0 {
+		fmt.Fprintln(out, "No results found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	if len(result.Artist) > 0 {
+		fmt.Fprintln(w, "Artists")
+		for _, artist := range result.Artist {
+			fmt.Fprintf(w, "  %s\t%s\n", artist.ID, artist.Name)
+		}
+	}
+	if len(result.Album) > 0 {
+		fmt.Fprintln(w, "Albums")
+		for _, album := range result.Album {
+			year := ""
+			if album.Year > 0 {
+				year = fmt.Sprintf(" (%d)", album.Year)
+			}
+			fmt.Fprintf(w, "  %s\t%s - %s%s\n", album.ID, album.Artist, album.Name, year)
+		}
+	}
+	if len(result.Song) > 0 {
+		fmt.Fprintln(w, "Tracks")
+		for _, song := range result.Song {
+			fmt.Fprintf(w, "  %s\t%s - %s\t%s\n", song.ID, song.Artist, song.Title, song.Album)
+		}
+	}
+	return w.Flush()
+}
+
+// searchTracks returns up to count tracks matching query. The dash in
+// "artist - title" is dropped since the server matches on words.
+func searchTracks(ctx context.Context, client *navidrome.Client, query string, count int) ([]models.Track, error) {
+	if count < 1 {
+		count = 1
+	}
+	query = strings.Join(strings.Fields(strings.ReplaceAll(query, " - ", " ")), " ")
+	resp, err := client.Search(ctx, query, 0, 0, count)
+	if err != nil {
+		return nil, fmt.Errorf("searching: %w", err)
+	}
+	tracks := convertSongs(resp.SubsonicResponse.SearchResult3.Song)
+	if len(tracks) == 0 {
+		return nil, fmt.Errorf("no tracks match %q", query)
+	}
+	return tracks, nil
+}
+
+// playHeadless plays tracks through the configured audio backend, printing each
+// track to w as it starts, and returns when the queue ends or ctx is cancelled
+func playHeadless(ctx context.Context, w io.Writer, cfg *config.Config, opts Options, client *navidrome.Client, tracks []models.Track) error {
+	if opts.NoAudio {
+		return errors.New("audio is disabled (-no-audio)")
+	}
+	if len(tracks) == 0 {
+		return errors.New("nothing to play")
+	}
+
+	scrobbler := scrobbling.NewManager(cfg)
+	scrobbler.AttachNavidromeClient(client)
+	defer scrobbler.Close()
+
+	backend, name, err := audio.NewBackend(audio.BackendOptions{
+		Backend:    cfg.Audio.Backend,
+		Device:     cfg.Audio.Device,
+		BufferSize: time.Duration(cfg.Audio.BufferSize) * time.Millisecond,
+		Prebuffer:  cfg.Audio.PrebufferKB * 1024,
+	}, client, scrobbler)
+	if err != nil {
+		return fmt.Errorf("starting audio: %w", err)
+	}
+	defer backend.Close()
+
+	runner := hooks.NewRunner(cfg.Hooks)
+	backend.SetTrackHook(func(track models.Track) {
+		fmt.Fprintf(w, "♪ %s - %s\n", track.Artist, track.Title)
+		runner.TrackStarted(track)
+	})
+	backend.SetVolume(float64(cfg.Audio.Volume) / 100.0)
+
+	fmt.Fprintf(w, "Playing %d tracks (%s backend) - Ctrl+C to stop\n", len(tracks), name)
+	if err := backend.ReplaceQueue(tracks, true); err != nil {
+		return fmt.Errorf("playing %s - %s: %w", tracks[0].Artist, tracks[0].Title, err)
+	}
+	waitForQueueEnd(ctx, backend)
+	return nil
+}
+
+// waitForQueueEnd blocks until playback has stayed stopped for queueEndGrace
+// (the queue ran out) or ctx is cancelled, in which case playback is stopped
+func waitForQueueEnd(ctx context.Context, backend audio.AudioBackend) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	var stoppedSince time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			backend.Stop()
+			return
+		case <-ticker.C:
+			if backend.IsPlaying() {
+				stoppedSince = time.Time{}
+			} else if stoppedSince.IsZero() {
+				stoppedSince = time.Now()
+			} else if time.Since(stoppedSince) >= queueEndGrace {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"navitone-cli/pkg/navidrome"
+)
+
+// searchServer answers search3 with result as the searchResult3 body
+func searchServer(t *testing.T, result string) *navidrome.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/search3" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"subsonic-response":{"status":"ok","searchResult3":%s}}`, result)
+	}))
+	t.Cleanup(server.Close)
+	return navidrome.NewClient(server.URL, "user", "pass")
+}
+
+func TestRunSearch(t *testing.T) {
+	client := searchServer(t, `{
+		"artist":[{"id":"ar1","name":"Boards of Canada"}],
+		"album":[{"id":"al1","name":"Geogaddi","artist":"Boards of Canada","year":2002}],
+		"song":[{"id":"tr1","title":"Dawn Chorus","artist":"Boards of Canada","album":"Geogaddi"}]}`)
+
+	var out bytes.Buffer
+	if err := runSearch(context.Background(), &out, client, "boards"); err != nil {
+		t.Fatalf("runSearch: %v", err)
+	}
+	want := []string{
+		"Artists",
+		"  ar1  Boards of Canada",
+		"Albums",
+		"  al1  Boards of Canada - Geogaddi (2002)",
+		"Tracks",
+		"  tr1  Boards of Canada - Dawn Chorus  Geogaddi",
+	}
+	if got := strings.Split(strings.TrimRight(out.String(), "\n"), "\n"); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestRunSearchNoResults(t *testing.T) {
+	var out bytes.Buffer
+	if err := runSearch(context.Background(), &out, searchServer(t, `{}`), "nothing"); err != nil {
+		t.Fatalf("runSearch: %v", err)
+	}
+	if out.String() != "No results found\n" {
+		t.Errorf("got %q", out.String())
+	}
+}
@@ -0,0 +1,199 @@
+package controllers
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"navitone-cli/internal/models"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// libraryCache is the on-disk snapshot of the last-loaded library lists
+type libraryCache struct {
+	ServerURL string
+	Username  string
+	SavedAt   time.Time
+	Albums    []models.Album
+	Artists   []models.Artist
+	Playlists []models.Playlist
+}
+
+// userCachePath returns a per-server, per-user cache file under the given subdirectory
+func (a *App) userCachePath(subdir string) (string, error) {
+	nav := a.state.ConfigForm.Config.Navidrome
+	if nav.ServerURL == "" {
+		return "", fmt.Errorf("no server configured")
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	key := fmt.Sprintf("%x", md5.Sum([]byte(nav.Username+"@"+nav.ServerURL)))
+	return filepath.Join(cacheDir, "navitone-cli", subdir, key+".json"), nil
+}
+
+// libraryCachePath returns the cache file for the configured server and user
+func (a *App) libraryCachePath() (string, error) {
+	return a.userCachePath("library")
+}
+
+// writeCacheFile marshals v to path via a temp file, so a crash or concurrent write
+// never leaves a truncated file behind
+func writeCacheFile(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "cache-*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	tmp.Close()
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// loadCachedLibrary fills albums, artists and playlists from the disk cache so tabs
+// render instantly; returns whether anything was loaded. Lists are refreshed from the
+// server afterwards, so a stale or unreadable cache is simply ignored.
+func (a *App) loadCachedLibrary() bool {
+	cfg := a.state.ConfigForm.Config
+	if !cfg.Cache.Enabled {
+		return false
+	}
+
+	path, err := a.libraryCachePath()
+	if err != nil {
+		return false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	var cache libraryCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return false
+	}
+
+	if cache.ServerURL != cfg.Navidrome.ServerURL || cache.Username != cfg.Navidrome.Username {
+		return false
+	}
+	if cfg.Cache.TTL > 0 && time.Since(cache.SavedAt) > time.Duration(cfg.Cache.TTL)*time.Hour {
+		return false
+	}
+
+	a.state.Albums = cache.Albums
+	a.state.Artists = cache.Artists
+	a.state.Playlists = cache.Playlists
+	return len(cache.Albums) > 0 || len(cache.Artists) > 0 || len(cache.Playlists) > 0
+}
+
+// cacheLibrary writes the current library lists to disk in the background
+func (a *App) cacheLibrary() tea.Cmd {
+	cfg := a.state.ConfigForm.Config
+	if !cfg.Cache.Enabled {
+		return nil
+	}
+
+	path, err := a.libraryCachePath()
+	if err != nil {
+		return nil
+	}
+
+	cache := libraryCache{
+		ServerURL: cfg.Navidrome.ServerURL,
+		Username:  cfg.Navidrome.Username,
+		SavedAt:   time.Now(),
+		Albums:    a.state.Albums,
+		Artists:   a.state.Artists,
+		Playlists: a.state.Playlists,
+	}
+
+	return func() tea.Msg {
+		writeCacheFile(path, cache)
+		return nil
+	}
+}
+
+// invalidateLibraryCache removes the cached library (used on manual refresh)
+func (a *App) invalidateLibraryCache() {
+	if path, err := a.libraryCachePath(); err == nil {
+		os.Remove(path)
+	}
+}
+
+// refreshCachedLibrary reloads every list that was populated from the cache
+func (a *App) refreshCachedLibrary() tea.Cmd {
+	if a.navidromeClient == nil {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	if len(a.state.Albums) > 0 {
+		cmds = append(cmds, a.loadAlbums())
+	}
+	if len(a.state.Artists) > 0 {
+		cmds = append(cmds, a.loadArtists())
+	}
+	if len(a.state.Playlists) > 0 {
+		cmds = append(cmds, a.loadPlaylists())
+	}
+	return tea.Batch(cmds...)
+}
+
+// refreshAll reloads albums, artists, playlists and home data, skipping any that
+// is still loading so overlapping refreshes don't race each other
+func (a *App) refreshAll() tea.Cmd {
+	if a.navidromeClient == nil {
+		return nil
+	}
+
+	a.lastRefresh = time.Now()
+	var cmds []tea.Cmd
+	if !a.state.LoadingAlbums {
+		cmds = append(cmds, a.loadAlbums())
+	}
+	if !a.state.LoadingArtists {
+		cmds = append(cmds, a.loadArtists())
+	}
+	if !a.state.LoadingPlaylists {
+		cmds = append(cmds, a.loadPlaylists())
+	}
+	if !a.state.LoadingHomeData {
+		cmds = append(cmds, a.loadHomeData())
+	}
+	return tea.Batch(cmds...)
+}
+
+// checkAutoRefresh refreshes the whole library every behavior.auto_refresh_minutes,
+// so albums and playlists added on the server show up during a long session
+func (a *App) checkAutoRefresh(now time.Time) tea.Cmd {
+	minutes := a.state.ConfigForm.Config.Behavior.AutoRefreshMinutes
+	if minutes <= 0 || a.state.Offline {
+		return nil
+	}
+	if a.lastRefresh.IsZero() {
+		a.lastRefresh = now // Startup already loaded everything
+		return nil
+	}
+	if now.Sub(a.lastRefresh) < time.Duration(minutes)*time.Minute {
+		return nil
+	}
+	return a.refreshAll()
+}
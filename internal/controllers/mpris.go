@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/internal/mpris"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MPRISCommandMsg is a playback request from a desktop media widget or media key
+type MPRISCommandMsg struct {
+	Command mpris.Command
+}
+
+// listenForMPRIS waits for the next MPRIS request; its handler re-arms it. It
+// returns nil once the server is stopped.
+func listenForMPRIS(commands <-chan mpris.Command) tea.Cmd {
+	return func() tea.Msg {
+		cmd, ok := <-commands
+		if !ok {
+			return nil
+		}
+		return MPRISCommandMsg{Command: cmd}
+	}
+}
+
+// OtherPlayerStartedMsg reports that another media player entered the Playing state
+type OtherPlayerStartedMsg struct {
+	Player string
+}
+
+// listenForOtherPlayers waits for the next player the watcher reports; its
+// handler re-arms it. It returns nil once the watcher is stopped.
+func listenForOtherPlayers(players <-chan string) tea.Cmd {
+	return func() tea.Msg {
+		player, ok := <-players
+		if !ok {
+			return nil
+		}
+		return OtherPlayerStartedMsg{Player: player}
+	}
+}
+
+// updateMPRISServer starts or stops the MPRIS server to match config.Audio.MPRIS.
+// Without a session bus (e.g. over SSH) navitone just runs without it.
+func (a *App) updateMPRISServer() tea.Cmd {
+	enabled := a.state.ConfigForm.Config.Audio.MPRIS && a.audioManager != nil && runtime.GOOS == "linux"
+	if !enabled {
+		if a.mprisServer != nil {
+			a.mprisServer.Stop()
+			a.mprisServer = nil
+		}
+		return nil
+	}
+	if a.mprisServer != nil {
+		return nil
+	}
+
+	server, err := mpris.NewServer()
+	if err != nil {
+		a.logMessage(fmt.Sprintf("Media keys unavailable: %v", err))
+		return nil
+	}
+	a.mprisServer = server
+	server.Update(models.PlaybackState{
+		CurrentTrack: a.state.CurrentTrack,
+		IsPlaying:    a.state.IsPlaying,
+		IsShuffle:    a.state.IsShuffleMode,
+		Position:     a.state.Position,
+	})
+	return listenForMPRIS(server.Commands())
+}
+
+// handleMPRISCommand runs a desktop playback request and waits for the next one
+func (a *App) handleMPRISCommand(msg MPRISCommandMsg) (tea.Model, tea.Cmd) {
+	var next tea.Cmd
+	if a.mprisServer != nil {
+		next = listenForMPRIS(a.mprisServer.Commands())
+	}
+	if a.audioManager == nil {
+		return a, next
+	}
+
+	var err error
+	switch msg.Command.Action {
+	case mpris.ActionPlayPause:
+		err = a.audioManager.TogglePlayPause()
+	case mpris.ActionPlay:
+		if !a.audioManager.IsPlaying() {
+			err = a.audioManager.TogglePlayPause()
+		}
+	case mpris.ActionPause:
+		a.audioManager.Pause()
+	case mpris.ActionStop:
+		a.audioManager.Stop()
+	case mpris.ActionNext:
+		err = a.audioManager.NextTrack()
+	case mpris.ActionPrevious:
+		err = a.audioManager.PreviousTrack()
+	case mpris.ActionSeek:
+		if seconds := int(msg.Command.Offset / time.Second); seconds != 0 {
+			a.seek(seconds)
+		}
+	}
+	if err != nil {
+		a.logMessage(fmt.Sprintf("Media key %s error: %v", msg.Command.Action, err))
+	}
+	return a, next
+}
+
+// updatePlayerWatcher starts or stops watching other MPRIS players to match
+// config.Audio.PauseOnOther
+func (a *App) updatePlayerWatcher() tea.Cmd {
+	enabled := a.state.ConfigForm.Config.Audio.PauseOnOther && a.audioManager != nil && runtime.GOOS == "linux"
+	if !enabled {
+		if a.playerWatcher != nil {
+			a.playerWatcher.Stop()
+			a.playerWatcher = nil
+		}
+		return nil
+	}
+	if a.playerWatcher != nil {
+		return nil
+	}
+
+	watcher, err := mpris.NewWatcher()
+	if err != nil {
+		a.logMessage(fmt.Sprintf("Auto-pause unavailable: %v", err))
+		return nil
+	}
+	a.playerWatcher = watcher
+	return listenForOtherPlayers(watcher.Playing())
+}
+
+// handleOtherPlayerStarted pauses playback when another media player starts,
+// resuming stays manual, and waits for the next one
+func (a *App) handleOtherPlayerStarted(msg OtherPlayerStartedMsg) (tea.Model, tea.Cmd) {
+	var next tea.Cmd
+	if a.playerWatcher != nil {
+		next = listenForOtherPlayers(a.playerWatcher.Playing())
+	}
+	if a.audioManager == nil || !a.audioManager.IsPlaying() {
+		return a, next
+	}
+	a.audioManager.Pause()
+	a.logMessage(fmt.Sprintf("Paused: %s started playing", msg.Player))
+	return a, next
+}
@@ -0,0 +1,164 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"navitone-cli/internal/models"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// BatchQueueResult carries the tracks of every marked album, artist or playlist, in list order
+type BatchQueueResult struct {
+	Tracks    []models.Track
+	Items     int    // How many marked items the tracks came from
+	Kind      string // "albums", "artists" or "playlists", for the log
+	Failed    int    // Items whose tracks could not be loaded
+	Placement queuePlacement
+	Error     error
+}
+
+// toggleMark marks or unmarks the row at index for batch queueing, then moves the
+// selection down so consecutive rows can be marked quickly
+func (a *App) toggleMark(index int, listLen int, selected *int) {
+	if index < 0 || index >= listLen {
+		return
+	}
+	if a.state.MarkedItems[index] {
+		delete(a.state.MarkedItems, index)
+	} else {
+		if a.state.MarkedItems == nil {
+			a.state.MarkedItems = make(map[int]bool)
+		}
+		a.state.MarkedItems[index] = true
+	}
+	if *selected < listLen-1 {
+		*selected++
+	}
+}
+
+// clearMarks drops the batch selection
+func (a *App) clearMarks() {
+	a.state.MarkedItems = nil
+}
+
+// clearMarksFor drops the batch selection when tab's list is replaced (reloaded or
+// sorted), since the marked indices would no longer point at the same items
+func (a *App) clearMarksFor(tab models.Tab) {
+	if a.state.CurrentTab == tab {
+		a.clearMarks()
+	}
+}
+
+// markedIndices returns the marked rows in list order, ignoring any past listLen
+func (a *App) markedIndices(listLen int) []int {
+	indices := make([]int, 0, len(a.state.MarkedItems))
+	for i := range a.state.MarkedItems {
+		if i < listLen {
+			indices = append(indices, i)
+		}
+	}
+	sort.Ints(indices)
+	return indices
+}
+
+// queueMarkedAlbums places the tracks of every marked album in the queue
+func (a *App) queueMarkedAlbums(placement queuePlacement) tea.Cmd {
+	var albums []models.Album
+	for _, i := range a.markedIndices(len(a.state.Albums)) {
+		albums = append(albums, a.state.Albums[i])
+	}
+	a.clearMarks()
+	return a.queueAlbums(albums, placement)
+}
+
+// queueAlbums places the tracks of albums in the queue, in order
+func (a *App) queueAlbums(albums []models.Album, placement queuePlacement) tea.Cmd {
+	return a.batchQueue("albums", len(albums), placement, func(ctx context.Context, i int) ([]models.Track, error) {
+		resp, err := a.navidromeClient.GetAlbumTracks(ctx, albums[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		return convertSongs(resp.SubsonicResponse.SongsByGenre.Song), nil
+	})
+}
+
+// queueMarkedArtists places the tracks of every marked artist in the queue
+func (a *App) queueMarkedArtists(placement queuePlacement) tea.Cmd {
+	var artists []models.Artist
+	for _, i := range a.markedIndices(len(a.state.Artists)) {
+		artists = append(artists, a.state.Artists[i])
+	}
+	a.clearMarks()
+
+	return a.batchQueue("artists", len(artists), placement, func(ctx context.Context, i int) ([]models.Track, error) {
+		resp, err := a.navidromeClient.GetArtistTracks(ctx, artists[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		return convertSongs(resp.SubsonicResponse.SongsByGenre.Song), nil
+	})
+}
+
+// queueMarkedPlaylists places the tracks of every marked playlist in the queue
+func (a *App) queueMarkedPlaylists(placement queuePlacement) tea.Cmd {
+	var playlists []models.Playlist
+	for _, i := range a.markedIndices(len(a.state.Playlists)) {
+		playlists = append(playlists, a.state.Playlists[i])
+	}
+	a.clearMarks()
+
+	return a.batchQueue("playlists", len(playlists), placement, func(ctx context.Context, i int) ([]models.Track, error) {
+		resp, err := a.navidromeClient.GetPlaylistTracks(ctx, playlists[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		return convertSongs(resp.SubsonicResponse.Playlist.Entry), nil
+	})
+}
+
+// batchQueue loads the tracks of count items one after another, so the queue keeps
+// the list order rather than the order the requests happen to finish in
+func (a *App) batchQueue(kind string, count int, placement queuePlacement, load func(ctx context.Context, i int) ([]models.Track, error)) tea.Cmd {
+	if count == 0 {
+		return nil
+	}
+	if a.navidromeClient == nil {
+		a.logMessage("Cannot queue: not connected to a server")
+		return nil
+	}
+
+	a.logMessage(fmt.Sprintf("Queueing %d %s...", count, kind))
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		result := BatchQueueResult{Items: count, Kind: kind, Placement: placement}
+		for i := 0; i < count; i++ {
+			tracks, err := load(ctx, i)
+			if err != nil {
+				result.Failed++
+				result.Error = err
+				continue
+			}
+			result.Tracks = append(result.Tracks, tracks...)
+		}
+		return result
+	}
+}
+
+// handleBatchQueueResult places the batch in the queue, reporting items that failed to load
+func (a *App) handleBatchQueueResult(msg BatchQueueResult) (tea.Model, tea.Cmd) {
+	if msg.Failed > 0 {
+		a.logMessage(fmt.Sprintf("Failed to load %d of %d %s: %v", msg.Failed, msg.Items, msg.Kind, msg.Error))
+	}
+	if len(msg.Tracks) == 0 {
+		return a, nil
+	}
+
+	a.placeTracks(msg.Tracks, msg.Placement, fmt.Sprintf("%d %s", msg.Items-msg.Failed, msg.Kind))
+	return a, nil
+}
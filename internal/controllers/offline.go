@@ -0,0 +1,221 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/internal/offline"
+	"navitone-cli/pkg/navidrome"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// connectivityInterval is how often the server is pinged to detect offline mode;
+// while it is unreachable, retries back off from reconnectMinDelay up to it
+const (
+	connectivityInterval = 30 * time.Second
+	reconnectMinDelay    = 2 * time.Second
+)
+
+// initOfflineStore opens the download store for the configured server and user and
+// lets the audio backend play from it while offline
+func (a *App) initOfflineStore() {
+	path, err := a.userCachePath("offline")
+	if err != nil {
+		return
+	}
+
+	maxBytes := int64(a.state.ConfigForm.Config.Cache.OfflineMaxMB) * 1024 * 1024
+	store, err := offline.NewStore(strings.TrimSuffix(path, ".json"), maxBytes)
+	if err != nil {
+		a.logMessage(fmt.Sprintf("Offline cache unavailable: %v", err))
+		return
+	}
+	a.offlineStore = store
+	a.refreshCachedTrackIDs()
+
+	if a.audioManager != nil {
+		a.audioManager.SetLocalTrackResolver(store.Path)
+	}
+}
+
+// refreshCachedTrackIDs mirrors the store's contents (after downloads and evictions)
+func (a *App) refreshCachedTrackIDs() {
+	ids := make(map[string]bool)
+	for _, id := range a.offlineStore.IDs() {
+		ids[id] = true
+	}
+	a.state.CachedTrackIDs = ids
+}
+
+// cacheForOffline queues tracks for download, skipping ones already cached or queued
+func (a *App) cacheForOffline(tracks []models.Track) tea.Cmd {
+	if a.offlineStore == nil || a.navidromeClient == nil {
+		a.logMessage("Offline cache unavailable - check the server config")
+		return nil
+	}
+
+	queued := 0
+	for _, track := range tracks {
+		if a.state.CachedTrackIDs[track.ID] || a.isPendingDownload(track.ID) {
+			continue
+		}
+		a.offlinePending = append(a.offlinePending, track)
+		queued++
+	}
+
+	if queued == 0 {
+		a.logMessage("Already cached for offline playback")
+		return nil
+	}
+	a.logMessage(fmt.Sprintf("Caching %d tracks for offline playback", queued))
+
+	if a.offlineDownloading {
+		return nil // The running download picks up the rest
+	}
+	return a.downloadNextOffline()
+}
+
+// isPendingDownload reports whether trackID is waiting to be downloaded
+func (a *App) isPendingDownload(trackID string) bool {
+	for _, track := range a.offlinePending {
+		if track.ID == trackID {
+			return true
+		}
+	}
+	return false
+}
+
+// OfflineDownloadResult reports one finished offline download
+type OfflineDownloadResult struct {
+	Track models.Track
+	Error error
+}
+
+// downloadNextOffline downloads the next pending track in the background
+func (a *App) downloadNextOffline() tea.Cmd {
+	if len(a.offlinePending) == 0 {
+		a.offlineDownloading = false
+		return nil
+	}
+
+	track := a.offlinePending[0]
+	a.offlinePending = a.offlinePending[1:]
+	a.offlineDownloading = true
+
+	store, client := a.offlineStore, a.navidromeClient
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancel()
+		return OfflineDownloadResult{Track: track, Error: store.Download(ctx, client, track.ID)}
+	}
+}
+
+// handleOfflineDownloadResult records a finished download and starts the next one
+func (a *App) handleOfflineDownloadResult(msg OfflineDownloadResult) (tea.Model, tea.Cmd) {
+	if msg.Error != nil {
+		a.logMessage(fmt.Sprintf("Offline download failed for %s: %v", msg.Track.Title, msg.Error))
+	} else {
+		a.refreshCachedTrackIDs()
+		a.logMessage(fmt.Sprintf("Cached for offline: %s - %s", msg.Track.Artist, msg.Track.Title))
+	}
+	return a, a.downloadNextOffline()
+}
+
+// ConnectivityTickMsg triggers a server reachability check
+type ConnectivityTickMsg struct{}
+
+// ConnectivityResult reports whether the server answered a ping
+type ConnectivityResult struct {
+	Online bool
+}
+
+// connectivityTick schedules the next reachability check after delay
+func connectivityTick(delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return ConnectivityTickMsg{}
+	})
+}
+
+// checkConnectivity pings the server in the background
+func (a *App) checkConnectivity() tea.Cmd {
+	client := a.navidromeClient
+	if client == nil {
+		return connectivityTick(connectivityInterval)
+	}
+	timeout := a.state.ConfigForm.Config.Timeouts.PingTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return ConnectivityResult{Online: serverAnswered(client.Ping(ctx))}
+	}
+}
+
+// serverAnswered reports whether a request that returned err reached the server:
+// any Subsonic error (even rejected credentials) means it answered
+func serverAnswered(err error) bool {
+	var apiErr *navidrome.APIError
+	return err == nil || errors.As(err, &apiErr)
+}
+
+// noteRequestError marks the server disconnected in the header when err shows a
+// request never got an answer; the next reachability check clears it
+func (a *App) noteRequestError(err error) {
+	if !serverAnswered(err) && !errors.Is(err, context.Canceled) {
+		a.state.ConnectionState = models.ConnectionDisconnected
+	}
+}
+
+// handleConnectivityResult switches offline mode on or off when reachability
+// changes, reconnecting the client once the server answers again
+func (a *App) handleConnectivityResult(msg ConnectivityResult) (tea.Model, tea.Cmd) {
+	// Deferred so it follows reconnectClient, which starts a new client as connecting
+	defer func() {
+		if msg.Online {
+			a.state.ConnectionState = models.ConnectionConnected
+		} else {
+			a.state.ConnectionState = models.ConnectionDisconnected
+		}
+	}()
+
+	if a.state.Offline == !msg.Online {
+		if a.state.Offline {
+			a.reconnectAttempts++
+		}
+		return a, connectivityTick(a.connectivityDelay())
+	}
+
+	a.state.Offline = !msg.Online
+	a.reconnectAttempts = 0
+	if a.audioManager != nil {
+		a.audioManager.SetOffline(a.state.Offline)
+	}
+	if a.state.Offline {
+		a.logMessage(fmt.Sprintf("Server unreachable - offline mode (%d cached tracks), reconnecting...", len(a.state.CachedTrackIDs)))
+	} else {
+		a.reconnectClient()
+		a.logMessage("Server reachable again - reconnected, streaming resumed")
+	}
+	return a, connectivityTick(a.connectivityDelay())
+}
+
+// connectivityDelay returns how long to wait before the next reachability check:
+// connectivityInterval while the server answers, and a doubling backoff from
+// reconnectMinDelay while it doesn't
+func (a *App) connectivityDelay() time.Duration {
+	if !a.state.Offline {
+		return connectivityInterval
+	}
+	delay := reconnectMinDelay
+	for i := 0; i < a.reconnectAttempts && delay < connectivityInterval; i++ {
+		delay *= 2
+	}
+	if delay > connectivityInterval {
+		delay = connectivityInterval
+	}
+	return delay
+}
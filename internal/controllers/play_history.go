@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"navitone-cli/internal/models"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxPlayHistory caps how many played tracks are kept (in memory and on disk)
+const maxPlayHistory = 200
+
+// playHistoryPath returns the history file for the configured server and user
+func (a *App) playHistoryPath() (string, error) {
+	return a.userCachePath("history")
+}
+
+// loadPlayHistory restores the play history saved by a previous session
+func (a *App) loadPlayHistory() {
+	path, err := a.playHistoryPath()
+	if err != nil {
+		return
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	var history []models.PlayHistoryEntry
+	if err := json.Unmarshal(data, &history); err != nil {
+		return
+	}
+	if len(history) > maxPlayHistory {
+		history = history[:maxPlayHistory]
+	}
+	a.state.PlayHistory = history
+}
+
+// recordPlayHistory adds the current track to the front of the history when it
+// differs from the most recent entry (so RepeatOne loops are only recorded once)
+func (a *App) recordPlayHistory() tea.Cmd {
+	track := a.state.CurrentTrack
+	if track == nil || !a.state.IsPlaying {
+		return nil
+	}
+	if len(a.state.PlayHistory) > 0 && a.state.PlayHistory[0].Track.ID == track.ID {
+		return nil
+	}
+
+	entry := models.PlayHistoryEntry{Track: *track, PlayedAt: time.Now()}
+	history := append([]models.PlayHistoryEntry{entry}, a.state.PlayHistory...)
+	if len(history) > maxPlayHistory {
+		history = history[:maxPlayHistory]
+	}
+	a.state.PlayHistory = history
+	if a.state.ShowHistoryModal {
+		// Keep the same entry selected as the list shifts down
+		a.state.SelectedHistoryIndex++
+	}
+
+	return a.savePlayHistory()
+}
+
+// savePlayHistory writes the play history to disk in the background
+func (a *App) savePlayHistory() tea.Cmd {
+	path, err := a.playHistoryPath()
+	if err != nil {
+		return nil
+	}
+
+	history := a.state.PlayHistory
+	return func() tea.Msg {
+		writeCacheFile(path, history)
+		return nil
+	}
+}
+
+// handleHistoryModalKeyPress handles navigation and replay in the play history modal
+func (a *App) handleHistoryModalKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "alt+h":
+		a.state.ShowHistoryModal = false
+	case "up":
+		if a.state.SelectedHistoryIndex > 0 {
+			a.state.SelectedHistoryIndex--
+		}
+	case "down":
+		if a.state.SelectedHistoryIndex < len(a.state.PlayHistory)-1 {
+			a.state.SelectedHistoryIndex++
+		}
+	case "enter":
+		if a.state.SelectedHistoryIndex < len(a.state.PlayHistory) {
+			a.replayTrack(a.state.PlayHistory[a.state.SelectedHistoryIndex].Track)
+			a.state.ShowHistoryModal = false
+		}
+	case "a":
+		if a.state.SelectedHistoryIndex < len(a.state.PlayHistory) {
+			track := a.state.PlayHistory[a.state.SelectedHistoryIndex].Track
+			if a.audioManager != nil {
+				a.audioManager.AddToQueue(track)
+			} else {
+				a.state.Queue = append(a.state.Queue, track)
+			}
+			a.logMessage(fmt.Sprintf("Added to queue: %s - %s", track.Artist, track.Title))
+		}
+	}
+	return a, nil
+}
+
+// replayTrack plays track immediately, keeping the rest of the queue intact
+func (a *App) replayTrack(track models.Track) {
+	a.logMessage(fmt.Sprintf("Replaying: %s - %s", track.Artist, track.Title))
+
+	if a.audioManager == nil {
+		a.insertTracksNext([]models.Track{track})
+		a.state.CurrentTrack = &track
+		a.state.IsPlaying = true
+		return
+	}
+
+	if a.audioManager.GetCurrentTrack() == nil {
+		a.audioManager.AddToQueue(track)
+		if err := a.audioManager.PlayTrackAtIndex(len(a.audioManager.GetQueue()) - 1); err != nil {
+			a.logMessage(fmt.Sprintf("Failed to play track: %v", err))
+		}
+		return
+	}
+
+	a.audioManager.InsertTracksNext([]models.Track{track})
+	if err := a.audioManager.NextTrack(); err != nil {
+		a.logMessage(fmt.Sprintf("Failed to play track: %v", err))
+	}
+}
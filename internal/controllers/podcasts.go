@@ -0,0 +1,238 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newestPodcastCount is how many episodes the "Newest episodes" row lists
+const newestPodcastCount = 20
+
+// htmlTag matches the markup in podcast show notes
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// PodcastsLoadResult carries the podcast channels with their episodes
+type PodcastsLoadResult struct {
+	Channels []models.PodcastChannel
+	Error    error
+}
+
+// openPodcasts shows the podcast browser and refreshes its channels
+func (a *App) openPodcasts() tea.Cmd {
+	if a.state.PodcastsUnavailable {
+		a.logMessage("Podcasts aren't available on this server")
+		return nil
+	}
+	if a.navidromeClient == nil {
+		a.logMessage("Podcasts unavailable: not connected to a server")
+		return nil
+	}
+	a.state.ShowPodcasts = true
+	a.state.OpenPodcast = -1
+	a.state.SelectedPodcastIndex = 0
+	return a.loadPodcasts()
+}
+
+// loadPodcasts fetches the channels and their episodes, plus the newest episodes
+// across all of them, in the background
+func (a *App) loadPodcasts() tea.Cmd {
+	client := a.navidromeClient
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	a.state.LoadingPodcasts = true
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		channels, err := client.GetPodcasts(ctx, true)
+		if err != nil {
+			return PodcastsLoadResult{Error: err}
+		}
+
+		titles := make(map[string]string, len(channels))
+		result := make([]models.PodcastChannel, 0, len(channels)+1)
+		for _, channel := range channels {
+			titles[channel.ID] = channel.Title
+		}
+		// The newest episodes are a convenience, so a failure only leaves them out
+		if newest, err := client.GetNewestPodcasts(ctx, newestPodcastCount); err == nil && len(newest) > 0 {
+			result = append(result, models.PodcastChannel{
+				Title:    "Newest episodes",
+				Episodes: convertEpisodes(newest, titles),
+			})
+		}
+		for _, channel := range channels {
+			result = append(result, models.PodcastChannel{
+				ID:          channel.ID,
+				Title:       channel.Title,
+				Description: plainText(channel.Description),
+				Episodes:    convertEpisodes(channel.Episode, titles),
+			})
+		}
+		return PodcastsLoadResult{Channels: result}
+	}
+}
+
+// convertEpisodes turns the episodes the server has downloaded into tracks, with
+// the channel title (from titles) standing in for artist and album
+func convertEpisodes(episodes []navidrome.PodcastEpisode, titles map[string]string) []models.Track {
+	tracks := make([]models.Track, 0, len(episodes))
+	for _, episode := range episodes {
+		if episode.Status != "" && episode.Status != "completed" {
+			continue // Not downloaded by the server, so there is nothing to stream
+		}
+		id := episode.StreamID
+		if id == "" {
+			id = episode.ID
+		}
+		channel := titles[episode.ChannelID]
+		if channel == "" {
+			channel = episode.Album
+		}
+		tracks = append(tracks, models.Track{
+			ID:          id,
+			Title:       episode.Title,
+			Artist:      channel,
+			Album:       channel,
+			Duration:    episode.Duration,
+			Size:        episode.Size,
+			Suffix:      episode.Suffix,
+			BitRate:     episode.BitRate,
+			IsPodcast:   true,
+			PublishDate: parsePublishDate(episode.PublishDate),
+			Description: plainText(episode.Description),
+		})
+	}
+	return tracks
+}
+
+// parsePublishDate reads an episode's ISO 8601 publish date, with or without a
+// time zone; the zero time when it is missing or unreadable
+func parsePublishDate(s string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// plainText strips the HTML from show notes and collapses their whitespace
+func plainText(s string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(htmlTag.ReplaceAllString(s, " "))), " ")
+}
+
+// podcastsUnsupported reports whether err means the server doesn't offer podcasts
+// (not implemented, switched off, or not allowed for this user)
+func podcastsUnsupported(err error) bool {
+	return errors.Is(err, navidrome.ErrNotImplemented) ||
+		navidrome.IsAPIError(err, navidrome.ErrorCodeNotFound) ||
+		navidrome.IsAPIError(err, navidrome.ErrorCodeNotAuthorized)
+}
+
+// handlePodcastsLoadResult shows the loaded channels, or hides podcasts for the rest
+// of the session when the server doesn't support them
+func (a *App) handlePodcastsLoadResult(msg PodcastsLoadResult) (tea.Model, tea.Cmd) {
+	a.state.LoadingPodcasts = false
+	if msg.Error != nil {
+		if podcastsUnsupported(msg.Error) {
+			a.state.PodcastsUnavailable = true
+			a.state.ShowPodcasts = false
+			a.logMessage("Podcasts aren't available on this server")
+			return a, nil
+		}
+		a.logMessage(fmt.Sprintf("Failed to load podcasts: %v", msg.Error))
+		return a, nil
+	}
+
+	a.state.PodcastChannels = msg.Channels
+	if a.state.OpenPodcast >= len(msg.Channels) {
+		a.state.OpenPodcast = -1
+	}
+	a.state.SelectedPodcastIndex = max(0, min(a.state.SelectedPodcastIndex, a.podcastRowCount()-1))
+	return a, nil
+}
+
+// podcastRowCount returns how many rows the podcast browser lists: channels, or
+// the open channel's episodes
+func (a *App) podcastRowCount() int {
+	if a.state.OpenPodcast >= 0 {
+		return len(a.state.PodcastChannels[a.state.OpenPodcast].Episodes)
+	}
+	return len(a.state.PodcastChannels)
+}
+
+// handlePodcastsKeyPress opens a channel with Enter (or →), goes back to the
+// channels with Backspace (or ←), and plays or queues episodes
+func (a *App) handlePodcastsKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	rows := a.podcastRowCount()
+	switch msg.String() {
+	case "esc", "q", "alt+p":
+		a.state.ShowPodcasts = false
+	case "up":
+		if a.state.SelectedPodcastIndex > 0 {
+			a.state.SelectedPodcastIndex--
+		}
+	case "down":
+		if a.state.SelectedPodcastIndex < rows-1 {
+			a.state.SelectedPodcastIndex++
+		}
+	case "pgup":
+		a.state.SelectedPodcastIndex = max(0, a.state.SelectedPodcastIndex-10)
+	case "pgdown":
+		a.state.SelectedPodcastIndex = max(0, min(rows-1, a.state.SelectedPodcastIndex+10))
+	case "home":
+		a.state.SelectedPodcastIndex = 0
+	case "end":
+		a.state.SelectedPodcastIndex = max(0, rows-1)
+	case "r":
+		return a, a.loadPodcasts()
+	case "backspace", "left":
+		if a.state.OpenPodcast >= 0 {
+			a.state.SelectedPodcastIndex = a.state.OpenPodcast
+			a.state.OpenPodcast = -1
+		}
+	case "enter", "shift+enter", "right":
+		if a.state.SelectedPodcastIndex >= rows {
+			break
+		}
+		if a.state.OpenPodcast < 0 {
+			a.state.OpenPodcast = a.state.SelectedPodcastIndex
+			a.state.SelectedPodcastIndex = 0
+			break
+		}
+		if msg.String() == "right" {
+			break
+		}
+		episode := a.state.PodcastChannels[a.state.OpenPodcast].Episodes[a.state.SelectedPodcastIndex]
+		if a.queueOnlyKey(msg.String()) {
+			a.logMessage(fmt.Sprintf("Queued episode: %s - %s", episode.Artist, episode.Title))
+			return a, a.addTrackToQueue(episode)
+		}
+		a.state.ShowPodcasts = false
+		a.playPodcastEpisode(episode)
+	}
+	return a, nil
+}
+
+// playPodcastEpisode replaces the queue with one episode. Shuffle is turned off,
+// since episodes are meant to be heard in order.
+func (a *App) playPodcastEpisode(episode models.Track) {
+	if a.audioManager != nil && a.audioManager.IsShuffleEnabled() {
+		a.audioManager.ToggleShuffle()
+		a.logMessage("Shuffle turned off for podcast playback")
+	} else if a.audioManager == nil {
+		a.state.IsShuffleMode = false
+	}
+	a.replaceQueue([]models.Track{episode})
+	a.logMessage(fmt.Sprintf("Playing episode: %s - %s", episode.Artist, episode.Title))
+}
@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"fmt"
+
+	"navitone-cli/internal/config"
+	"navitone-cli/internal/models"
+)
+
+// queuePlacement is where a loaded album, artist or playlist goes in the queue
+type queuePlacement int
+
+const (
+	placeAppend  queuePlacement = iota // After the last track
+	placeNext                          // Right after the current track
+	placeReplace                       // Instead of the whole queue, playing from the first track
+)
+
+// queueKeyPlacement returns what a (or Alt+Enter) and Alt+A do with an album,
+// artist or playlist: a follows behavior.default_queue_mode and Alt+A does the other
+func (a *App) queueKeyPlacement(key string) queuePlacement {
+	replace := a.state.ConfigForm.Config.Behavior.DefaultQueueMode == config.QueueModeReplace
+	if key == "alt+a" {
+		replace = !replace
+	}
+	if replace {
+		return placeReplace
+	}
+	return placeAppend
+}
+
+// placeTracks puts the tracks of a loaded album or playlist (what) into the queue
+func (a *App) placeTracks(tracks []models.Track, placement queuePlacement, what string) {
+	switch placement {
+	case placeNext:
+		a.insertTracksNext(tracks)
+		a.logMessage(fmt.Sprintf("Playing %s next (%d tracks)", what, len(tracks)))
+	case placeReplace:
+		a.replaceQueue(tracks)
+		a.logMessage(fmt.Sprintf("Replaced queue with %s (%d tracks)", what, len(tracks)))
+	default:
+		if a.audioManager != nil {
+			// State will be updated via the audio manager events
+			a.audioManager.AddTracksToQueue(tracks)
+			a.logMessage(fmt.Sprintf("Added %s to queue (%d tracks)", what, len(tracks)))
+		} else {
+			a.state.Queue = append(a.state.Queue, tracks...)
+			a.logMessage(fmt.Sprintf("Added %s to queue (%d tracks, total: %d)", what, len(tracks), len(a.state.Queue)))
+		}
+	}
+}
+
+// replaceQueue swaps the whole queue for tracks and plays the first one
+func (a *App) replaceQueue(tracks []models.Track) {
+	if len(tracks) == 0 {
+		return
+	}
+	if a.audioManager != nil {
+		if err := a.audioManager.ReplaceQueue(tracks, true); err != nil {
+			a.logMessage(fmt.Sprintf("Failed to play %s - %s: %v", tracks[0].Artist, tracks[0].Title, err))
+		}
+		return
+	}
+
+	a.state.Queue = append([]models.Track(nil), tracks...)
+	a.state.CurrentTrack = &a.state.Queue[0]
+	a.state.IsPlaying = true
+}
+
+// undoQueueChange brings back the queue from before the last clear, replace or trim
+// and restarts the track that was current in it. The queue it replaces becomes the
+// next undo, so undoing twice swaps back.
+func (a *App) undoQueueChange() {
+	if a.audioManager == nil {
+		return
+	}
+	tracks, index := a.audioManager.PreviousQueue()
+	if len(tracks) == 0 {
+		a.logMessage("Nothing to undo")
+		return
+	}
+
+	if err := a.audioManager.ReplaceQueue(tracks, false); err != nil {
+		a.logMessage(fmt.Sprintf("Undo failed: %v", err))
+		return
+	}
+	a.state.SelectedQueueIndex = 0
+	if index >= 0 && index < len(tracks) {
+		a.state.SelectedQueueIndex = index
+		if err := a.audioManager.PlayTrackAtIndex(index); err != nil {
+			a.logMessage(fmt.Sprintf("Failed to play %s - %s: %v", tracks[index].Artist, tracks[index].Title, err))
+		}
+	}
+	a.logMessage(fmt.Sprintf("Restored previous queue (%d tracks)", len(tracks)))
+}
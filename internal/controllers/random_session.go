@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"navitone-cli/internal/models"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RandomSessionResult carries the random tracks fetched for a new session
+type RandomSessionResult struct {
+	Tracks []models.Track
+	Error  error
+}
+
+// handleRandomPickerKeyPress chooses how many random tracks to start a session with
+func (a *App) handleRandomPickerKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		a.state.ShowRandomPicker = false
+	case "up":
+		if a.state.SelectedRandomIndex > 0 {
+			a.state.SelectedRandomIndex--
+		}
+	case "down":
+		if a.state.SelectedRandomIndex < len(models.RandomSessionCounts)-1 {
+			a.state.SelectedRandomIndex++
+		}
+	case "enter":
+		a.state.ShowRandomPicker = false
+		return a, a.startRandomSession(models.RandomSessionCounts[a.state.SelectedRandomIndex])
+	}
+	return a, nil
+}
+
+// startRandomSession fetches count random tracks from the whole library
+func (a *App) startRandomSession(count int) tea.Cmd {
+	client := a.navidromeClient
+	if client == nil {
+		a.logMessage("Random session unavailable: not connected to a server")
+		return nil
+	}
+
+	a.logMessage(fmt.Sprintf("Picking %d random tracks...", count))
+	timeout := a.state.ConfigForm.Config.Timeouts.LibraryTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		resp, err := client.GetSongs(ctx, count, 0)
+		if err != nil {
+			return RandomSessionResult{Error: err}
+		}
+		return RandomSessionResult{Tracks: convertSongs(resp.SubsonicResponse.SongsByGenre.Song)}
+	}
+}
+
+// handleRandomSessionResult replaces the queue with the random tracks, turns shuffle
+// on and starts playing; unlike Alt+Shift+S the existing queue is discarded
+func (a *App) handleRandomSessionResult(msg RandomSessionResult) (tea.Model, tea.Cmd) {
+	if msg.Error != nil {
+		a.logMessage(fmt.Sprintf("Failed to load random tracks: %v", msg.Error))
+		return a, nil
+	}
+	if len(msg.Tracks) == 0 {
+		a.logMessage("Random session: the server returned no tracks")
+		return a, nil
+	}
+
+	a.state.SelectedQueueIndex = 0
+	if a.audioManager == nil {
+		a.state.Queue = msg.Tracks
+		a.state.IsShuffleMode = true
+		a.logMessage(fmt.Sprintf("Queued %d random tracks", len(msg.Tracks)))
+		return a, nil
+	}
+
+	a.audioManager.ClearQueue()
+	a.audioManager.AddTracksToQueue(msg.Tracks)
+	if !a.audioManager.IsShuffleEnabled() {
+		a.audioManager.ToggleShuffle()
+	}
+	if err := a.audioManager.PlayTrackAtIndex(0); err != nil {
+		a.logMessage(fmt.Sprintf("Failed to start random session: %v", err))
+		return a, nil
+	}
+	a.logMessage(fmt.Sprintf("Playing %d random tracks (shuffle on)", len(msg.Tracks)))
+	return a, nil
+}
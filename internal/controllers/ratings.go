@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"navitone-cli/internal/models"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RatingResult reports the outcome of a setRating call
+type RatingResult struct {
+	ID     string
+	Name   string
+	Rating int
+	Error  error
+}
+
+// ratingKey maps the 0-5 keys to a star rating; 0 clears the rating
+func ratingKey(key string) (int, bool) {
+	if len(key) == 1 && key[0] >= '0' && key[0] <= '5' {
+		return int(key[0] - '0'), true
+	}
+	return 0, false
+}
+
+// setRating rates a song or album on the server in the background
+func (a *App) setRating(id, name string, rating int) tea.Cmd {
+	client := a.navidromeClient
+	if client == nil {
+		return nil
+	}
+	timeout := a.state.ConfigForm.Config.Timeouts.MetadataTimeout()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return RatingResult{ID: id, Name: name, Rating: rating, Error: client.SetRating(ctx, id, rating)}
+	}
+}
+
+// rateTrack rates a track
+func (a *App) rateTrack(track models.Track, rating int) tea.Cmd {
+	return a.setRating(track.ID, track.Title, rating)
+}
+
+// rateAlbum rates an album
+func (a *App) rateAlbum(album models.Album, rating int) tea.Cmd {
+	return a.setRating(album.ID, album.Name, rating)
+}
+
+// handleRatingResult records a rating the server accepted
+func (a *App) handleRatingResult(msg RatingResult) (tea.Model, tea.Cmd) {
+	if msg.Error != nil {
+		a.logMessage(fmt.Sprintf("Failed to rate %s: %v", msg.Name, msg.Error))
+		return a, nil
+	}
+
+	if a.state.Ratings == nil {
+		a.state.Ratings = make(map[string]int)
+	}
+	a.state.Ratings[msg.ID] = msg.Rating
+	if msg.Rating == 0 {
+		a.logMessage(fmt.Sprintf("Cleared rating for %s", msg.Name))
+	} else {
+		a.logMessage(fmt.Sprintf("Rated %s %s", msg.Name, models.RatingStars(msg.Rating)))
+	}
+	return a, nil
+}
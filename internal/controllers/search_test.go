@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"fmt"
+	"testing"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+)
+
+func searchTracksPage(prefix string, n int) []models.Track {
+	tracks := make([]models.Track, n)
+	for i := range tracks {
+		tracks[i] = models.Track{ID: fmt.Sprintf("%s%d", prefix, i), Title: "Track"}
+	}
+	return tracks
+}
+
+// TestSearchMoreResultForOldQuery checks that a page requested before the query
+// changed is dropped rather than appended to the new results
+func TestSearchMoreResultForOldQuery(t *testing.T) {
+	app := newTestApp(t)
+	app.navidromeClient = navidrome.NewClient("http://navidrome.invalid", "user", "pass")
+	app.state.SearchQuery = "old"
+	app.state.SearchResults.Tracks = searchTracksPage("old", models.SearchPageSize)
+	app.state.SearchTracksOffset = models.SearchPageSize
+
+	if app.loadMoreSearchResults("tracks") == nil {
+		t.Fatal("no command to load the next page")
+	}
+	stale := SearchMoreResult{Seq: app.searchSeq, Section: "tracks", Tracks: searchTracksPage("old-more", 3)}
+
+	// The query changes and its first page arrives before the stale page
+	app.state.SearchQuery = "new"
+	app.performSearch()
+	app.Update(SearchResult{Seq: app.searchSeq, Results: models.SearchResults{Tracks: searchTracksPage("new", 2)}})
+	app.Update(stale)
+
+	if got := len(app.state.SearchResults.Tracks); got != 2 {
+		t.Errorf("got %d tracks, want the new query's 2", got)
+	}
+	if app.state.SearchTracksOffset != 2 {
+		t.Errorf("tracks offset %d, want 2", app.state.SearchTracksOffset)
+	}
+}
+
+// TestLoadMoreSearchResultsOnce checks that pressing more again before the page
+// arrives doesn't request the same offset twice
+func TestLoadMoreSearchResultsOnce(t *testing.T) {
+	app := newTestApp(t)
+	app.navidromeClient = navidrome.NewClient("http://navidrome.invalid", "user", "pass")
+	app.state.SearchQuery = "query"
+	app.state.SearchResults.Tracks = searchTracksPage("t", models.SearchPageSize)
+	app.state.SearchTracksOffset = models.SearchPageSize
+
+	if app.loadMoreSearchResults("tracks") == nil {
+		t.Fatal("no command to load the next page")
+	}
+	if app.loadMoreSearchResults("tracks") != nil {
+		t.Error("requested the same page again while it was loading")
+	}
+
+	app.Update(SearchMoreResult{Seq: app.searchSeq, Section: "tracks", Tracks: searchTracksPage("more", 3)})
+	if got := len(app.state.SearchResults.Tracks); got != models.SearchPageSize+3 {
+		t.Errorf("got %d tracks, want %d", got, models.SearchPageSize+3)
+	}
+	if app.state.LoadingSearchResults {
+		t.Error("still loading after the page arrived")
+	}
+}
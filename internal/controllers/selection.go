@@ -0,0 +1,35 @@
+package controllers
+
+// selectedID returns the ID of the selected item (idAt gives the ID at each of n
+// indexes), or "" when the selection is outside the list
+func selectedID(index, n int, idAt func(int) string) string {
+	if index < 0 || index >= n {
+		return ""
+	}
+	return idAt(index)
+}
+
+// reselect returns the new index of the previously selected item after its list was
+// replaced, so the selection follows the item rather than its row. With duplicates
+// (the same track queued twice) the occurrence nearest the old row wins. When the
+// item is gone the old index is kept, clamped to the new list.
+func reselect(id string, old, n int, idAt func(int) string) int {
+	if old >= n {
+		old = n - 1
+	}
+	if old < 0 {
+		old = 0
+	}
+	if id == "" {
+		return old
+	}
+	for distance := 0; distance < n; distance++ {
+		if i := old - distance; i >= 0 && idAt(i) == id {
+			return i
+		}
+		if i := old + distance; i < n && idAt(i) == id {
+			return i
+		}
+	}
+	return old
+}
@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"log"
+
+	"navitone-cli/internal/config"
+	"navitone-cli/internal/models"
+)
+
+// restoreSession reopens the tab and selections saved on the last exit, unless
+// behavior.restore_session is off. Lists already shown from the library cache
+// get their selection now; the rest get it from their load handlers.
+func (a *App) restoreSession() {
+	if !a.state.ConfigForm.Config.Behavior.RestoreSession {
+		return
+	}
+
+	session := config.LoadSession()
+	if tab := models.Tab(session.Tab); tab >= models.HomeTab && tab <= models.ConfigTab {
+		a.state.CurrentTab = tab
+	}
+
+	a.restoredSelection = make(map[models.Tab]string)
+	restore := func(tab models.Tab, id string, n int, idAt func(int) string, index *int) {
+		if id == "" {
+			return
+		}
+		if n == 0 {
+			a.restoredSelection[tab] = id
+			return
+		}
+		*index = reselect(id, 0, n, idAt)
+	}
+	restore(models.AlbumsTab, session.AlbumID, len(a.state.Albums), func(i int) string {
+		return a.state.Albums[i].ID
+	}, &a.state.SelectedAlbumIndex)
+	restore(models.ArtistsTab, session.ArtistID, len(a.state.Artists), func(i int) string {
+		return a.state.Artists[i].ID
+	}, &a.state.SelectedArtistIndex)
+	restore(models.PlaylistsTab, session.PlaylistID, len(a.state.Playlists), func(i int) string {
+		return a.state.Playlists[i].ID
+	}, &a.state.SelectedPlaylistIndex)
+}
+
+// takeRestoredSelection returns (once) the item ID the last session had selected on
+// a tab whose list wasn't cached, or "" when there is none
+func (a *App) takeRestoredSelection(tab models.Tab) string {
+	id := a.restoredSelection[tab]
+	delete(a.restoredSelection, tab)
+	return id
+}
+
+// saveSession records the current tab and selections for the next start
+func (a *App) saveSession() {
+	if !a.state.ConfigForm.Config.Behavior.RestoreSession {
+		return
+	}
+
+	session := &config.Session{
+		Tab: int(a.state.CurrentTab),
+		AlbumID: selectedID(a.state.SelectedAlbumIndex, len(a.state.Albums), func(i int) string {
+			return a.state.Albums[i].ID
+		}),
+		ArtistID: selectedID(a.state.SelectedArtistIndex, len(a.state.Artists), func(i int) string {
+			return a.state.Artists[i].ID
+		}),
+		PlaylistID: selectedID(a.state.SelectedPlaylistIndex, len(a.state.Playlists), func(i int) string {
+			return a.state.Playlists[i].ID
+		}),
+	}
+	// A list that never loaded this time keeps the selection it was restored with
+	if session.AlbumID == "" {
+		session.AlbumID = a.restoredSelection[models.AlbumsTab]
+	}
+	if session.ArtistID == "" {
+		session.ArtistID = a.restoredSelection[models.ArtistsTab]
+	}
+	if session.PlaylistID == "" {
+		session.PlaylistID = a.restoredSelection[models.PlaylistsTab]
+	}
+
+	if err := config.SaveSession(session); err != nil {
+		log.Printf("Failed to save session: %v", err)
+	}
+}
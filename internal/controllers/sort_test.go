@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"testing"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+)
+
+func albumsByYear(years ...int) []models.Album {
+	albums := make([]models.Album, len(years))
+	for i, year := range years {
+		albums[i] = models.Album{ID: string(rune('a' + i)), Year: year}
+	}
+	return albums
+}
+
+// TestReloadReappliesAlbumSort checks that a reload sorts the new albums the way the
+// active sort was first applied, keeping the selected album selected
+func TestReloadReappliesAlbumSort(t *testing.T) {
+	tests := []struct {
+		name       string
+		sortBy     string
+		inMemory   bool
+		wantServer bool // Whether the reload asks the server for its sorted list
+	}{
+		{"year from the server", "year", false, true},
+		{"year after the server fell back", "year", true, false},
+		{"play count, always in memory", "play_count", true, false},
+		{"starred from the server", "starred", false, true},
+		{"date added, the load order", "date_added", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newTestApp(t)
+			app.navidromeClient = navidrome.NewClient("http://navidrome.invalid", "user", "pass")
+			app.state.ActiveSorts["albums"] = tt.sortBy
+			app.albumSortInMemory = tt.inMemory
+			app.state.Albums = albumsByYear(1990, 2000, 2010)
+			app.state.SelectedAlbumIndex = 1 // "b"
+
+			reloaded := albumsByYear(1990, 2000, 2010)
+			reloaded[0].PlayCount, reloaded[2].PlayCount = 1, 9
+			_, cmd := app.Update(AlbumsLoadResult{Albums: reloaded})
+
+			if got := app.state.LoadingAlbums; got != tt.wantServer {
+				t.Errorf("asked the server again: %v, want %v", got, tt.wantServer)
+			}
+			if tt.wantServer && cmd == nil {
+				t.Fatal("reload returned no command to fetch the sorted albums")
+			}
+			if !tt.wantServer && tt.sortBy != "date_added" && app.state.Albums[0].ID != "c" {
+				t.Errorf("albums not sorted in memory: first is %s", app.state.Albums[0].ID)
+			}
+			if selected := app.state.Albums[app.state.SelectedAlbumIndex].ID; selected != "b" {
+				t.Errorf("selected %s after the reload, want b", selected)
+			}
+		})
+	}
+}
+
+func TestAlbumsSortResultKeepsSelection(t *testing.T) {
+	for _, keep := range []bool{true, false} {
+		app := newTestApp(t)
+		app.state.Albums = albumsByYear(1990, 2000, 2010)
+		app.state.SelectedAlbumIndex = 1 // "b"
+
+		app.Update(AlbumsSortResult{Albums: []models.Album{{ID: "c"}, {ID: "a"}, {ID: "b"}}, SortBy: "alpha", KeepSelection: keep})
+
+		wantIndex := 2
+		if !keep {
+			wantIndex = 0
+		}
+		if app.state.SelectedAlbumIndex != wantIndex {
+			t.Errorf("KeepSelection %v: selected index %d, want %d", keep, app.state.SelectedAlbumIndex, wantIndex)
+		}
+		if app.state.ActiveSorts["albums"] != "alpha" || app.albumSortInMemory {
+			t.Errorf("active sort %q (in memory %v), want alpha from the server", app.state.ActiveSorts["albums"], app.albumSortInMemory)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"testing"
+
+	"navitone-cli/internal/audio"
+	"navitone-cli/internal/config"
+	"navitone-cli/internal/models"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// volumeBackend records the volume the app last set
+type volumeBackend struct {
+	fakeBackend
+	volume float64
+}
+
+func (v *volumeBackend) SetVolume(volume float64) { v.volume = volume }
+
+func TestClampVolume(t *testing.T) {
+	tests := []struct {
+		volume, limit, want int
+	}{
+		{-5, 100, 0},
+		{0, 100, 0},
+		{50, 100, 50},
+		{100, 100, 100},
+		{105, 100, 100},
+		{130, config.MaxBoostVolume, 130},
+		{config.MaxBoostVolume + 10, config.MaxBoostVolume, config.MaxBoostVolume},
+	}
+	for _, tt := range tests {
+		if got := clampVolume(tt.volume, tt.limit); got != tt.want {
+			t.Errorf("clampVolume(%d, %d) = %d, want %d", tt.volume, tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestAdjustVolumeClamps(t *testing.T) {
+	app := newTestApp(t)
+	backend := &volumeBackend{}
+	app.audioManager = backend
+	app.audioBackend = audio.BackendOto
+
+	app.setVolume(3)
+	app.adjustVolume(-5)
+	if app.state.Volume != 0 || backend.volume != 0 {
+		t.Errorf("below 0: UI %d%%, backend %v; want 0", app.state.Volume, backend.volume)
+	}
+
+	app.setVolume(98)
+	app.adjustVolume(5)
+	if app.state.Volume != 100 || backend.volume != 1 {
+		t.Errorf("above 100: UI %d%%, backend %v; want 100%%", app.state.Volume, backend.volume)
+	}
+}
+
+func TestMuteAdjustUnmute(t *testing.T) {
+	app := newTestApp(t)
+	backend := &volumeBackend{}
+	app.audioManager = backend
+	app.setVolume(60)
+
+	app.toggleMute()
+	if !app.state.Muted || app.state.Volume != 0 || backend.volume != 0 || app.state.PreMuteVolume != 60 {
+		t.Fatalf("after mute: muted %v, volume %d%%, backend %v, remembered %d%%", app.state.Muted, app.state.Volume, backend.volume, app.state.PreMuteVolume)
+	}
+
+	// Adjusting while muted unmutes relative to the remembered level
+	app.adjustVolume(-5)
+	if app.state.Muted || app.state.Volume != 55 || backend.volume != 0.55 {
+		t.Fatalf("after adjusting: muted %v, volume %d%%, backend %v; want unmuted at 55%%", app.state.Muted, app.state.Volume, backend.volume)
+	}
+
+	// Muting again remembers the adjusted level, and unmuting restores it
+	app.toggleMute()
+	app.toggleMute()
+	if app.state.Muted || app.state.Volume != 55 || backend.volume != 0.55 {
+		t.Fatalf("after unmute: muted %v, volume %d%%, backend %v; want 55%%", app.state.Muted, app.state.Volume, backend.volume)
+	}
+}
+
+// TestConfigFormVolumeLimit checks that the config form takes a boosted default
+// volume only with allow_boost on
+func TestConfigFormVolumeLimit(t *testing.T) {
+	for _, boost := range []bool{false, true} {
+		app := newTestApp(t)
+		app.state.CurrentTab = models.ConfigTab
+		cf := app.state.ConfigForm
+		cf.Config.Audio.AllowBoost = boost
+		cf.ActiveField = models.VolumeField
+		cf.EditMode = true
+		cf.CurrentInput = "130"
+
+		app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		if accepted := cf.Config.Audio.Volume == 130; accepted != boost {
+			t.Errorf("allow_boost %v: volume %d, error %q", boost, cf.Config.Audio.Volume, cf.ValidationError)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package downloads
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"navitone-cli/internal/models"
+	"navitone-cli/pkg/navidrome"
+)
+
+// trackTimeout bounds a single track download
+const trackTimeout = 10 * time.Minute
+
+// Result reports one finished track download
+type Result struct {
+	Track   models.Track
+	Path    string // Where the track was (or already is) saved
+	Skipped bool   // An identical file already existed
+	Error   error
+}
+
+// Downloader saves tracks into a music folder laid out as Artist/Album/NN - Title.ext,
+// running at most workers downloads at once across all batches
+type Downloader struct {
+	slots chan struct{}
+
+	reserved map[string]bool // Paths being written by running downloads
+	mu       sync.Mutex
+}
+
+// NewDownloader creates a downloader that runs up to workers downloads in parallel
+func NewDownloader(workers int) *Downloader {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Downloader{
+		slots:    make(chan struct{}, workers),
+		reserved: make(map[string]bool),
+	}
+}
+
+// Download saves tracks from client under root in the background. Results arrive on
+// the returned channel, which is closed once every track has finished.
+func (d *Downloader) Download(client *navidrome.Client, root string, tracks []models.Track) <-chan Result {
+	results := make(chan Result, len(tracks))
+
+	go func() {
+		var wg sync.WaitGroup
+		for _, track := range tracks {
+			d.slots <- struct{}{} // Wait for a free worker
+			wg.Add(1)
+			go func(track models.Track) {
+				defer func() {
+					<-d.slots
+					wg.Done()
+				}()
+				results <- d.downloadTrack(client, root, track)
+			}(track)
+		}
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+// downloadTrack saves one track, skipping it when the same file is already on disk
+func (d *Downloader) downloadTrack(client *navidrome.Client, root string, track models.Track) Result {
+	path, skip := d.reserve(TrackPath(root, track), track.Size)
+	if skip {
+		return Result{Track: track, Path: path, Skipped: true}
+	}
+	defer d.release(path)
+
+	ctx, cancel := context.WithTimeout(context.Background(), trackTimeout)
+	defer cancel()
+	if err := client.DownloadTrack(ctx, track.ID, path); err != nil {
+		return Result{Track: track, Error: err}
+	}
+	return Result{Track: track, Path: path}
+}
+
+// reserve picks a free file name for path, appending " (2)", " (3)"... on collisions.
+// It reports skip when path already holds a file of the expected size.
+func (d *Downloader) reserve(path string, size int64) (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(path, ext)
+	candidate := path
+	for n := 2; ; n++ {
+		info, err := os.Stat(candidate)
+		if !d.reserved[candidate] {
+			if err != nil {
+				break
+			}
+			if size > 0 && info.Size() == size {
+				return candidate, true
+			}
+		}
+		candidate = fmt.Sprintf("%s (%d)%s", base, n, ext)
+	}
+
+	d.reserved[candidate] = true
+	return candidate, false
+}
+
+// release frees a path reserved by reserve
+func (d *Downloader) release(path string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.reserved, path)
+}
+
+// TrackPath returns where track is saved under root: Artist/Album/NN - Title.ext
+func TrackPath(root string, track models.Track) string {
+	name := SanitizeName(track.Title)
+	if track.Track > 0 {
+		name = fmt.Sprintf("%02d - %s", track.Track, name)
+	}
+	if track.Disc > 1 {
+		name = fmt.Sprintf("%d-%s", track.Disc, name)
+	}
+	return filepath.Join(root, SanitizeName(track.Artist), SanitizeName(track.Album), name+trackExt(track))
+}
+
+// trackExt returns the original file's extension, which the download endpoint serves
+func trackExt(track models.Track) string {
+	if track.Suffix != "" {
+		return "." + strings.ToLower(track.Suffix)
+	}
+	if ext := filepath.Ext(track.Path); ext != "" {
+		return strings.ToLower(ext)
+	}
+	return ".audio"
+}
+
+// SanitizeName makes name safe as a single path element on common filesystems.
+// Empty names become "Unknown".
+func SanitizeName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r < 0x20 || r == 0x7f:
+			return -1
+		case strings.ContainsRune(`/\:*?"<>|`, r):
+			return '_'
+		}
+		return r
+	}, name)
+
+	// Windows rejects trailing dots and spaces; leading dots would hide the file
+	name = strings.Trim(name, " .")
+	if name == "" {
+		return "Unknown"
+	}
+	if len(name) > 200 {
+		name = strings.TrimSpace(truncate(name, 200))
+	}
+	return name
+}
+
+// truncate shortens s to at most n bytes without splitting a UTF-8 character
+func truncate(s string, n int) string {
+	for n > 0 && n < len(s) && s[n]&0xC0 == 0x80 {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,154 @@
+package hooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"navitone-cli/internal/config"
+	"navitone-cli/internal/models"
+)
+
+// NowPlayingFileName is the file written to $XDG_RUNTIME_DIR (or the temp dir)
+// when config.Hooks.NowPlayingFile is set
+const NowPlayingFileName = "navitone-nowplaying.json"
+
+// NowPlaying is the track metadata written to the now playing file
+type NowPlaying struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Artist    string `json:"artist"`
+	Album     string `json:"album"`
+	Track     int    `json:"track,omitempty"`
+	Year      int    `json:"year,omitempty"`
+	Genre     string `json:"genre,omitempty"`
+	Duration  int    `json:"duration"` // Seconds
+	StartedAt int64  `json:"started_at"`
+}
+
+// Runner tells external scripts about track changes, as set in config.Hooks
+type Runner struct {
+	cfg config.HooksConfig
+	mu  sync.Mutex
+}
+
+// NewRunner creates a runner for the given hook settings
+func NewRunner(cfg config.HooksConfig) *Runner {
+	return &Runner{cfg: cfg}
+}
+
+// Reconfigure applies hook settings saved at runtime
+func (r *Runner) Reconfigure(cfg config.HooksConfig) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cfg = cfg
+}
+
+// TrackStarted writes the now playing file and runs the on_track_change command
+// in the background, so a slow script can never hold up playback
+func (r *Runner) TrackStarted(track models.Track) {
+	r.mu.Lock()
+	cfg := r.cfg
+	r.mu.Unlock()
+
+	if !cfg.NowPlayingFile && cfg.OnTrackChange == "" {
+		return
+	}
+
+	info := NowPlaying{
+		ID:        track.ID,
+		Title:     track.Title,
+		Artist:    track.Artist,
+		Album:     track.Album,
+		Track:     track.Track,
+		Year:      track.Year,
+		Genre:     track.Genre,
+		Duration:  track.Duration,
+		StartedAt: time.Now().Unix(),
+	}
+
+	go func() {
+		if cfg.NowPlayingFile {
+			if err := writeNowPlaying(info); err != nil {
+				log.Printf("hooks: writing now playing file: %v", err)
+			}
+		}
+		if cfg.OnTrackChange != "" {
+			if err := runCommand(cfg.OnTrackChange, info); err != nil {
+				log.Printf("hooks: on_track_change: %v", err)
+			}
+		}
+	}()
+}
+
+// NowPlayingPath returns where the now playing file is written
+func NowPlayingPath() string {
+	dir := os.Getenv("XDG_RUNTIME_DIR")
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, NowPlayingFileName)
+}
+
+// writeNowPlaying replaces the now playing file atomically so readers never see
+// a half-written file
+func writeNowPlaying(info NowPlaying) error {
+	data, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := NowPlayingPath()
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// runCommand starts command without a shell, passing artist, title and album as
+// arguments and every field as a NAVITONE_* environment variable, and doesn't
+// wait for it to finish
+func runCommand(command string, info NowPlaying) error {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	args := append(fields[1:], sanitize(info.Artist), sanitize(info.Title), sanitize(info.Album))
+	cmd := exec.Command(fields[0], args...)
+	cmd.Env = append(os.Environ(),
+		"NAVITONE_ID="+sanitize(info.ID),
+		"NAVITONE_TITLE="+sanitize(info.Title),
+		"NAVITONE_ARTIST="+sanitize(info.Artist),
+		"NAVITONE_ALBUM="+sanitize(info.Album),
+		"NAVITONE_TRACK="+strconv.Itoa(info.Track),
+		"NAVITONE_YEAR="+strconv.Itoa(info.Year),
+		"NAVITONE_GENRE="+sanitize(info.Genre),
+		"NAVITONE_DURATION="+strconv.Itoa(info.Duration),
+	)
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("starting %s: %w", fields[0], err)
+	}
+	go cmd.Wait() // Reap the process; its exit status doesn't matter
+	return nil
+}
+
+// sanitize strips control characters (newlines, escape sequences, NUL) from tag
+// values before they reach another program
+func sanitize(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, value)
+}
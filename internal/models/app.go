@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -50,6 +51,17 @@ type Album struct {
 	PlayCount   int       `json:"playCount"`
 	CreatedAt   time.Time `json:"created"`
 	CoverArt    string    `json:"coverArt,omitempty"`
+	UserRating  int       `json:"userRating,omitempty"` // 1-5 stars, 0 when unrated
+	MusicBrainzID string  `json:"musicBrainzId,omitempty"` // Release MBID, when tagged
+}
+
+// AddedWithin reports whether the album was added to the library less than days
+// ago; albums with no known date never count as new
+func (a Album) AddedWithin(days int, now time.Time) bool {
+	if days <= 0 || a.CreatedAt.IsZero() {
+		return false
+	}
+	return now.Sub(a.CreatedAt) < time.Duration(days)*24*time.Hour
 }
 
 // Artist represents a music artist
@@ -79,8 +91,71 @@ type Track struct {
 	BitRate   int    `json:"bitRate"`
 	PlayCount int    `json:"playCount"`
 	Path      string `json:"path"`
+	UserRating int   `json:"userRating,omitempty"` // 1-5 stars, 0 when unrated
+	Unavailable bool `json:"-"` // Failed to stream (e.g. missing on disk); skipped when advancing
+
+	// MusicBrainz IDs for scrobble matching; empty when the library isn't tagged
+	RecordingMBID string   `json:"musicBrainzId,omitempty"`
+	ReleaseMBID   string   `json:"releaseMbid,omitempty"`
+	ArtistMBIDs   []string `json:"artistMbids,omitempty"`
+
+	// Podcast episodes, which are never cached: Artist and Album hold the channel title
+	IsPodcast   bool      `json:"-"`
+	PublishDate time.Time `json:"-"`
+	Description string    `json:"-"` // Show notes as plain text
+}
+
+// PodcastChannel is a podcast the server subscribes to, with its playable episodes
+// newest first
+type PodcastChannel struct {
+	ID          string
+	Title       string
+	Description string
+	Episodes    []Track
+}
+
+// StreamInfo describes the audio the playback backend is actually decoding
+type StreamInfo struct {
+	Codec      string // Codec/decoder name, e.g. "flac" or "mp3"
+	BitRate    int    // Actual stream bitrate in kbps, 0 if unknown
+	SampleRate int    // Sample rate in Hz
+	Channels   int
+}
+
+// AudioDevice is an audio output the playback backend can use
+type AudioDevice struct {
+	Name        string // Backend device name, e.g. "pulse/alsa_output.usb" or "auto"
+	Description string // Human-readable label
 }
 
+// PlaybackEvent is sent on a playback backend's event channel. The controller reads
+// the channel from a Bubble Tea command, so every update lands on the UI loop.
+type PlaybackEvent struct {
+	Log   string         // Log line to show, or
+	State *PlaybackState // The backend's state after a change
+}
+
+// PlaybackState is a snapshot of a playback backend's state, taken under its lock
+// so the UI never reads fields the audio goroutines are writing
+type PlaybackState struct {
+	Queue            []Track
+	CurrentTrack     *Track
+	IsPlaying        bool
+	IsShuffle        bool
+	StopAfterCurrent bool
+	Speed            float64
+	Position         time.Duration
+	StreamInfo       StreamInfo
+}
+
+// PlaybackEventBuffer is the capacity of a backend's event channel. Events that
+// don't fit are dropped rather than blocking playback.
+const PlaybackEventBuffer = 256
+
+// MaxFailedTracks is how many tracks in a row may fail to play before a backend
+// stops rather than skipping on, so a missing album doesn't race through the queue
+const MaxFailedTracks = 5
+
 // Playlist represents a user playlist
 type Playlist struct {
 	ID        string    `json:"id"`
@@ -94,13 +169,107 @@ type Playlist struct {
 	ChangedAt time.Time `json:"changed"`
 }
 
+// SearchScope limits which categories a search returns
+type SearchScope int
+
+const (
+	SearchScopeAll SearchScope = iota
+	SearchScopeArtists
+	SearchScopeAlbums
+	SearchScopeTracks
+)
+
+// String returns the string representation of a search scope
+func (s SearchScope) String() string {
+	switch s {
+	case SearchScopeArtists:
+		return "Artists"
+	case SearchScopeAlbums:
+		return "Albums"
+	case SearchScopeTracks:
+		return "Tracks"
+	default:
+		return "All"
+	}
+}
+
+// Next returns the scope that follows s when cycling All → Artists → Albums → Tracks
+func (s SearchScope) Next() SearchScope {
+	return (s + 1) % 4
+}
+
+// Includes reports whether results of the given category ("artists", "albums", "tracks") are searched
+func (s SearchScope) Includes(category string) bool {
+	switch s {
+	case SearchScopeArtists:
+		return category == "artists"
+	case SearchScopeAlbums:
+		return category == "albums"
+	case SearchScopeTracks:
+		return category == "tracks"
+	default:
+		return true
+	}
+}
+
+// SearchPageSize is how many results per section each search request loads
+const SearchPageSize = 5
+
 // SearchResults represents organized search results
 type SearchResults struct {
 	Artists []Artist
 	Albums  []Album
 	Tracks  []Track
+
+	// Whether the last page for each section was full (a MORE button is shown)
+	MoreArtists bool
+	MoreAlbums  bool
+	MoreTracks  bool
+}
+
+// NowPlayingEntry represents what another user/client is currently streaming
+type NowPlayingEntry struct {
+	Username   string
+	PlayerName string
+	Track      Track
+	MinutesAgo int
+}
+
+// PlayHistoryEntry records a track that started playing locally
+type PlayHistoryEntry struct {
+	Track    Track
+	PlayedAt time.Time
+}
+
+// ConnectionState is whether the server answered the last request, shown in the header
+type ConnectionState int
+
+const (
+	ConnectionConnecting   ConnectionState = iota // No answer yet since starting or reconnecting
+	ConnectionConnected                           // The server answered, even if with an error
+	ConnectionDisconnected                        // Not configured, or the server couldn't be reached
+)
+
+// FolderEntryKind says what a folder browser row is
+type FolderEntryKind int
+
+const (
+	FolderDirectory FolderEntryKind = iota // A directory (getMusicDirectory)
+	FolderLibrary                          // A top-level music folder (getIndexes)
+	FolderTrack                            // A song file
+)
+
+// FolderEntry is a row of the folder browser
+type FolderEntry struct {
+	ID    string
+	Name  string
+	Kind  FolderEntryKind
+	Track Track // Set for FolderTrack
 }
 
+// RandomSessionCounts are the track counts offered when starting a random session
+var RandomSessionCounts = []int{50, 100, 200}
+
 // SortOption represents different sorting options
 type SortOption struct {
 	ID          string
@@ -115,6 +284,19 @@ var SortOptions = []SortOption{
 	{ID: "play_count", DisplayName: "Play Count", Applicable: []string{"albums", "artists", "playlists"}},
 	{ID: "album_artist", DisplayName: "Album Artist", Applicable: []string{"albums"}},
 	{ID: "year", DisplayName: "Year", Applicable: []string{"albums"}},
+	{ID: "rating", DisplayName: "Highest Rated", Applicable: []string{"albums"}},
+	{ID: "starred", DisplayName: "Starred Only", Applicable: []string{"albums"}},
+	{ID: "new", DisplayName: "New Additions Only", Applicable: []string{"albums"}},
+}
+
+// FindSortOption returns the sort option with id
+func FindSortOption(id string) (SortOption, bool) {
+	for _, option := range SortOptions {
+		if option.ID == id {
+			return option, true
+		}
+	}
+	return SortOption{}, false
 }
 
 // AppState represents the current state of the application
@@ -124,8 +306,12 @@ type AppState struct {
 	CurrentTrack  *Track
 	Queue         []Track
 	Volume        int
+	Muted         bool
+	PreMuteVolume int // Volume restored on unmute
 	Position      time.Duration
 	IsShuffleMode bool
+	StopAfterCurrent bool // Stop when the current track ends instead of advancing
+	PlaybackSpeed float64 // Speed multiplier for the session (0 or 1 = normal)
 	ConfigForm    *ConfigFormState
 	
 	// Content state
@@ -138,15 +324,30 @@ type AppState struct {
 	LoadingArtists   bool
 	LoadingPlaylists bool
 	LoadingError     string
+	AuthError        string // Set when the server rejects our credentials mid-session
+
+	ConnectionState ConnectionState
+
+	// Offline playback
+	Offline        bool            // Server unreachable; tracks play from downloaded copies
+	CachedTrackIDs map[string]bool // Tracks downloaded for offline playback
+
+	// Ratings set this session, by song or album ID; they override the loaded UserRating
+	Ratings map[string]int
 	
 	// Selection state
 	SelectedAlbumIndex    int
 	SelectedArtistIndex   int
 	SelectedPlaylistIndex int
 	SelectedQueueIndex    int
+	MarkedItems           map[int]bool // Rows marked for batch queueing in the current tab's list
+	HideLogArea           bool // Log area hidden to reclaim vertical space
+	MinimalMode           bool // Minimal chrome for small terminals (also automatic on short terminals)
+	SpinnerFrame          int  // Loading animation frame; advanced while anything is loading
+	MarqueeOffset         int // Ticks the selected row has been scrolling; reset when selection changes
 	
 	// Home tab navigation state
-	HomeSelectedSection  int  // 0=Recently Added, 1=Top Artists, 2=Most Played Albums, 3=Top Tracks
+	HomeSelectedSection  int  // 0=Recently Added, 1=Top Artists, 2=Most Played Albums, 3=Top Tracks, 4=Recently Played
 	HomeSelectedIndex    int  // Index within the selected section
 	
 	// Home tab data
@@ -154,6 +355,7 @@ type AppState struct {
 	TopArtistsByPlays   []Artist  // with aggregated play counts
 	MostPlayedAlbums    []Album   // sorted by PlayCount
 	TopTracks           []Track   // sorted by PlayCount
+	RecentlyPlayedAlbums []Album  // most recently played first
 	
 	// Loading states for home sections
 	LoadingHomeData bool
@@ -172,6 +374,63 @@ type AppState struct {
 	SelectedModalIndex  int
 	LoadingModalContent bool
 	
+	// Server-wide now playing modal state
+	ShowNowPlayingModal bool
+	NowPlaying          []NowPlayingEntry
+	LoadingNowPlaying   bool
+
+	// Playlist picker state ("add to playlist")
+	ShowPlaylistPicker   bool
+	PlaylistPickerTracks []Track // Tracks to append to the chosen playlist
+	SelectedPickerIndex  int    // len(Playlists) selects the "New playlist…" row
+	NamingNewPlaylist    bool   // Typing the name of a playlist to create
+	NewPlaylistName      string
+
+	// Local play history (newest first)
+	PlayHistory          []PlayHistoryEntry
+	ShowHistoryModal     bool
+	SelectedHistoryIndex int
+
+	// Random session picker ("play random N")
+	ShowRandomPicker    bool
+	SelectedRandomIndex int
+
+	// Equalizer modal (MPV only): a row per preset, then the custom bands
+	ShowEqualizer          bool
+	SelectedEqualizerIndex int // Index into config.EqualizerPresetNames; one past the end is custom
+	SelectedEqualizerBand  int // Band being adjusted on the custom row
+
+	// Fit-to-time prompt (Alt+T): a queue filling a target number of minutes
+	ShowFitPicker    bool
+	FitMinutes       int
+	FitFromTopTracks bool // Pick from the most played tracks instead of random ones
+	FitTyped         bool // A digit was typed since the prompt opened
+
+	// Folder browser (Alt+F): the server's directory tree, for libraries with poor tags
+	ShowFolderBrowser   bool
+	FolderPath          []FolderEntry // Directories descended into, outermost first; empty at the top
+	FolderEntries       []FolderEntry // Contents of the last directory in FolderPath
+	SelectedFolderIndex int
+	LoadingFolder       bool
+
+	// Confirm prompt: a yes/no question answered with y or n (the controller keeps
+	// what each answer does)
+	ShowConfirm   bool
+	ConfirmPrompt string
+
+	// Podcasts (Alt+P): the channels, then the episodes of OpenPodcast
+	ShowPodcasts          bool
+	PodcastChannels       []PodcastChannel // "Newest episodes" first when the server lists them
+	OpenPodcast           int              // Index into PodcastChannels, -1 for the channel list
+	SelectedPodcastIndex  int
+	LoadingPodcasts       bool
+	PodcastsUnavailable   bool // The server has no podcasts; Alt+P is hidden
+
+	// Audio device picker (Config tab)
+	ShowDevicePicker    bool
+	AudioDevices        []AudioDevice
+	SelectedDeviceIndex int
+	
 	// Search state
 	SearchQuery         string
 	SearchResults       SearchResults
@@ -180,14 +439,30 @@ type AppState struct {
 	SearchArtistsOffset int
 	SearchAlbumsOffset  int
 	SearchTracksOffset  int
+	SearchScope         SearchScope // Kept for the session across modal opens
 	
 	// Sorting state
 	ShowSortModal      bool
 	SelectedSortIndex  int
 	CurrentSortContext string // "albums", "artists", "playlists"
+	ActiveSorts        map[string]string // Sort ID last applied per context, re-applied whenever the list reloads
 	
 	// Log state (for contained event logging)
 	LogMessages []string
+
+	// Log history modal state
+	ShowLogModal     bool
+	LogFilter        string // Case-insensitive substring (or fuzzy, with search.fuzzy) filter
+	EditingLogFilter bool   // Whether keystrokes go to the filter
+	SelectedLogIndex int    // Index within the filtered messages
+
+	// Stream details for the player's now-streaming line
+	StreamInfo       StreamInfo
+	ServerMaxBitRate int // Per-user transcode cap from Navidrome (kbps, 0 = unlimited)
+
+	// Session state
+	SessionStart      time.Time     // When this session started
+	SessionListenTime time.Duration // Accumulated time spent actually playing
 	
 	// Artwork state
 	CurrentArtwork      string // ASCII art for currently selected item
@@ -195,6 +470,9 @@ type AppState struct {
 	ShowArtwork         bool   // Whether to show artwork (based on config + space)
 }
 
+// MaxLogMessages is how many log messages are kept for the log history view
+const MaxLogMessages = 500
+
 // AddLogMessage adds a log message to the log buffer, keeping only the latest messages
 func (a *AppState) AddLogMessage(message string) {
 	// Add timestamp prefix for better user experience
@@ -203,8 +481,80 @@ func (a *AppState) AddLogMessage(message string) {
 	
 	a.LogMessages = append(a.LogMessages, formattedMessage)
 	
-	// Keep only the latest 10 messages (only 2 are shown, but we keep more for scroll-back potential)
-	if len(a.LogMessages) > 10 {
-		a.LogMessages = a.LogMessages[len(a.LogMessages)-10:]
+	// Keep only the latest messages (ui.log_lines controls how many are shown, alt+l shows the rest)
+	if len(a.LogMessages) > MaxLogMessages {
+		a.LogMessages = a.LogMessages[len(a.LogMessages)-MaxLogMessages:]
 	}
-}
\ No newline at end of file
+}
+
+// FilteredLogMessages returns the log messages matching LogFilter, oldest first
+func (a *AppState) FilteredLogMessages() []string {
+	if a.LogFilter == "" {
+		return a.LogMessages
+	}
+
+	if a.FuzzyEnabled() {
+		var filtered []string
+		for _, i := range FuzzyFilter(a.LogFilter, a.LogMessages) {
+			filtered = append(filtered, a.LogMessages[i])
+		}
+		return filtered
+	}
+
+	filter := strings.ToLower(a.LogFilter)
+	var filtered []string
+	for _, msg := range a.LogMessages {
+		if strings.Contains(strings.ToLower(msg), filter) {
+			filtered = append(filtered, msg)
+		}
+	}
+	return filtered
+}
+// IsLoading reports whether any loading placeholder is on screen
+func (a *AppState) IsLoading() bool {
+	return a.LoadingAlbums || a.LoadingArtists || a.LoadingPlaylists || a.LoadingHomeData ||
+		a.LoadingModalContent || a.LoadingNowPlaying || a.LoadingSearchResults || a.LoadingArtwork
+}
+
+// QueueDuration returns the length of the whole queue and the time left to play:
+// the rest of the current track plus everything queued after it
+func (a *AppState) QueueDuration() (total, remaining time.Duration) {
+	current := -1
+	for i, track := range a.Queue {
+		total += time.Duration(track.Duration) * time.Second
+		if current < 0 && a.CurrentTrack != nil && track.ID == a.CurrentTrack.ID {
+			current = i
+		}
+	}
+	if current < 0 {
+		return total, total
+	}
+
+	for _, track := range a.Queue[current:] {
+		remaining += time.Duration(track.Duration) * time.Second
+	}
+	remaining -= a.Position
+	if remaining < 0 {
+		remaining = 0
+	}
+	return total, remaining
+}
+
+// Rating returns the star rating (0-5) for a song or album ID, preferring one set this session
+func (a *AppState) Rating(id string, loaded int) int {
+	if rating, ok := a.Ratings[id]; ok {
+		return rating
+	}
+	return loaded
+}
+
+// RatingStars renders a 1-5 rating as "★★★☆☆", or "" when unrated
+func RatingStars(rating int) string {
+	if rating <= 0 {
+		return ""
+	}
+	if rating > 5 {
+		rating = 5
+	}
+	return strings.Repeat("★", rating) + strings.Repeat("☆", 5-rating)
+}
@@ -21,6 +21,11 @@ const (
 	ArtworkQualityField
 	ArtworkColorField
 	ArtworkSizeField
+	HomeRecentCountField
+	HomeTopArtistsCountField
+	HomeMostPlayedCountField
+	HomeTopTracksCountField
+	HomeRecentlyPlayedCountField
 	VolumeField
 	AudioDeviceField
 	BufferSizeField
@@ -38,6 +43,12 @@ type ConfigFormState struct {
     // Server scrobbling capability status
     ServerScrobblingDetected bool
     ServerScrobblingEnabled  bool
+    // Library scan state (admin only)
+    ServerAdmin bool
+    Scanning    bool
+    ScanCount   int64
+    // Audio backend in use, e.g. "[OK] Audio backend: mpv 0.37.0"
+    AudioStatus string
 }
 
 // NewConfigFormState creates a new config form state
@@ -58,6 +69,9 @@ func (cfs *ConfigFormState) GetFieldValue(field ConfigFormField) string {
 		return cfs.Config.Navidrome.Username
 	case PasswordField:
 		if cfs.Config.Navidrome.Password == "" {
+			if cfs.Config.Navidrome.Token != "" {
+				return "(using stored token)"
+			}
 			return ""
 		}
 		return "••••••••" // Masked password
@@ -79,13 +93,23 @@ func (cfs *ConfigFormState) GetFieldValue(field ConfigFormField) string {
 		return cfs.Config.UI.ArtworkQuality
 	case ArtworkSizeField:
 		return cfs.Config.UI.ArtworkSize
+	case HomeRecentCountField:
+		return fmt.Sprintf("%d", cfs.Config.UI.HomeRecentCount)
+	case HomeTopArtistsCountField:
+		return fmt.Sprintf("%d", cfs.Config.UI.HomeTopArtistsCount)
+	case HomeMostPlayedCountField:
+		return fmt.Sprintf("%d", cfs.Config.UI.HomeMostPlayedCount)
+	case HomeTopTracksCountField:
+		return fmt.Sprintf("%d", cfs.Config.UI.HomeTopTracksCount)
+	case HomeRecentlyPlayedCountField:
+		return fmt.Sprintf("%d", cfs.Config.UI.HomeRecentlyPlayedCount)
 	case AudioDeviceField:
 		if cfs.Config.Audio.Device == "" {
 			return "Auto-detect"
 		}
 		return cfs.Config.Audio.Device
 	case BufferSizeField:
-		return fmt.Sprintf("%d", cfs.Config.Audio.BufferSize)
+		return fmt.Sprintf("%d ms", cfs.Config.Audio.BufferSize)
 	default:
 		return ""
 	}
@@ -118,22 +142,55 @@ func (cfs *ConfigFormState) GetFieldLabel(field ConfigFormField) string {
         return "Artwork Color"
     case ArtworkSizeField:
         return "Artwork Size"
+    case HomeRecentCountField:
+        return "Home: Recently Added"
+    case HomeTopArtistsCountField:
+        return "Home: Top Artists"
+    case HomeMostPlayedCountField:
+        return "Home: Most Played"
+    case HomeTopTracksCountField:
+        return "Home: Top Tracks"
+    case HomeRecentlyPlayedCountField:
+        return "Home: Recently Played"
     case VolumeField:
         return "Volume"
     case AudioDeviceField:
         return "Audio Device"
 	case BufferSizeField:
-		return "Buffer Size"
+		return "Buffer Size (oto)"
 	default:
 		return ""
 	}
 }
 
+// HomeCountTarget returns the config value behind a home section count field, or nil
+func (cfs *ConfigFormState) HomeCountTarget(field ConfigFormField) *int {
+	switch field {
+	case HomeRecentCountField:
+		return &cfs.Config.UI.HomeRecentCount
+	case HomeTopArtistsCountField:
+		return &cfs.Config.UI.HomeTopArtistsCount
+	case HomeMostPlayedCountField:
+		return &cfs.Config.UI.HomeMostPlayedCount
+	case HomeTopTracksCountField:
+		return &cfs.Config.UI.HomeTopTracksCount
+	case HomeRecentlyPlayedCountField:
+		return &cfs.Config.UI.HomeRecentlyPlayedCount
+	default:
+		return nil
+	}
+}
+
 // IsCheckboxField returns true if the field is a checkbox
 func (cfs *ConfigFormState) IsCheckboxField(field ConfigFormField) bool {
 	return field == LastFMEnabledField || field == ListenBrainzEnabledField || field == ShowArtworkField || field == ArtworkColorField
 }
 
+// IsScrobblingField returns true if the field is in the Scrobbling Settings section
+func (cfs *ConfigFormState) IsScrobblingField(field ConfigFormField) bool {
+	return field >= LastFMEnabledField && field <= ListenBrainzTokenField
+}
+
 // GetCheckboxValue returns the checkbox value for boolean fields
 func (cfs *ConfigFormState) GetCheckboxValue(field ConfigFormField) bool {
 	switch field {
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"sort"
+
+	"github.com/sahilm/fuzzy"
+)
+
+// FuzzyEnabled reports whether config.Search.Fuzzy is on
+func (a *AppState) FuzzyEnabled() bool {
+	return a.ConfigForm != nil && a.ConfigForm.Config != nil && a.ConfigForm.Config.Search.Fuzzy
+}
+
+// FuzzyFilter returns the indexes of texts that contain query's characters in
+// order (so "btls" still finds "The Beatles"), in their original order
+func FuzzyFilter(query string, texts []string) []int {
+	matches := fuzzy.FindNoSort(query, texts)
+	indexes := make([]int, len(matches))
+	for i, match := range matches {
+		indexes[i] = match.Index
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// FuzzyRank returns the indexes of texts ordered by fuzzy score against query:
+// best matches first, then the ones that don't match at all in their original
+// order. Nothing is dropped, since the server may have matched on other fields.
+func FuzzyRank(query string, texts []string) []int {
+	order := make([]int, 0, len(texts))
+	matched := make([]bool, len(texts))
+	for _, match := range fuzzy.Find(query, texts) {
+		order = append(order, match.Index)
+		matched[match.Index] = true
+	}
+	for i := range texts {
+		if !matched[i] {
+			order = append(order, i)
+		}
+	}
+	return order
+}
+
+// RankArtists orders artists by fuzzy score of their name against query
+func RankArtists(query string, artists []Artist) []Artist {
+	texts := make([]string, len(artists))
+	for i, artist := range artists {
+		texts[i] = artist.Name
+	}
+	ranked := make([]Artist, 0, len(artists))
+	for _, i := range FuzzyRank(query, texts) {
+		ranked = append(ranked, artists[i])
+	}
+	return ranked
+}
+
+// RankAlbums orders albums by fuzzy score of "name artist" against query
+func RankAlbums(query string, albums []Album) []Album {
+	texts := make([]string, len(albums))
+	for i, album := range albums {
+		texts[i] = album.Name + " " + album.Artist
+	}
+	ranked := make([]Album, 0, len(albums))
+	for _, i := range FuzzyRank(query, texts) {
+		ranked = append(ranked, albums[i])
+	}
+	return ranked
+}
+
+// RankTracks orders tracks by fuzzy score of "title artist album" against query
+func RankTracks(query string, tracks []Track) []Track {
+	texts := make([]string, len(tracks))
+	for i, track := range tracks {
+		texts[i] = track.Title + " " + track.Artist + " " + track.Album
+	}
+	ranked := make([]Track, 0, len(tracks))
+	for _, i := range FuzzyRank(query, texts) {
+		ranked = append(ranked, tracks[i])
+	}
+	return ranked
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFuzzyRankPutsSubstringsFirst(t *testing.T) {
+	texts := []string{
+		"Bread and Eggs at Twilight", // b-e-a-t scattered: a fuzzy match only
+		"No Match Here",
+		"Heartbeat",
+		"Beat It",
+	}
+	order := FuzzyRank("beat", texts)
+	if len(order) != len(texts) {
+		t.Fatalf("got %d results, want all %d", len(order), len(texts))
+	}
+
+	position := make(map[int]int, len(order))
+	for rank, i := range order {
+		position[i] = rank
+	}
+	for i, text := range texts {
+		if !strings.Contains(strings.ToLower(text), "beat") {
+			continue
+		}
+		if position[i] > position[0] {
+			t.Errorf("substring match %q ranked below fuzzy match %q: %v", text, texts[0], order)
+		}
+	}
+	if order[len(order)-1] != 1 {
+		t.Errorf("the text that doesn't match should come last: %v", order)
+	}
+}
+
+func TestFuzzyFilter(t *testing.T) {
+	texts := []string{"The Beatles", "Blur", "Beat Happening", "Oasis", "Bread and Toast"}
+	// Fuzzy filtering also finds scattered letters and abbreviations, and keeps
+	// the list order
+	if got, want := FuzzyFilter("beat", texts), []int{0, 2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FuzzyFilter(beat) = %v, want %v", got, want)
+	}
+	if got, want := FuzzyFilter("btls", texts), []int{0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FuzzyFilter(btls) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,301 @@
+package mpris
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+	"github.com/godbus/dbus/v5/prop"
+
+	"navitone-cli/internal/models"
+)
+
+// D-Bus names from the MPRIS2 spec
+const (
+	BusName     = "org.mpris.MediaPlayer2.navitone"
+	objectPath  = "/org/mpris/MediaPlayer2"
+	rootIface   = "org.mpris.MediaPlayer2"
+	playerIface = "org.mpris.MediaPlayer2.Player"
+	noTrack     = dbus.ObjectPath("/org/mpris/MediaPlayer2/TrackList/NoTrack")
+)
+
+// Actions a desktop can request through MPRIS
+const (
+	ActionPlayPause = "playpause"
+	ActionPlay      = "play"
+	ActionPause     = "pause"
+	ActionStop      = "stop"
+	ActionNext      = "next"
+	ActionPrevious  = "previous"
+	ActionSeek      = "seek"
+)
+
+// Command is a playback request from a desktop media widget or media key
+type Command struct {
+	Action string
+	Offset time.Duration // Relative seek for ActionSeek
+}
+
+// Server exposes navitone on the session bus as an MPRIS2 player, so desktop
+// media widgets show the current track and media keys control playback.
+// Requests arrive on Commands so the controller can run them on the UI loop.
+type Server struct {
+	conn     *dbus.Conn
+	props    *prop.Properties
+	commands chan Command
+
+	mu       sync.Mutex
+	closed   bool
+	position time.Duration // Last reported position, for SetPosition
+	trackID  dbus.ObjectPath
+}
+
+// NewServer connects to the session bus and claims the navitone MPRIS name.
+// It fails on systems other than Linux and when no session bus is running
+// (e.g. over SSH), in which case the caller just runs without MPRIS.
+func NewServer() (*Server, error) {
+	if runtime.GOOS != "linux" {
+		return nil, fmt.Errorf("MPRIS is only available on Linux")
+	}
+
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return nil, fmt.Errorf("no D-Bus session bus: %w", err)
+	}
+
+	reply, err := conn.RequestName(BusName, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("requesting %s: %w", BusName, err)
+	}
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		conn.Close()
+		return nil, fmt.Errorf("%s is already taken (is another navitone running?)", BusName)
+	}
+
+	s := &Server{
+		conn:     conn,
+		commands: make(chan Command, 16),
+		trackID:  noTrack,
+	}
+	if err := s.export(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// export publishes the root and player interfaces with their properties
+func (s *Server) export() error {
+	if err := s.conn.Export(rootObject{s}, objectPath, rootIface); err != nil {
+		return fmt.Errorf("exporting %s: %w", rootIface, err)
+	}
+	// Seek is renamed on export: vet reserves the Go method name Seek for io.Seeker
+	player := playerObject{s}
+	if err := s.conn.ExportWithMap(player, map[string]string{"SeekBy": "Seek"}, objectPath, playerIface); err != nil {
+		return fmt.Errorf("exporting %s: %w", playerIface, err)
+	}
+
+	props, err := prop.Export(s.conn, objectPath, prop.Map{
+		rootIface: {
+			"CanQuit":             {Value: false},
+			"CanRaise":            {Value: false},
+			"HasTrackList":        {Value: false},
+			"Identity":            {Value: "Navitone"},
+			"SupportedUriSchemes": {Value: []string{}},
+			"SupportedMimeTypes":  {Value: []string{}},
+		},
+		playerIface: {
+			"PlaybackStatus": {Value: "Stopped", Emit: prop.EmitTrue},
+			"LoopStatus":     {Value: "None"},
+			"Rate":           {Value: 1.0, Emit: prop.EmitTrue},
+			"Shuffle":        {Value: false, Emit: prop.EmitTrue},
+			"Metadata":       {Value: map[string]dbus.Variant{"mpris:trackid": dbus.MakeVariant(noTrack)}, Emit: prop.EmitTrue},
+			"Volume":         {Value: 1.0},
+			"Position":       {Value: int64(0), Emit: prop.EmitFalse}, // Clients poll it; the spec forbids change signals
+			"MinimumRate":    {Value: 1.0},
+			"MaximumRate":    {Value: 1.0},
+			"CanGoNext":      {Value: true},
+			"CanGoPrevious":  {Value: true},
+			"CanPlay":        {Value: true},
+			"CanPause":       {Value: true},
+			"CanSeek":        {Value: true},
+			"CanControl":     {Value: true},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("exporting properties: %w", err)
+	}
+	s.props = props
+
+	node := &introspect.Node{
+		Name: objectPath,
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			prop.IntrospectData,
+			{Name: rootIface, Methods: introspect.Methods(rootObject{s}), Properties: props.Introspection(rootIface)},
+			{Name: playerIface, Methods: playerMethods(player), Properties: props.Introspection(playerIface)},
+		},
+	}
+	return s.conn.Export(introspect.NewIntrospectable(node), objectPath, "org.freedesktop.DBus.Introspectable")
+}
+
+// Commands returns the channel of playback requests; it is closed by Stop
+func (s *Server) Commands() <-chan Command {
+	return s.commands
+}
+
+// Update publishes the playback state; properties only signal when they change
+func (s *Server) Update(state models.PlaybackState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+
+	status := "Stopped"
+	switch {
+	case state.CurrentTrack != nil && state.IsPlaying:
+		status = "Playing"
+	case state.CurrentTrack != nil:
+		status = "Paused"
+	}
+	s.setIfChanged("PlaybackStatus", status)
+	s.setIfChanged("Shuffle", state.IsShuffle)
+
+	trackID := noTrack
+	if state.CurrentTrack != nil {
+		trackID = trackObjectPath(state.CurrentTrack.ID)
+	}
+	if trackID != s.trackID {
+		s.trackID = trackID
+		s.props.SetMust(playerIface, "Metadata", metadata(trackID, state.CurrentTrack))
+	}
+
+	s.position = state.Position
+	s.props.SetMust(playerIface, "Position", state.Position.Microseconds())
+}
+
+// setIfChanged sets a player property, skipping the change signal when the value is the same
+func (s *Server) setIfChanged(name string, value interface{}) {
+	if s.props.GetMust(playerIface, name) != value {
+		s.props.SetMust(playerIface, name, value)
+	}
+}
+
+// Stop releases the bus name and closes Commands
+func (s *Server) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.commands)
+	s.conn.ReleaseName(BusName)
+	s.conn.Close()
+}
+
+// send queues a command, dropping it if the UI is not keeping up
+func (s *Server) send(cmd Command) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.commands <- cmd:
+	default:
+	}
+}
+
+// metadata builds the MPRIS Metadata map for track
+func metadata(trackID dbus.ObjectPath, track *models.Track) map[string]dbus.Variant {
+	meta := map[string]dbus.Variant{"mpris:trackid": dbus.MakeVariant(trackID)}
+	if track == nil {
+		return meta
+	}
+	meta["mpris:length"] = dbus.MakeVariant((time.Duration(track.Duration) * time.Second).Microseconds())
+	meta["xesam:title"] = dbus.MakeVariant(track.Title)
+	meta["xesam:artist"] = dbus.MakeVariant([]string{track.Artist})
+	meta["xesam:album"] = dbus.MakeVariant(track.Album)
+	if track.Track > 0 {
+		meta["xesam:trackNumber"] = dbus.MakeVariant(int32(track.Track))
+	}
+	if track.Disc > 0 {
+		meta["xesam:discNumber"] = dbus.MakeVariant(int32(track.Disc))
+	}
+	if track.Genre != "" {
+		meta["xesam:genre"] = dbus.MakeVariant([]string{track.Genre})
+	}
+	return meta
+}
+
+// trackObjectPath turns a Navidrome track ID into a valid D-Bus object path
+func trackObjectPath(id string) dbus.ObjectPath {
+	clean := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, id)
+	if clean == "" {
+		return noTrack
+	}
+	return dbus.ObjectPath("/org/navitone/track/" + clean)
+}
+
+// rootObject implements the org.mpris.MediaPlayer2 methods
+type rootObject struct{ s *Server }
+
+// Raise is a no-op: a terminal can't bring itself to the front
+func (rootObject) Raise() *dbus.Error { return nil }
+
+// Quit is a no-op; CanQuit is false
+func (rootObject) Quit() *dbus.Error { return nil }
+
+// playerObject implements the org.mpris.MediaPlayer2.Player methods
+type playerObject struct{ s *Server }
+
+func (p playerObject) Next() *dbus.Error      { p.s.send(Command{Action: ActionNext}); return nil }
+func (p playerObject) Previous() *dbus.Error  { p.s.send(Command{Action: ActionPrevious}); return nil }
+func (p playerObject) Pause() *dbus.Error     { p.s.send(Command{Action: ActionPause}); return nil }
+func (p playerObject) PlayPause() *dbus.Error { p.s.send(Command{Action: ActionPlayPause}); return nil }
+func (p playerObject) Stop() *dbus.Error      { p.s.send(Command{Action: ActionStop}); return nil }
+func (p playerObject) Play() *dbus.Error      { p.s.send(Command{Action: ActionPlay}); return nil }
+
+// SeekBy moves the position by offset microseconds; exported on the bus as Seek
+func (p playerObject) SeekBy(offset int64) *dbus.Error {
+	p.s.send(Command{Action: ActionSeek, Offset: time.Duration(offset) * time.Microsecond})
+	return nil
+}
+
+// SetPosition seeks to position microseconds, ignored if trackID is no longer current
+func (p playerObject) SetPosition(trackID dbus.ObjectPath, position int64) *dbus.Error {
+	p.s.mu.Lock()
+	current, offset := p.s.trackID, time.Duration(position)*time.Microsecond-p.s.position
+	p.s.mu.Unlock()
+	if trackID == current {
+		p.s.send(Command{Action: ActionSeek, Offset: offset})
+	}
+	return nil
+}
+
+// playerMethods returns the player introspection data under the D-Bus method names
+func playerMethods(player playerObject) []introspect.Method {
+	methods := introspect.Methods(player)
+	for i := range methods {
+		if methods[i].Name == "SeekBy" {
+			methods[i].Name = "Seek"
+			methods[i].Args[0].Name = "Offset"
+		}
+	}
+	return methods
+}
+
+// OpenUri is not supported; SupportedUriSchemes is empty
+func (playerObject) OpenUri(uri string) *dbus.Error { return nil }
@@ -0,0 +1,154 @@
+package mpris
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// playerPrefix starts the bus name of every MPRIS player
+const playerPrefix = "org.mpris.MediaPlayer2."
+
+// Watcher observes the other MPRIS players on the session bus and reports each
+// one that enters the Playing state on Playing. navitone's own players are
+// skipped: its MPRIS server, and the mpv it runs if mpv exposes MPRIS through a
+// plugin. Other mpv instances still count.
+type Watcher struct {
+	conn    *dbus.Conn
+	signals chan *dbus.Signal
+	playing chan string
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewWatcher connects to the session bus and subscribes to PropertiesChanged on
+// every player. Like NewServer it fails off Linux and without a session bus.
+func NewWatcher() (*Watcher, error) {
+	if runtime.GOOS != "linux" {
+		return nil, fmt.Errorf("MPRIS is only available on Linux")
+	}
+
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return nil, fmt.Errorf("no D-Bus session bus: %w", err)
+	}
+	err = conn.AddMatchSignal(
+		dbus.WithMatchObjectPath(objectPath),
+		dbus.WithMatchInterface("org.freedesktop.DBus.Properties"),
+		dbus.WithMatchMember("PropertiesChanged"),
+		dbus.WithMatchArg(0, playerIface),
+	)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("watching MPRIS players: %w", err)
+	}
+
+	w := &Watcher{
+		conn:    conn,
+		signals: make(chan *dbus.Signal, 16),
+		playing: make(chan string, 4),
+	}
+	conn.Signal(w.signals)
+	go w.run()
+	return w, nil
+}
+
+// Playing returns the channel of players that started playing, by the name after
+// org.mpris.MediaPlayer2. (e.g. "spotify"); it is closed by Stop
+func (w *Watcher) Playing() <-chan string {
+	return w.playing
+}
+
+// Stop closes the bus connection, which ends the watch and closes Playing
+func (w *Watcher) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return
+	}
+	w.closed = true
+	w.conn.Close()
+}
+
+// run reports players entering Playing until the connection closes the signal channel
+func (w *Watcher) run() {
+	defer close(w.playing)
+	for sig := range w.signals {
+		if playbackStatus(sig) != "Playing" || w.isOwn(sig.Sender) {
+			continue
+		}
+		select {
+		case w.playing <- w.playerName(sig.Sender):
+		default: // The UI hasn't taken the last one yet, which pauses just the same
+		}
+	}
+}
+
+// playbackStatus returns the PlaybackStatus a PropertiesChanged signal carries, if any
+func playbackStatus(sig *dbus.Signal) string {
+	if sig.Name != "org.freedesktop.DBus.Properties.PropertiesChanged" || len(sig.Body) < 2 {
+		return ""
+	}
+	changed, ok := sig.Body[1].(map[string]dbus.Variant)
+	if !ok {
+		return ""
+	}
+	status, _ := changed["PlaybackStatus"].Value().(string)
+	return status
+}
+
+// isOwn reports whether the unique bus name sender belongs to this process or
+// one of its children (the mpv backend)
+func (w *Watcher) isOwn(sender string) bool {
+	var pid uint32
+	err := w.conn.BusObject().Call("org.freedesktop.DBus.GetConnectionUnixProcessID", 0, sender).Store(&pid)
+	if err != nil {
+		return false
+	}
+	self := os.Getpid()
+	return int(pid) == self || parentPID(int(pid)) == self
+}
+
+// parentPID reads the parent of pid from /proc, returning 0 when it can't
+func parentPID(pid int) int {
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0
+	}
+	// The command name in parentheses may hold spaces, so parse after its closing paren
+	end := strings.LastIndexByte(string(stat), ')')
+	if end < 0 {
+		return 0
+	}
+	fields := strings.Fields(string(stat[end+1:]))
+	if len(fields) < 2 {
+		return 0
+	}
+	ppid, _ := strconv.Atoi(fields[1])
+	return ppid
+}
+
+// playerName finds the MPRIS name owned by the unique bus name sender, falling
+// back to sender itself
+func (w *Watcher) playerName(sender string) string {
+	var names []string
+	if err := w.conn.BusObject().Call("org.freedesktop.DBus.ListNames", 0).Store(&names); err != nil {
+		return sender
+	}
+	for _, name := range names {
+		if !strings.HasPrefix(name, playerPrefix) {
+			continue
+		}
+		var owner string
+		if err := w.conn.BusObject().Call("org.freedesktop.DBus.GetNameOwner", 0, name).Store(&owner); err == nil && owner == sender {
+			return strings.TrimPrefix(name, playerPrefix)
+		}
+	}
+	return sender
+}
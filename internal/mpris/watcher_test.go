@@ -0,0 +1,46 @@
+package mpris
+
+import (
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+)
+
+func TestPlaybackStatus(t *testing.T) {
+	changed := func(props map[string]dbus.Variant) *dbus.Signal {
+		return &dbus.Signal{
+			Name: "org.freedesktop.DBus.Properties.PropertiesChanged",
+			Body: []interface{}{playerIface, props, []string{}},
+		}
+	}
+	tests := []struct {
+		name string
+		sig  *dbus.Signal
+		want string
+	}{
+		{"playing", changed(map[string]dbus.Variant{"PlaybackStatus": dbus.MakeVariant("Playing")}), "Playing"},
+		{"paused", changed(map[string]dbus.Variant{"PlaybackStatus": dbus.MakeVariant("Paused")}), "Paused"},
+		{"other property", changed(map[string]dbus.Variant{"Volume": dbus.MakeVariant(0.5)}), ""},
+		{"other signal", &dbus.Signal{Name: "org.freedesktop.DBus.NameOwnerChanged", Body: []interface{}{"a", "b", "c"}}, ""},
+		{"short body", &dbus.Signal{Name: "org.freedesktop.DBus.Properties.PropertiesChanged", Body: []interface{}{playerIface}}, ""},
+	}
+	for _, tt := range tests {
+		if got := playbackStatus(tt.sig); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParentPID(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("reads /proc")
+	}
+	if got := parentPID(os.Getpid()); got != os.Getppid() {
+		t.Errorf("parentPID = %d, want %d", got, os.Getppid())
+	}
+	if got := parentPID(-1); got != 0 {
+		t.Errorf("parentPID of a missing process = %d, want 0", got)
+	}
+}
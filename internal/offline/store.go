@@ -0,0 +1,180 @@
+package offline
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"navitone-cli/pkg/navidrome"
+)
+
+// indexFile lists the cached tracks inside the store directory
+const indexFile = "index.json"
+
+// entry is one downloaded track
+type entry struct {
+	ID       string    `json:"id"`
+	File     string    `json:"file"` // File name inside the store directory
+	Size     int64     `json:"size"`
+	LastUsed time.Time `json:"lastUsed"`
+}
+
+// Store keeps downloaded tracks on disk for offline playback, evicting the least
+// recently used ones once the total size passes maxBytes
+type Store struct {
+	dir      string
+	maxBytes int64
+
+	entries map[string]*entry
+	mu      sync.Mutex
+}
+
+// NewStore opens (or creates) the offline store in dir, capped at maxBytes (0 for no cap)
+func NewStore(dir string, maxBytes int64) (*Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	s := &Store{
+		dir:      dir,
+		maxBytes: maxBytes,
+		entries:  make(map[string]*entry),
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, indexFile)); err == nil {
+		var entries []*entry
+		if json.Unmarshal(data, &entries) == nil {
+			for _, e := range entries {
+				// Drop entries whose file was removed behind our back
+				if _, err := os.Stat(filepath.Join(dir, e.File)); err == nil {
+					s.entries[e.ID] = e
+				}
+			}
+		}
+	}
+	return s, nil
+}
+
+// SetMaxBytes changes the size cap, evicting tracks if the store is now over it
+func (s *Store) SetMaxBytes(maxBytes int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maxBytes = maxBytes
+	if s.evictLocked("") {
+		s.saveLocked()
+	}
+}
+
+// Has reports whether trackID is cached
+func (s *Store) Has(trackID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.entries[trackID]
+	return ok
+}
+
+// IDs returns the IDs of all cached tracks
+func (s *Store) IDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, len(s.entries))
+	for id := range s.entries {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Path returns the local file for trackID and marks it as recently used
+func (s *Store) Path(trackID string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[trackID]
+	if !ok {
+		return "", false
+	}
+	e.LastUsed = time.Now()
+	s.saveLocked()
+	return filepath.Join(s.dir, e.File), true
+}
+
+// Download fetches trackID from the server into the store, evicting older tracks to
+// stay under the size cap. Already-cached tracks are not downloaded again.
+func (s *Store) Download(ctx context.Context, client *navidrome.Client, trackID string) error {
+	if s.Has(trackID) {
+		return nil
+	}
+
+	file := trackID + ".audio"
+	path := filepath.Join(s.dir, file)
+	if err := client.DownloadTrack(ctx, trackID, path); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[trackID] = &entry{ID: trackID, File: file, Size: info.Size(), LastUsed: time.Now()}
+	s.evictLocked(trackID)
+	return s.saveLocked()
+}
+
+// evictLocked removes least recently used tracks (never keep) until the store fits
+// its cap, reporting whether anything was removed (must be called with lock held)
+func (s *Store) evictLocked(keep string) bool {
+	if s.maxBytes <= 0 {
+		return false
+	}
+
+	var total int64
+	entries := make([]*entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		total += e.Size
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].LastUsed.Before(entries[j].LastUsed) })
+
+	removed := false
+	for _, e := range entries {
+		if total <= s.maxBytes {
+			break
+		}
+		if e.ID == keep {
+			continue
+		}
+		os.Remove(filepath.Join(s.dir, e.File))
+		delete(s.entries, e.ID)
+		total -= e.Size
+		removed = true
+	}
+	return removed
+}
+
+// saveLocked writes the index via a temp file (must be called with lock held)
+func (s *Store) saveLocked() error {
+	entries := make([]*entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		entries = append(entries, e)
+	}
+
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(s.dir, indexFile)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
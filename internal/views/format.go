@@ -0,0 +1,62 @@
+package views
+
+import (
+	"strconv"
+	"strings"
+)
+
+// thousandsSeparators maps config.UI.Locale language codes to the separator they
+// group digits with; anything not listed uses a comma
+var thousandsSeparators = map[string]string{
+	"none": "",
+	"de": ".", "es": ".", "it": ".", "nl": ".", "pt": ".", "da": ".", "id": ".", "tr": ".",
+	"fr": " ", "ru": " ", "pl": " ", "cs": " ", "sv": " ", "fi": " ", "nb": " ", "uk": " ",
+	"ch": "'",
+}
+
+// thousandsSeparator returns the digit group separator for a locale such as "de",
+// "de_DE" or "de-CH.UTF-8"
+func thousandsSeparator(locale string) string {
+	lang := strings.ToLower(locale)
+	if i := strings.IndexAny(lang, "_-."); i >= 0 {
+		lang = lang[:i]
+	}
+	if sep, ok := thousandsSeparators[lang]; ok {
+		return sep
+	}
+	return ","
+}
+
+// groupDigits formats n with sep between groups of three digits, e.g. 1234567 -> "1,234,567"
+func groupDigits(n int, sep string) string {
+	digits := strconv.Itoa(n)
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if sep == "" || len(digits) <= 3 {
+		return sign + digits
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	first := len(digits) % 3
+	if first == 0 {
+		first = 3
+	}
+	b.WriteString(digits[:first])
+	for i := first; i < len(digits); i += 3 {
+		b.WriteString(sep)
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
+
+// formatCount formats a play or item count for config.UI.Locale
+func (v *MainView) formatCount(n int) string {
+	locale := ""
+	if cf := v.state.ConfigForm; cf != nil && cf.Config != nil {
+		locale = cf.Config.UI.Locale
+	}
+	return groupDigits(n, thousandsSeparator(locale))
+}
@@ -0,0 +1,47 @@
+package views
+
+import "testing"
+
+func TestGroupDigits(t *testing.T) {
+	tests := []struct {
+		n    int
+		sep  string
+		want string
+	}{
+		{0, ",", "0"},
+		{7, ",", "7"},
+		{999, ",", "999"},
+		{1000, ",", "1,000"},
+		{12345, ",", "12,345"},
+		{123456, ",", "123,456"},
+		{1234567, ",", "1,234,567"},
+		{-1234567, ",", "-1,234,567"},
+		{-999, ",", "-999"},
+		{1234567, ".", "1.234.567"},
+		{1234567, "\u00a0", "1\u00a0234\u00a0567"},
+		{1234567, "", "1234567"},
+	}
+	for _, tt := range tests {
+		if got := groupDigits(tt.n, tt.sep); got != tt.want {
+			t.Errorf("groupDigits(%d, %q) = %q, want %q", tt.n, tt.sep, got, tt.want)
+		}
+	}
+}
+
+func TestThousandsSeparator(t *testing.T) {
+	tests := map[string]string{
+		"":            ",",
+		"en_US.UTF-8": ",",
+		"de":          ".",
+		"de_DE":       ".",
+		"fr-FR":       "\u00a0", // No-break space, so counts don't wrap
+		"ch":          "'",
+		"none":        "",
+		"xx":          ",",
+	}
+	for locale, want := range tests {
+		if got := thousandsSeparator(locale); got != want {
+			t.Errorf("thousandsSeparator(%q) = %q, want %q", locale, got, want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package views
+
+import "strings"
+
+// glyphSet holds the icons the render functions draw, so terminals without emoji
+// support can switch to ASCII or Nerd Font icons (config.UI.Glyphs)
+type glyphSet struct {
+	Home, Album, Artist, Playlist, Track, Queue, Hot              string // Section titles
+	Search, Sort, Server, History, Log, Speaker, Add, Art, Folder string
+	Podcast                                                       string
+
+	Playing, Paused, Stopped, Shuffle, Offline, Cached, Note string // Player and queue state
+	Muted, Clock, Error, Warning, Locked, Check, Connection  string
+	Spinner                                                  []string // Frames of the loading animation
+
+	Private, Public string // Playlist visibility
+	Star, StarEmpty string // Starred items and 1-5 ratings
+}
+
+// glyphSets maps config.UI.Glyphs values to their icons; ASCII drops purely
+// decorative title icons rather than spelling them out
+var glyphSets = map[string]glyphSet{
+	"emoji": {
+		Home: "🏠", Album: "💿", Artist: "🎤", Playlist: "📋", Track: "🎵", Queue: "🔄", Hot: "🔥",
+		Search: "🔍", Sort: "🔧", Server: "📡", History: "🕘", Log: "📜", Speaker: "🔊", Add: "➕", Art: "🎨", Folder: "📁",
+		Podcast: "🎙",
+		Playing: "▶", Paused: "⏸", Stopped: "⏹", Shuffle: "🔀", Offline: "✈", Cached: "⬇", Note: "♪",
+		Muted: "🔇", Clock: "🕒", Error: "❌", Warning: "⚠", Locked: "🔒", Check: "✔", Connection: "●",
+		Spinner: []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+		Private: "🔒", Public: "🌐",
+		Star: "★", StarEmpty: "☆",
+	},
+	"ascii": {
+		Playlist: "[P]",
+		Playing:  ">", Paused: "||", Stopped: "[]", Shuffle: "~", Offline: "[offline]", Cached: "[dl]", Note: "#",
+		Muted: "[mute]", Error: "!", Warning: "!", Locked: "!", Check: "[x]", Connection: "*",
+		Spinner: []string{"|", "/", "-", "\\"},
+		Private: "[private]", Public: "[public]", Folder: "[+]",
+		Star: "*", StarEmpty: ".",
+	},
+	"nerdfont": {
+		Home: "\uf015", Album: "\U000f0025", Artist: "\uf130", Playlist: "\U000f0cb9", Track: "\uf001", Queue: "\uf0cb", Hot: "\uf06d",
+		Search: "\uf002", Sort: "\uf0dc", Server: "\uf233", History: "\uf1da", Log: "\uf0f6", Speaker: "\uf028", Add: "\uf067", Art: "\uf1fc", Folder: "\uf07b",
+		Podcast: "\uf2ce",
+		Playing: "\uf04b", Paused: "\uf04c", Stopped: "\uf04d", Shuffle: "\uf074", Offline: "\U000f001d", Cached: "\uf019", Note: "\uf001",
+		Muted: "\uf026", Clock: "\uf017", Error: "\uf057", Warning: "\uf071", Locked: "\uf023", Check: "\uf00c", Connection: "\uf111",
+		Spinner: []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+		Private: "\uf023", Public: "\uf0ac",
+		Star: "\uf005", StarEmpty: "\uf006",
+	},
+}
+
+// glyphs returns the icon set selected by config.UI.Glyphs, defaulting to emoji
+func (v *MainView) glyphs() glyphSet {
+	cf := v.state.ConfigForm
+	if cf != nil && cf.Config != nil {
+		if set, ok := glyphSets[cf.Config.UI.Glyphs]; ok {
+			return set
+		}
+	}
+	return glyphSets["emoji"]
+}
+
+// withIcon prefixes text with glyph, or returns text alone when the set has no icon for it
+func withIcon(glyph, text string) string {
+	if glyph == "" {
+		return text
+	}
+	return glyph + " " + text
+}
+
+// ratingStars renders a 1-5 rating as five stars (e.g. "★★★☆☆"), or "" when unrated
+func (v *MainView) ratingStars(rating int) string {
+	if rating <= 0 {
+		return ""
+	}
+	if rating > 5 {
+		rating = 5
+	}
+	g := v.glyphs()
+	return strings.Repeat(g.Star, rating) + strings.Repeat(g.StarEmpty, 5-rating)
+}
+
+// spinnerFrame returns the loading animation frame for AppState.SpinnerFrame
+func (v *MainView) spinnerFrame() string {
+	frames := v.glyphs().Spinner
+	return frames[v.state.SpinnerFrame%len(frames)]
+}
+
+// loadingText prefixes a loading placeholder with the spinner
+func (v *MainView) loadingText(text string) string {
+	return v.spinnerFrame() + " " + text
+}
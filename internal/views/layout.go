@@ -0,0 +1,223 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// contentBoxOverhead is the rows the content box adds around its text:
+// rounded border top/bottom (2) plus padding top/bottom (2)
+const contentBoxOverhead = 4
+
+// minContentLines keeps the content box usable on tiny terminals
+const minContentLines = 3
+
+// minimalModeHeight is the terminal height below which minimal mode turns on by itself
+const minimalModeHeight = 16
+
+// layout is the vertical budget for one render, measured from the chrome that is
+// actually visible so every tab works from the same numbers
+type layout struct {
+	Width  int
+	Height int
+
+	Header string
+	Footer string
+	Player string
+	Log    string // Empty when the log area is hidden
+
+	// Minimal drops the footer, log and content border and shrinks the player to one
+	// line; set by Alt+M or when the terminal is shorter than minimalModeHeight
+	Minimal bool
+
+	ContentWidth int // Width passed to the content box style
+	ContentLines int // Text lines a tab renderer may emit inside the content box
+}
+
+// computeLayout renders the chrome and derives the content area from what is left
+func (v *MainView) computeLayout() layout {
+	l := layout{
+		Width:   v.width,
+		Height:  v.height,
+		Header:  v.renderHeader(),
+		Minimal: v.state.MinimalMode || v.height < minimalModeHeight,
+	}
+	if l.Minimal {
+		l.Player = v.renderMiniPlayer()
+		l.ContentLines = l.Height - lipgloss.Height(l.Header) - lipgloss.Height(l.Player)
+		if l.ContentLines < minContentLines {
+			l.ContentLines = minContentLines
+		}
+		l.ContentWidth = l.Width
+		return l
+	}
+
+	l.Footer = v.renderFooter()
+	l.Player = v.renderPlayer()
+	if !v.state.HideLogArea {
+		l.Log = v.renderLogArea()
+	}
+
+	chrome := lipgloss.Height(l.Header) + lipgloss.Height(l.Footer) + lipgloss.Height(l.Player)
+	if l.Log != "" {
+		chrome += lipgloss.Height(l.Log)
+	}
+
+	l.ContentLines = l.Height - chrome - contentBoxOverhead
+	if l.ContentLines < minContentLines {
+		l.ContentLines = minContentLines
+	}
+
+	l.ContentWidth = l.Width - 2 // Border left/right
+	if l.ContentWidth < 10 {
+		l.ContentWidth = 10
+	}
+	return l
+}
+
+// currentLayout returns the layout of the last render, recomputing it if the terminal
+// has been resized since (e.g. when the controller asks before the next frame)
+func (v *MainView) currentLayout() layout {
+	if v.layout.Height != v.height || v.layout.Width != v.width {
+		v.layout = v.computeLayout()
+	}
+	return v.layout
+}
+
+// fitLines truncates text to at most maxLines lines, ending with hint when cut
+func fitLines(text string, maxLines int, hint string) string {
+	lines := strings.Split(text, "\n")
+	if len(lines) <= maxLines {
+		return text
+	}
+	if maxLines < 1 {
+		maxLines = 1
+	}
+	lines = lines[:maxLines-1]
+	return strings.Join(append(lines, hint), "\n")
+}
+
+// listRows returns how many list rows fit alongside overhead lines of titles and
+// totals, capped at preferred
+func (v *MainView) listRows(preferred, overhead int) int {
+	rows := v.currentLayout().ContentLines - overhead
+	if rows > preferred {
+		rows = preferred
+	}
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+// twoColumnMinWidth is the terminal width above which lists split into two columns
+const twoColumnMinWidth = 120
+
+// columnGap separates the two columns of a wide list
+const columnGap = 2
+
+// listColumns returns how many columns lists render in: two on wide terminals when
+// config.UI.TwoColumn is enabled, otherwise one
+func (v *MainView) listColumns() int {
+	cf := v.state.ConfigForm
+	if v.width > twoColumnMinWidth && cf != nil && cf.Config != nil && cf.Config.UI.TwoColumn {
+		return 2
+	}
+	return 1
+}
+
+// halfColumnWidth is the text width of one column in the two-column layout
+func (v *MainView) halfColumnWidth() int {
+	return (v.currentLayout().ContentWidth-2-columnGap-2)/2 - 2 // Padding, gap, scrollbar and row prefix
+}
+
+// renderColumns renders left and right side by side, each formatted at half width
+func (v *MainView) renderColumns(left, right func() string) string {
+	prev := v.columnWidth
+	v.columnWidth = v.halfColumnWidth()
+	defer func() { v.columnWidth = prev }()
+
+	// Truncate rather than wrap overlong lines, then pad so the right column lines up
+	width := v.columnWidth + 2
+	column := func(text string) string {
+		return lipgloss.PlaceHorizontal(width, lipgloss.Left, lipgloss.NewStyle().MaxWidth(width).Render(text))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, column(left()), strings.Repeat(" ", columnGap), column(right()))
+}
+
+// renderListRows renders rows start..end-1 one per line; in two-column mode the first
+// half of the window fills the left column and the rest the right, so selection still
+// moves through the flattened order
+func (v *MainView) renderListRows(start, end int, format func(i int) string) string {
+	rows := func(from, to int) string {
+		var b strings.Builder
+		for i := from; i < to; i++ {
+			b.WriteString(format(i))
+			b.WriteString("\n")
+		}
+		return b.String()
+	}
+
+	if v.listColumns() == 1 || end-start < 2 {
+		return rows(start, end)
+	}
+
+	split := start + (end-start+1)/2
+	return v.renderColumns(func() string {
+		return strings.TrimSuffix(rows(start, split), "\n")
+	}, func() string {
+		return strings.TrimSuffix(rows(split, end), "\n")
+	}) + "\n"
+}
+
+// scrollbarEnabled reports whether lists draw a scrollbar (config.UI.Scrollbar)
+func (v *MainView) scrollbarEnabled() bool {
+	cf := v.state.ConfigForm
+	return cf != nil && cf.Config != nil && cf.Config.UI.Scrollbar
+}
+
+// scrollbar returns height cells of a vertical scrollbar for a window of visible
+// items starting at start within total, with the thumb sized to the visible share
+func scrollbar(total, start, visible, height int) []string {
+	thumb := height * visible / total
+	if thumb < 1 {
+		thumb = 1
+	}
+	offset := 0
+	if total > visible {
+		offset = (height - thumb) * start / (total - visible)
+	}
+
+	cells := make([]string, height)
+	for i := range cells {
+		if i >= offset && i < offset+thumb {
+			cells[i] = "█"
+		} else {
+			cells[i] = "░"
+		}
+	}
+	return cells
+}
+
+// withScrollbar appends a scrollbar to the right of a block of list rows when the
+// list is longer than its visible window
+func (v *MainView) withScrollbar(rows string, total, start, visible int) string {
+	trailing := strings.HasSuffix(rows, "\n")
+	rows = strings.TrimSuffix(rows, "\n")
+	if !v.scrollbarEnabled() || total <= visible || rows == "" {
+		if trailing {
+			return rows + "\n"
+		}
+		return rows
+	}
+
+	height := lipgloss.Height(rows)
+	block := lipgloss.PlaceHorizontal(lipgloss.Width(rows), lipgloss.Left, rows)
+	bar := v.styles.HelpText.Render(strings.Join(scrollbar(total, start, visible, height), "\n"))
+	out := lipgloss.JoinHorizontal(lipgloss.Top, block, " ", bar)
+	if trailing {
+		out += "\n"
+	}
+	return out
+}
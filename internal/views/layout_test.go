@@ -0,0 +1,57 @@
+package views
+
+import (
+	"fmt"
+	"testing"
+
+	"navitone-cli/internal/config"
+	"navitone-cli/internal/models"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// newTestView returns a view of a small library with the default config, sized
+// width x height
+func newTestView(width, height int) *MainView {
+	cfg := config.DefaultConfig()
+	state := &models.AppState{
+		ConfigForm: models.NewConfigFormState(cfg),
+		Volume:     cfg.Audio.Volume,
+	}
+	for i := 0; i < 60; i++ {
+		album := models.Album{ID: fmt.Sprintf("al%d", i), Name: fmt.Sprintf("Album %d", i), Artist: "Artist", Year: 2000 + i%20, TrackCount: 10, PlayCount: i * 37}
+		state.Albums = append(state.Albums, album)
+		state.RecentlyAddedAlbums = append(state.RecentlyAddedAlbums, album)
+		state.MostPlayedAlbums = append(state.MostPlayedAlbums, album)
+		state.Artists = append(state.Artists, models.Artist{ID: fmt.Sprintf("ar%d", i), Name: fmt.Sprintf("Artist %d", i), AlbumCount: 3})
+		state.Playlists = append(state.Playlists, models.Playlist{ID: fmt.Sprintf("pl%d", i), Name: fmt.Sprintf("Playlist %d", i), SongCount: 12})
+		track := models.Track{ID: fmt.Sprintf("tr%d", i), Title: fmt.Sprintf("Track %d", i), Artist: "Artist", Album: "Album", Duration: 200}
+		state.Queue = append(state.Queue, track)
+		state.TopTracks = append(state.TopTracks, track)
+	}
+	state.CurrentTrack = &state.Queue[0]
+	state.IsPlaying = true
+	for i := 0; i < 5; i++ {
+		state.AddLogMessage(fmt.Sprintf("Log message %d", i))
+	}
+
+	view := NewMainView(state, cfg.UI.Theme, cfg.UI.AccentIndex)
+	view.SetSize(width, height)
+	return view
+}
+
+// TestRenderFitsTerminal checks that no tab renders taller than the terminal
+func TestRenderFitsTerminal(t *testing.T) {
+	tabs := []models.Tab{models.HomeTab, models.AlbumsTab, models.ArtistsTab, models.PlaylistsTab, models.QueueTab, models.ConfigTab}
+	for _, height := range []int{24, 30, 50} {
+		for _, tab := range tabs {
+			t.Run(fmt.Sprintf("%d rows, tab %d", height, tab), func(t *testing.T) {
+				view := newTestView(100, height)
+				view.state.CurrentTab = tab
+				if got := lipgloss.Height(view.Render()); got > height {
+					t.Errorf("rendered %d rows, more than the terminal's %d", got, height)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TestLogAreaTruncatesWideRunes fills the log with emoji and CJK messages that
+// straddle the width limit and checks no line comes out wider than the terminal
+func TestLogAreaTruncatesWideRunes(t *testing.T) {
+	const width = 40
+	messages := []string{
+		strings.Repeat("a", width-6) + "🎵🎵🎵", // Emoji across the boundary
+		strings.Repeat("a", width-5) + "🎵x",  // Wide rune exactly at the boundary
+		strings.Repeat("音楽", width),          // Only wide runes
+		"Now playing: 坂本龍一 - 戦場のメリークリスマス 🎹🎹🎹🎹🎹🎹",
+		strings.Repeat("é", width*2), // Multi-byte but narrow
+	}
+
+	view := newTestView(width, 24)
+	view.state.ConfigForm.Config.UI.LogLines = len(messages)
+	view.state.LogMessages = messages
+
+	lines := strings.Split(view.renderLogArea(), "\n")
+	for i, line := range lines {
+		if got := lipgloss.Width(line); got > width {
+			t.Errorf("line %d is %d cells wide, more than %d: %q", i, got, width, line)
+		}
+	}
+
+	for _, msg := range messages {
+		truncated := view.truncateToWidth(msg, width-4)
+		if got := lipgloss.Width(truncated); got > width-4 {
+			t.Errorf("truncateToWidth(%q) is %d cells wide, want at most %d", msg, got, width-4)
+		}
+		if strings.ToValidUTF8(truncated, "?") != truncated {
+			t.Errorf("truncateToWidth(%q) cut a rune in half: %q", msg, truncated)
+		}
+	}
+}
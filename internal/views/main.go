@@ -2,10 +2,13 @@ package views
 
 import (
     "fmt"
+    "math"
     "strings"
-    "unicode/utf8"
+    "time"
 
     "github.com/charmbracelet/lipgloss"
+    "github.com/mattn/go-runewidth"
+    "navitone-cli/internal/config"
     "navitone-cli/internal/models"
 )
 
@@ -16,6 +19,14 @@ type MainView struct {
 	height int
 	theme  Theme
 	styles ThemedStyles
+	layout layout // Computed once per Render
+
+	themeAccent lipgloss.Color // The theme's own accent while SetAccent overrides it
+
+	columnWidth int // Row width while rendering one column of a two-column list (0 = full width)
+
+	albumRowSource string            // ui.album_row_format that albumRowFormat was parsed from
+	albumRowFormat *config.RowFormat // nil for the built-in album row layout
 }
 
 // NewMainView creates a new main view
@@ -57,6 +68,23 @@ func NewMainViewWithDirectTheme(state *models.AppState, theme Theme, styles Them
     }
 }
 
+// SetAccent rebuilds the styles with color as the accent, or with the theme's own
+// accent again when color is empty
+func (v *MainView) SetAccent(color string) {
+	if v.themeAccent == "" {
+		v.themeAccent = v.theme.Accent
+	}
+	accent := v.themeAccent
+	if color != "" {
+		accent = lipgloss.Color(color)
+	}
+	if accent == v.theme.Accent {
+		return
+	}
+	v.theme.Accent = accent
+	v.styles = NewThemedStyles(v.theme)
+}
+
 // SetSize updates the view dimensions
 func (v *MainView) SetSize(width, height int) {
 	// Debug logging to track size changes
@@ -78,28 +106,32 @@ func (v *MainView) Render() string {
 		v.height = 24
 	}
 
-	var sections []string
-
-	// Header with tabs - always render header first
-	header := v.renderHeader()
-
-	sections = append(sections, header)
-
-	// Main content area
-	sections = append(sections, v.renderContent())
+	// Measure the visible chrome once; the content area gets what is left
+	v.layout = v.computeLayout()
 
-	// Footer with key hints
-	sections = append(sections, v.renderFooter())
-
-	// Player controls section
-	sections = append(sections, v.renderPlayer())
+	sections := []string{
+		v.layout.Header,
+		v.renderContent(),
+	}
+	if v.layout.Footer != "" {
+		sections = append(sections, v.layout.Footer) // Dropped in minimal mode
+	}
+	sections = append(sections, v.layout.Player)
 
-	// Log area at the bottom
-	sections = append(sections, v.renderLogArea())
+	// Log area at the bottom (can be hidden to reclaim rows)
+	if v.layout.Log != "" {
+		sections = append(sections, v.layout.Log)
+	}
 
 	// Modal overlays if active
 	content := strings.Join(sections, "\n")
 
+	if v.state.ShowConfirm {
+		return v.renderConfirmOverlay(content)
+	}
+	if v.state.ShowPlaylistPicker {
+		return v.renderPlaylistPickerOverlay(content)
+	}
 	if v.state.ShowAlbumModal {
 		return v.renderAlbumModalOverlay(content)
 	}
@@ -115,6 +147,33 @@ func (v *MainView) Render() string {
 	if v.state.ShowSortModal {
 		return v.renderSortModalOverlay(content)
 	}
+	if v.state.ShowLogModal {
+		return v.renderLogModalOverlay(content)
+	}
+	if v.state.ShowHistoryModal {
+		return v.renderHistoryModalOverlay(content)
+	}
+	if v.state.ShowDevicePicker {
+		return v.renderDevicePickerOverlay(content)
+	}
+	if v.state.ShowRandomPicker {
+		return v.renderRandomPickerOverlay(content)
+	}
+	if v.state.ShowEqualizer {
+		return v.renderEqualizerOverlay(content)
+	}
+	if v.state.ShowFolderBrowser {
+		return v.renderFolderBrowserOverlay(content)
+	}
+	if v.state.ShowPodcasts {
+		return v.renderPodcastsOverlay(content)
+	}
+	if v.state.ShowFitPicker {
+		return v.renderFitPickerOverlay(content)
+	}
+	if v.state.ShowNowPlayingModal {
+		return v.renderNowPlayingModalOverlay(content)
+	}
 
 	return content
 }
@@ -131,52 +190,74 @@ func (v *MainView) renderHeader() string {
     pills := strings.Join(tabs, "")
     headerWidth := v.width
     if headerWidth <= 0 { headerWidth = 80 }
+
+    // Connection status on the right, dropping its label and then the dot when
+    // the tabs leave no room
+    inner := headerWidth - v.styles.Header.GetHorizontalFrameSize()
+    for _, status := range []string{v.renderConnectionStatus(true), v.renderConnectionStatus(false)} {
+        if gap := inner - lipgloss.Width(pills) - lipgloss.Width(status); gap >= 1 {
+            pills += strings.Repeat(" ", gap) + status
+            break
+        }
+    }
     return v.styles.Header.Width(headerWidth).Render(pills)
 }
 
+// renderConnectionStatus renders the header's connection dot: green when the
+// server answered last, yellow while connecting and red when it can't be reached
+func (v *MainView) renderConnectionStatus(withLabel bool) string {
+    style, label := v.styles.WarningMessage, "Connecting…"
+    switch v.state.ConnectionState {
+    case models.ConnectionConnected:
+        style, label = v.styles.SuccessMessage, "Connected"
+    case models.ConnectionDisconnected:
+        style, label = v.styles.ErrorMessage, "Disconnected"
+    }
+    status := v.glyphs().Connection
+    if withLabel {
+        status += " " + label
+    }
+    return style.Background(v.theme.Primary).Render(status)
+}
+
 // renderContent creates the main content area based on current tab
 func (v *MainView) renderContent() string {
-	// Ensure we have valid dimensions
-	width := v.width
-	height := v.height
-	if width <= 0 {
-		width = 80
-	}
-	if height <= 0 {
-		height = 24
-	}
+	l := v.currentLayout()
 
-    // Compute content height accounting for header (1), footer (1), player (3), log (2),
-    // and content box overhead (border top/bottom + padding top/bottom = 4)
-    // We need to be more conservative to ensure content never exceeds terminal height
-    contentHeight := height - 12  // Increased from 10 to 12 for more conservative sizing
-    contentWidth := width - 2
-	if contentWidth < 10 {
-		contentWidth = 10 // Minimum content width
-	}
-	if contentHeight < 3 {
-		contentHeight = 3 // Reduced minimum to ensure it fits small terminals
+	// Height includes the box padding but not its border
+	content := v.styles.Content.
+		Width(l.ContentWidth).
+		Height(l.ContentLines + 2).
+		MaxHeight(l.ContentLines + contentBoxOverhead)
+	if l.Minimal {
+		// No border or padding; every row goes to the list
+		content = lipgloss.NewStyle().
+			Foreground(v.theme.Foreground).
+			Width(l.ContentWidth).
+			Height(l.ContentLines).
+			MaxHeight(l.ContentLines)
 	}
 
-	content := v.styles.Content.
-		Width(contentWidth).
-		Height(contentHeight)
+	// Clamp every tab to the content area so the frame never overflows the terminal
+	render := func(text string) string {
+		return content.Render(fitLines(text, l.ContentLines, "… (more below)"))
+	}
 
 	switch v.state.CurrentTab {
 	case models.HomeTab:
-		return content.Render(v.renderHomeTab())
+		return render(v.renderHomeTab())
 	case models.AlbumsTab:
-		return content.Render(v.renderAlbumsTab())
+		return render(v.renderAlbumsTab())
 	case models.ArtistsTab:
-		return content.Render(v.renderArtistsTab())
+		return render(v.renderArtistsTab())
 	case models.PlaylistsTab:
-		return content.Render(v.renderPlaylistsTab())
+		return render(v.renderPlaylistsTab())
 	case models.QueueTab:
-		return content.Render(v.renderQueueTab())
+		return render(v.renderQueueTab())
 	case models.ConfigTab:
-		return content.Render(v.renderConfigTab())
+		return render(v.renderConfigTab())
 	default:
-		return content.Render("Unknown tab")
+		return render("Unknown tab")
 	}
 }
 
@@ -198,24 +279,31 @@ func (v *MainView) renderFooter() string {
 func (v *MainView) footerHint() string {
     global := "↑↓ Navigate • Tab Switch • Shift+S Sort • Shift+F Search • Shift+C Cava • q Quit"
 
-    if v.state.ShowAlbumModal || v.state.ShowArtistModal || v.state.ShowPlaylistModal || v.state.ShowSearchModal || v.state.ShowSortModal {
+    if v.state.ShowAlbumModal || v.state.ShowArtistModal || v.state.ShowPlaylistModal || v.state.ShowSearchModal || v.state.ShowSortModal || v.state.ShowPlaylistPicker {
         return global + " | Esc close • Enter select"
     }
 
     var ctx string
     switch v.state.CurrentTab {
     case models.HomeTab:
-        ctx = "Enter select • Shift+Enter queue • R Refresh"
+        play, queue := v.enterKeys()
+        ctx = play + " select • " + queue + " queue • R Refresh"
     case models.AlbumsTab:
-        ctx = "Enter view • R Refresh • A queue"
+        ctx = "Enter view • R Refresh • x/v mark • " + v.queueKeys("a") + " (marked) • A play next • W download • 0-5 rate • * random • Ctrl+R play random"
     case models.ArtistsTab:
-        ctx = "Enter view • R Refresh • A-Z jump to letter"
+        ctx = "Enter view • R Refresh • A-Z jump to letter • * random • x/v mark • " + v.queueKeys("Alt+Enter") + " (marked)"
     case models.PlaylistsTab:
-        ctx = "Enter view • R Refresh • A queue"
+        ctx = "Enter view • R Refresh • x/v mark • " + v.queueKeys("a") + " (marked) • A play next • W download"
     case models.QueueTab:
-        ctx = "Space play • Alt+←/→ skip • Shift+↑/↓ volume • X remove • C clear"
+        ctx = "Space play • Alt+←/→ skip • Shift+↑/↓ volume • X remove • C clear • [ clear played • ] clear upcoming • U undo • P add to playlist • O cache offline (Shift: all) • W download • 0-5 rate • ga/gA go to album/artist • e/E export M3U/JSON"
     case models.ConfigTab:
-        ctx = "Enter edit • F2 save • F3 test"
+        ctx = "Enter edit • F2 save • F3 test • F4 keyring • F5 token • F7 check"
+        if v.state.ConfigForm.IsScrobblingField(v.state.ConfigForm.ActiveField) {
+            ctx = "Enter edit • F2 save • F3 test scrobbling • F4 keyring • F5 token • F7 check"
+        }
+        if v.state.ConfigForm.ServerAdmin {
+            ctx += " • F6 scan library"
+        }
     }
 
     if ctx != "" {
@@ -231,6 +319,7 @@ func (v *MainView) formatRow(left string, right string, selected bool, leading s
     if width <= 0 { width = 80 }
     maxLine := width - 6 // account for frame and prefix
     if maxLine < 20 { maxLine = width - 2 }
+    if v.columnWidth > 0 { maxLine = v.columnWidth }
 
     prefix := "  "
     if selected { prefix = "> " }
@@ -246,6 +335,8 @@ func (v *MainView) formatRow(left string, right string, selected bool, leading s
         rem := maxLine - baseWidth - 1 - lipgloss.Width(rightText)
         if rem < 1 {
             leftText = v.truncateToWidth(leftText, max(1, rem))
+        } else if selected && v.marqueeEnabled() && lipgloss.Width(leftText) > rem {
+            leftText = v.marqueeText(leftText, rem)
         }
         rem = maxLine - baseWidth - 1 - lipgloss.Width(rightText) - lipgloss.Width(leftText)
         if rem < 0 { rem = 0 }
@@ -256,11 +347,68 @@ func (v *MainView) formatRow(left string, right string, selected bool, leading s
         return line
     }
 
-    content := prefix + leading + v.truncateToWidth(leftText, maxLine-baseWidth)
+    avail := maxLine - baseWidth
+    if selected && v.marqueeEnabled() && lipgloss.Width(leftText) > avail {
+        leftText = v.marqueeText(leftText, avail)
+    }
+    content := prefix + leading + v.truncateToWidth(leftText, avail)
     if selected { return v.styles.ActiveField.Render(content) }
     return content
 }
 
+// markLeading returns the checkmark for a row marked for batch queueing, or padding of
+// the same width for unmarked rows while anything is marked so the names stay aligned
+func (v *MainView) markLeading(index int) string {
+    if len(v.state.MarkedItems) == 0 { return "" }
+    check := v.glyphs().Check
+    if v.state.MarkedItems[index] { return check }
+    return strings.Repeat(" ", lipgloss.Width(check))
+}
+
+// enterKeys returns the keys that play and queue a selection (config.Behavior.EnterAction)
+func (v *MainView) enterKeys() (play, queue string) {
+    if v.state.ConfigForm != nil && v.state.ConfigForm.Config != nil && v.state.ConfigForm.Config.Behavior.EnterAction == config.EnterActionQueue {
+        return "Shift+Enter", "Enter"
+    }
+    return "Enter", "Shift+Enter"
+}
+
+// queueKeys describes what key and Alt+A do with an album, artist or playlist (config.Behavior.DefaultQueueMode)
+func (v *MainView) queueKeys(key string) string {
+    if v.state.ConfigForm != nil && v.state.ConfigForm.Config != nil && v.state.ConfigForm.Config.Behavior.DefaultQueueMode == config.QueueModeReplace {
+        return key + " replace queue • Alt+A append"
+    }
+    return key + " append • Alt+A replace queue"
+}
+
+// marqueePause is how many marquee ticks a selected row rests at its start before scrolling
+const marqueePause = 4
+
+// marqueeEnabled reports whether long selected rows should scroll (config.UI.Marquee)
+func (v *MainView) marqueeEnabled() bool {
+    return v.state.ConfigForm != nil && v.state.ConfigForm.Config != nil && v.state.ConfigForm.Config.UI.Marquee
+}
+
+// marqueeText returns a w-cell window of s scrolled by the selection's marquee offset,
+// wrapping around with a small gap so the start of the text follows its end
+func (v *MainView) marqueeText(s string, w int) string {
+    runes := []rune(s + "   ")
+    offset := v.state.MarqueeOffset - marqueePause
+    if offset <= 0 || w <= 0 { return s }
+    offset %= len(runes)
+
+    var b strings.Builder
+    width := 0
+    for i := 0; i < len(runes); i++ {
+        r := runes[(offset+i)%len(runes)]
+        rw := runeWidth(r)
+        if width+rw > w { break }
+        b.WriteRune(r)
+        width += rw
+    }
+    return b.String()
+}
+
 // truncateToWidth truncates a string to a visual width and appends an ellipsis when needed
 func (v *MainView) truncateToWidth(s string, w int) string {
     if w <= 0 { return "" }
@@ -281,9 +429,8 @@ func (v *MainView) truncateToWidth(s string, w int) string {
 }
 
 func runeWidth(r rune) int {
-    // Basic approximation; most ASCII and narrow runes are width 1
-    _ = utf8.RuneLen(r)
-    return 1
+    // Wide runes (CJK, most emoji) occupy two terminal cells
+    return runewidth.RuneWidth(r)
 }
 
 func max(a, b int) int { if a > b { return a }; return b }
@@ -331,24 +478,26 @@ func (v *MainView) renderProgressBar() string {
 
 // Tab-specific render functions
 func (v *MainView) renderHomeTab() string {
-	if v.state.LoadingHomeData {
-		return "🏠 Home\n\nLoading home data..."
+	// A refresh keeps showing the current sections until the new ones arrive
+	if v.state.LoadingHomeData && len(v.state.RecentlyAddedAlbums) == 0 && len(v.state.TopArtistsByPlays) == 0 &&
+		len(v.state.MostPlayedAlbums) == 0 && len(v.state.TopTracks) == 0 && len(v.state.RecentlyPlayedAlbums) == 0 {
+		return withIcon(v.glyphs().Home, "Home") + "\n\n" + v.loadingText("Loading home data...")
 	}
 
 	if v.state.LoadingError != "" {
-		return fmt.Sprintf("🏠 Home\n\n❌ Error: %s\n\nPress 'r' to retry", v.state.LoadingError)
+		return v.renderLoadingError(withIcon(v.glyphs().Home, "Home"))
 	}
 
 	var content strings.Builder
-	content.WriteString("🏠 Home\n\n")
+	content.WriteString(withIcon(v.glyphs().Home, "Home\n\n"))
 
 	// Show queue status
 	if len(v.state.Queue) > 0 {
-		content.WriteString(fmt.Sprintf("🔄 Queue: %d tracks", len(v.state.Queue)))
+		content.WriteString(fmt.Sprintf(withIcon(v.glyphs().Queue, "Queue: %d tracks"), len(v.state.Queue)))
 		if v.state.CurrentTrack != nil {
-			playStatus := "⏸"
+			playStatus := v.glyphs().Paused
 			if v.state.IsPlaying {
-				playStatus = "▶"
+				playStatus = v.glyphs().Playing
 			}
 			content.WriteString(fmt.Sprintf(" | %s %s - %s",
 				playStatus, v.state.CurrentTrack.Artist, v.state.CurrentTrack.Title))
@@ -358,38 +507,64 @@ func (v *MainView) renderHomeTab() string {
 
     // Footer displays navigation instructions
 
-	// Render all four sections vertically with height constraints
+	// Render all sections vertically with height constraints
 	homeSections := v.renderHomeSections()
 	content.WriteString(homeSections)
 
-	// Ensure content fits within available height
-	fullContent := content.String()
-
-	// Get the content height that was calculated in renderContent()
-	contentHeight := v.height - 12
-	if contentHeight < 3 {
-		contentHeight = 3
-	}
-
-	// Split content into lines and truncate if necessary
-	lines := strings.Split(fullContent, "\n")
+	// Ensure content fits within the computed content area
+	return fitLines(content.String(), v.currentLayout().ContentLines, "... (use ↑↓ to navigate sections)")
+}
 
-	// Account for border and padding overhead (approximately 4 lines)
-	maxContentLines := contentHeight - 4
-	if maxContentLines < 1 {
-		maxContentLines = 1
+// HomeSectionOrder returns the home sections to show, in display order
+func (v *MainView) HomeSectionOrder() []int {
+	var ui config.UIConfig
+	if v.state.ConfigForm != nil && v.state.ConfigForm.Config != nil {
+		ui = v.state.ConfigForm.Config.UI
 	}
+	return ui.HomeSectionOrder()
+}
 
-	if len(lines) > maxContentLines {
-		// Truncate and add scroll indicator
-		lines = lines[:maxContentLines-1]
-		lines = append(lines, "... (use ↑↓ to navigate sections)")
+// HomeSectionLimits returns how many items each home section shows: the configured
+// counts, trimmed largest-first until the shown sections fit the terminal height.
+// Hidden sections get 0.
+func (v *MainView) HomeSectionLimits() [config.HomeSectionCount]int {
+	configured := [config.HomeSectionCount]int{4, 4, 4, 4, 4}
+	if v.state.ConfigForm != nil && v.state.ConfigForm.Config != nil {
+		configured = v.state.ConfigForm.Config.UI.HomeSectionCounts()
+	}
+	order := v.HomeSectionOrder()
+	var counts [config.HomeSectionCount]int
+	total := 0
+	for _, section := range order {
+		counts[section] = configured[section]
+		total += counts[section]
+	}
+
+	// Content lines minus the tab header and queue status (4 lines) and the
+	// section titles plus the blank lines between them
+	budget := v.currentLayout().ContentLines - 4 - (2*len(order) - 1)
+	if budget < len(order) {
+		budget = len(order)
+	}
+
+	for total > budget {
+		largest := 0
+		for i := range counts {
+			if counts[i] > counts[largest] {
+				largest = i
+			}
+		}
+		if counts[largest] <= 1 {
+			break
+		}
+		counts[largest]--
+		total--
 	}
-
-	return strings.Join(lines, "\n")
+	return counts
 }
 
-// renderHomeSections renders all four home sections vertically with interactive navigation
+// renderHomeSections renders the configured home sections in order with interactive
+// navigation
 func (v *MainView) renderHomeSections() string {
 	var sections strings.Builder
 
@@ -399,33 +574,67 @@ func (v *MainView) renderHomeSections() string {
 		sectionWidth = 40
 	}
 
-	// Calculate available height for sections (conservative approach)
-	availableHeight := v.height - 20 // Very conservative to ensure fit
-	if availableHeight < 10 {
-		availableHeight = 10
-	}
+	// Per-section item counts come from config, trimmed to the terminal height
+	limits := v.HomeSectionLimits()
+	order := v.HomeSectionOrder()
 
-	// Render all sections vertically with exactly 4 items each
-	maxItemsPerSection := 4 // Always show exactly 4 items per section
+	// Wide terminals pair the sections side by side
+	if v.listColumns() == 2 {
+		for i := 0; i < len(order); i += 2 {
+			if i > 0 {
+				sections.WriteString("\n\n")
+			}
+			left, right := order[i], -1
+			if i+1 < len(order) {
+				right = order[i+1]
+			}
+			sections.WriteString(v.renderColumns(func() string {
+				return strings.TrimSuffix(v.renderHomeSection(left, v.columnWidth, limits[left]), "\n")
+			}, func() string {
+				if right < 0 {
+					return ""
+				}
+				return strings.TrimSuffix(v.renderHomeSection(right, v.columnWidth, limits[right]), "\n")
+			}))
+		}
+		return sections.String()
+	}
 
-	sections.WriteString(v.renderRecentlyAddedSectionConstrained(sectionWidth, maxItemsPerSection))
-	sections.WriteString("\n")
-	sections.WriteString(v.renderTopArtistsSectionConstrained(sectionWidth, maxItemsPerSection))
-	sections.WriteString("\n")
-	sections.WriteString(v.renderMostPlayedAlbumsSectionConstrained(sectionWidth, maxItemsPerSection))
-	sections.WriteString("\n")
-	sections.WriteString(v.renderTopTracksSectionConstrained(sectionWidth, maxItemsPerSection))
+	for i, section := range order {
+		if i > 0 {
+			sections.WriteString("\n")
+		}
+		sections.WriteString(v.renderHomeSection(section, sectionWidth, limits[section]))
+	}
 
 	return sections.String()
 }
 
+// renderHomeSection renders one home section by index (see config.HomeSectionIDs)
+func (v *MainView) renderHomeSection(section, width, maxItems int) string {
+	switch section {
+	case 0:
+		return v.renderRecentlyAddedSectionConstrained(width, maxItems)
+	case 1:
+		return v.renderTopArtistsSectionConstrained(width, maxItems)
+	case 2:
+		return v.renderMostPlayedAlbumsSectionConstrained(width, maxItems)
+	case 3:
+		return v.renderTopTracksSectionConstrained(width, maxItems)
+	case 4:
+		return v.renderRecentlyPlayedSectionConstrained(width, maxItems)
+	default:
+		return ""
+	}
+}
+
 // renderRecentlyAddedSectionConstrained renders the Recently Added Albums section with item limit
 func (v *MainView) renderRecentlyAddedSectionConstrained(width int, maxItems int) string {
 	var content strings.Builder
 	isActiveSection := v.state.HomeSelectedSection == 0
 
 	// Section title with indicator if active
-	title := "💿 Recently Added Albums"
+	title := withIcon(v.glyphs().Album, "Recently Added Albums")
 	if isActiveSection {
 		title = v.styles.ActiveSectionTitle.Render(title)
 	} else {
@@ -460,7 +669,7 @@ func (v *MainView) renderRecentlyAddedSectionConstrained(width int, maxItems int
 		content.WriteString(line + "\n")
 	}
 
-	// No "more" indicator - always show exactly 4 items
+	// No "more" indicator - sections show a fixed number of items
 
 	return content.String()
 }
@@ -471,7 +680,7 @@ func (v *MainView) renderTopArtistsSectionConstrained(width int, maxItems int) s
 	isActiveSection := v.state.HomeSelectedSection == 1
 
 	// Section title with indicator if active
-	title := "🎤 Top Artists"
+	title := withIcon(v.glyphs().Artist, "Top Artists")
 	if isActiveSection {
 		title = v.styles.ActiveSectionTitle.Render(title)
 	} else {
@@ -494,7 +703,7 @@ func (v *MainView) renderTopArtistsSectionConstrained(width int, maxItems int) s
 		artist := v.state.TopArtistsByPlays[i]
 		star := ""
 		if artist.StarredAt != nil {
-			star = "★ "
+			star = v.glyphs().Star + " "
 		}
 
 		albumText := "album"
@@ -511,7 +720,7 @@ func (v *MainView) renderTopArtistsSectionConstrained(width int, maxItems int) s
 		content.WriteString(line + "\n")
 	}
 
-	// No "more" indicator - always show exactly 4 items
+	// No "more" indicator - sections show a fixed number of items
 
 	return content.String()
 }
@@ -522,7 +731,7 @@ func (v *MainView) renderMostPlayedAlbumsSectionConstrained(width int, maxItems
 	isActiveSection := v.state.HomeSelectedSection == 2
 
 	// Section title with indicator if active
-	title := "🔥 Most Played Albums"
+	title := withIcon(v.glyphs().Hot, "Most Played Albums")
 	if isActiveSection {
 		title = v.styles.ActiveSectionTitle.Render(title)
 	} else {
@@ -557,7 +766,47 @@ func (v *MainView) renderMostPlayedAlbumsSectionConstrained(width int, maxItems
 		content.WriteString(line + "\n")
 	}
 
-	// No "more" indicator - always show exactly 4 items
+	// No "more" indicator - sections show a fixed number of items
+
+	return content.String()
+}
+
+// renderRecentlyPlayedSectionConstrained renders the Recently Played Albums section with item limit
+func (v *MainView) renderRecentlyPlayedSectionConstrained(width int, maxItems int) string {
+	var content strings.Builder
+	isActiveSection := v.state.HomeSelectedSection == 4
+
+	// Section title with indicator if active
+	title := withIcon(v.glyphs().History, "Recently Played Albums")
+	if isActiveSection {
+		title = v.styles.ActiveSectionTitle.Render(title)
+	} else {
+		title = v.styles.SectionTitle.Render(title)
+	}
+	content.WriteString(title + "\n")
+
+	if len(v.state.RecentlyPlayedAlbums) == 0 {
+		content.WriteString("  Nothing played yet\n")
+		return content.String()
+	}
+
+	// Show albums with selection highlighting, constrained by maxItems
+	maxShow := min(maxItems, len(v.state.RecentlyPlayedAlbums))
+	for i := 0; i < maxShow; i++ {
+		album := v.state.RecentlyPlayedAlbums[i]
+		yearStr := ""
+		if album.Year > 0 {
+			yearStr = fmt.Sprintf(" (%d)", album.Year)
+		}
+
+		line := fmt.Sprintf("%s - %s%s", album.Artist, album.Name, yearStr)
+		if isActiveSection && v.state.HomeSelectedIndex == i {
+			line = v.styles.ActiveField.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		content.WriteString(line + "\n")
+	}
 
 	return content.String()
 }
@@ -568,7 +817,7 @@ func (v *MainView) renderTopTracksSectionConstrained(width int, maxItems int) st
 	isActiveSection := v.state.HomeSelectedSection == 3
 
 	// Section title with indicator if active
-	title := "🎵 Top Tracks"
+	title := withIcon(v.glyphs().Track, "Top Tracks")
 	if isActiveSection {
 		title = v.styles.ActiveSectionTitle.Render(title)
 	} else {
@@ -607,7 +856,7 @@ func (v *MainView) renderTopTracksSectionConstrained(width int, maxItems int) st
 		content.WriteString(line + "\n")
 	}
 
-	// No "more" indicator - always show exactly 4 items
+	// No "more" indicator - sections show a fixed number of items
 
 	return content.String()
 }
@@ -618,7 +867,7 @@ func (v *MainView) renderRecentlyAddedSection(width int) string {
 	isActiveSection := v.state.HomeSelectedSection == 0
 
 	// Section title with indicator if active
-	title := "💿 Recently Added Albums"
+	title := withIcon(v.glyphs().Album, "Recently Added Albums")
 	if isActiveSection {
 		title = v.styles.ActiveSectionTitle.Render(title)
 	} else {
@@ -666,7 +915,7 @@ func (v *MainView) renderTopArtistsSection(width int) string {
 	isActiveSection := v.state.HomeSelectedSection == 1
 
 	// Section title with indicator if active
-	title := "🎤 Top Artists"
+	title := withIcon(v.glyphs().Artist, "Top Artists")
 	if isActiveSection {
 		title = v.styles.ActiveSectionTitle.Render(title)
 	} else {
@@ -689,7 +938,7 @@ func (v *MainView) renderTopArtistsSection(width int) string {
 		artist := v.state.TopArtistsByPlays[i]
 		star := ""
 		if artist.StarredAt != nil {
-			star = "★ "
+			star = v.glyphs().Star + " "
 		}
 
 		albumText := "album"
@@ -719,7 +968,7 @@ func (v *MainView) renderMostPlayedAlbumsSection(width int) string {
 	isActiveSection := v.state.HomeSelectedSection == 2
 
 	// Section title with indicator if active
-	title := "🔥 Most Played Albums"
+	title := withIcon(v.glyphs().Hot, "Most Played Albums")
 	if isActiveSection {
 		title = v.styles.ActiveSectionTitle.Render(title)
 	} else {
@@ -767,7 +1016,7 @@ func (v *MainView) renderTopTracksSection(width int) string {
 	isActiveSection := v.state.HomeSelectedSection == 3
 
 	// Section title with indicator if active
-	title := "🎵 Top Tracks"
+	title := withIcon(v.glyphs().Track, "Top Tracks")
 	if isActiveSection {
 		title = v.styles.ActiveSectionTitle.Render(title)
 	} else {
@@ -813,21 +1062,41 @@ func (v *MainView) renderTopTracksSection(width int) string {
 	return content.String()
 }
 
+// sortLabel describes the sort last applied to context ("albums", "artists" or
+// "playlists") for its tab title, e.g. " — by Year"; "" when unsorted
+func (v *MainView) sortLabel(context string) string {
+	option, ok := models.FindSortOption(v.state.ActiveSorts[context])
+	if !ok {
+		return ""
+	}
+	switch option.ID {
+	case "starred", "new":
+		return " — " + option.DisplayName // Filters rather than orders
+	}
+	return " — by " + option.DisplayName
+}
+
 func (v *MainView) renderAlbumsTab() string {
-	if v.state.LoadingAlbums {
-		return "💿 Albums\n\nLoading albums..."
+	if v.state.LoadingAlbums && len(v.state.Albums) == 0 {
+		return withIcon(v.glyphs().Album, "Albums") + "\n\n" + v.loadingText("Loading albums...")
 	}
 
 	if v.state.LoadingError != "" {
-		return fmt.Sprintf("💿 Albums\n\n❌ Error: %s\n\nPress 'r' to retry", v.state.LoadingError)
+		return v.renderLoadingError(withIcon(v.glyphs().Album, "Albums"))
 	}
 
 	if len(v.state.Albums) == 0 {
-		return "💿 Albums\n\nNo albums found.\n\nMake sure your Navidrome server is configured in the Config tab."
+		return withIcon(v.glyphs().Album, "Albums\n\nNo albums found.\n\nMake sure your Navidrome server is configured in the Config tab.")
 	}
 
 	var content strings.Builder
-	content.WriteString("💿 Albums\n\n")
+	content.WriteString(withIcon(v.glyphs().Album, "Albums"+v.sortLabel("albums")))
+	if v.state.LoadingAlbums {
+		content.WriteString(" (refreshing…)")
+	}
+	content.WriteString("\n\n")
+	content.WriteString(v.renderAlbumListHeader())
+	content.WriteString("\n")
 
     // Footer displays instructions; keep content focused
 
@@ -841,6 +1110,7 @@ func (v *MainView) renderAlbumsTab() string {
 	if v.state.ShowArtwork && v.state.CurrentArtwork != "" {
 		maxVisible = 15 // Reduce visible items when showing artwork
 	}
+	maxVisible = v.listRows(maxVisible, 5) * v.listColumns() // Title, column header and total lines
 	
 	if len(v.state.Albums) > maxVisible {
 		// Center the viewport around the selected item
@@ -855,12 +1125,9 @@ func (v *MainView) renderAlbumsTab() string {
 		endIdx = viewportStart + maxVisible
 	}
 
-	for i := startIdx; i < endIdx; i++ {
-		album := v.state.Albums[i]
-		line := v.formatAlbumLine(album, i == v.state.SelectedAlbumIndex)
-		content.WriteString(line)
-		content.WriteString("\n")
-	}
+	content.WriteString(v.withScrollbar(v.renderListRows(startIdx, endIdx, func(i int) string {
+		return v.formatAlbumLine(v.state.Albums[i], i == v.state.SelectedAlbumIndex, v.markLeading(i))
+	}), len(v.state.Albums), startIdx, endIdx-startIdx))
 
 	// Show total count
 	if len(v.state.Albums) > 0 {
@@ -880,33 +1147,136 @@ func (v *MainView) renderAlbumsTab() string {
 	return content.String()
 }
 
-func (v *MainView) formatAlbumLine(album models.Album, selected bool) string {
+// renderListHeader renders a column header row aligned with formatRow's columns
+// (repeated over each column of a two-column list)
+func (v *MainView) renderListHeader(left, right string) string {
+    header := func() string {
+        return v.styles.HelpText.Render(v.formatRow(left, right, false, ""))
+    }
+    if v.listColumns() == 1 {
+        return header()
+    }
+    return v.renderColumns(header, header)
+}
+
+// wideListColumns reports whether the list is wide enough for the optional columns
+func (v *MainView) wideListColumns() bool {
+    return v.width >= 72
+}
+
+// albumColumns lays out the right-hand album columns at fixed widths so they line up
+// (plays and year are dropped on narrow terminals)
+func (v *MainView) albumColumns(tracks, plays, year string) string {
+    if !v.wideListColumns() {
+        return fmt.Sprintf("%6s", tracks)
+    }
+    return fmt.Sprintf("%6s  %7s  %4s", tracks, plays, year)
+}
+
+// artistColumns lays out the right-hand artist columns at fixed widths
+func (v *MainView) artistColumns(albums, plays string) string {
+    if !v.wideListColumns() {
+        return fmt.Sprintf("%6s", albums)
+    }
+    return fmt.Sprintf("%6s  %7s", albums, plays)
+}
+
+// albumFormat returns the parsed ui.album_row_format, or nil for the built-in layout
+// (also when the template doesn't parse, so a bad edit can't break the list)
+func (v *MainView) albumFormat() *config.RowFormat {
+    format := ""
+    if cf := v.state.ConfigForm; cf != nil && cf.Config != nil {
+        format = cf.Config.UI.AlbumRowFormat
+    }
+    if format != v.albumRowSource {
+        v.albumRowSource = format
+        v.albumRowFormat = nil
+        if format != "" {
+            v.albumRowFormat, _ = config.ParseRowFormat(format, config.AlbumRowFields)
+        }
+    }
+    return v.albumRowFormat
+}
+
+// renderAlbumListHeader labels the album columns, following ui.album_row_format when set
+func (v *MainView) renderAlbumListHeader() string {
+    format := v.albumFormat()
+    if format == nil {
+        return v.renderListHeader("Artist — Album", v.albumColumns("Tracks", "Plays", "Year"))
+    }
+    left, right := format.Render(map[string]string{
+        "artist": "Artist", "name": "Album", "year": "Year", "genre": "Genre",
+        "tracks": "Tracks", "plays": "Plays", "duration": "Length", "rating": "Rating", "new": "",
+    })
+    return v.renderListHeader(left, right)
+}
+
+// newBadge returns "NEW" for an album added within ui.new_badge_days, or ""
+func (v *MainView) newBadge(album models.Album, selected bool) string {
+    days := 0
+    if cf := v.state.ConfigForm; cf != nil && cf.Config != nil {
+        days = cf.Config.UI.NewBadgeDays
+    }
+    if !album.AddedWithin(days, time.Now()) {
+        return ""
+    }
+    if selected {
+        return "NEW" // The selection highlight styles the whole row
+    }
+    return v.styles.SuccessMessage.Render("NEW")
+}
+
+func (v *MainView) formatAlbumLine(album models.Album, selected bool, leading string) string {
+    if format := v.albumFormat(); format != nil {
+        yearStr := ""
+        if album.Year > 0 { yearStr = fmt.Sprintf("%d", album.Year) }
+        duration := ""
+        if album.Duration > 0 { duration = formatHoursMinutes(time.Duration(album.Duration) * time.Second) }
+        left, right := format.Render(map[string]string{
+            "artist": album.Artist, "name": album.Name, "year": yearStr, "genre": album.Genre,
+            "tracks": v.formatCount(album.TrackCount), "plays": v.formatCount(album.PlayCount),
+            "duration": duration, "rating": v.ratingStars(v.state.Rating(album.ID, album.UserRating)),
+            "new": v.newBadge(album, selected),
+        })
+        return v.formatRow(left, right, selected, leading)
+    }
+
+    left := fmt.Sprintf("%s - %s", album.Artist, album.Name)
+    if badge := v.newBadge(album, selected); badge != "" {
+        left = badge + " " + left
+    }
+    if stars := v.ratingStars(v.state.Rating(album.ID, album.UserRating)); stars != "" {
+        left += " " + stars
+    }
+
     yearStr := ""
-    if album.Year > 0 { yearStr = fmt.Sprintf("[%d] ", album.Year) }
-    left := fmt.Sprintf("%s%s - %s", yearStr, album.Artist, album.Name)
-    
-    // Format with play count: "X tracks (Y plays)"
-    unit := "track"; if album.TrackCount != 1 { unit = "tracks" }
-    right := fmt.Sprintf("%2d %s (%4d plays)", album.TrackCount, unit, album.PlayCount)
-    
-    return v.formatRow(left, right, selected, "")
+    if album.Year > 0 { yearStr = fmt.Sprintf("%d", album.Year) }
+    right := v.albumColumns(v.formatCount(album.TrackCount), v.formatCount(album.PlayCount), yearStr)
+
+    return v.formatRow(left, right, selected, leading)
 }
 
 func (v *MainView) renderArtistsTab() string {
-	if v.state.LoadingArtists {
-		return "🎤 Artists\n\nLoading artists..."
+	if v.state.LoadingArtists && len(v.state.Artists) == 0 {
+		return withIcon(v.glyphs().Artist, "Artists") + "\n\n" + v.loadingText("Loading artists...")
 	}
 
 	if v.state.LoadingError != "" {
-		return fmt.Sprintf("🎤 Artists\n\n❌ Error: %s\n\nPress 'r' to retry", v.state.LoadingError)
+		return v.renderLoadingError(withIcon(v.glyphs().Artist, "Artists"))
 	}
 
 	if len(v.state.Artists) == 0 {
-		return "🎤 Artists\n\nNo artists found.\n\nMake sure your Navidrome server is configured in the Config tab."
+		return withIcon(v.glyphs().Artist, "Artists\n\nNo artists found.\n\nMake sure your Navidrome server is configured in the Config tab.")
 	}
 
 	var content strings.Builder
-	content.WriteString("🎤 Artists\n\n")
+	content.WriteString(withIcon(v.glyphs().Artist, "Artists"+v.sortLabel("artists")))
+	if v.state.LoadingArtists {
+		content.WriteString(" (refreshing…)")
+	}
+	content.WriteString("\n\n")
+	content.WriteString(v.renderListHeader("Artist", v.artistColumns("Albums", "Plays")))
+	content.WriteString("\n")
 
     // Footer displays instructions
 
@@ -915,8 +1285,8 @@ func (v *MainView) renderArtistsTab() string {
 	endIdx := len(v.state.Artists)
 
 	// For very large lists, show a window around the selected item
-	// Artists tab always shows 25 items (no artwork)
-	maxVisible := 25
+	// Artists tab shows up to 25 items (no artwork)
+	maxVisible := v.listRows(25, 5) * v.listColumns() // Title, column header and total lines
 	
 	if len(v.state.Artists) > maxVisible {
 		// Center the viewport around the selected item
@@ -931,12 +1301,9 @@ func (v *MainView) renderArtistsTab() string {
 		endIdx = viewportStart + maxVisible
 	}
 
-	for i := startIdx; i < endIdx; i++ {
-		artist := v.state.Artists[i]
-		line := v.formatArtistLine(artist, i == v.state.SelectedArtistIndex)
-		content.WriteString(line)
-		content.WriteString("\n")
-	}
+	content.WriteString(v.withScrollbar(v.renderListRows(startIdx, endIdx, func(i int) string {
+		return v.formatArtistLine(v.state.Artists[i], i == v.state.SelectedArtistIndex, v.markLeading(i))
+	}), len(v.state.Artists), startIdx, endIdx-startIdx))
 
 	// Show total count
 	if len(v.state.Artists) > 0 {
@@ -953,43 +1320,45 @@ func (v *MainView) renderArtistsTab() string {
 	return content.String()
 }
 
-func (v *MainView) formatArtistLine(artist models.Artist, selected bool) string {
-    unit := "album"; if artist.AlbumCount != 1 { unit = "albums" }
+func (v *MainView) formatArtistLine(artist models.Artist, selected bool, leading string) string {
     star := ""
-    if artist.StarredAt != nil { star = "★ " }
+    if artist.StarredAt != nil { star = v.glyphs().Star + " " }
     left := star + artist.Name
-    
-    // Format with play count: "X albums (Y plays)"
-    right := fmt.Sprintf("%2d %s (%4d plays)", artist.AlbumCount, unit, artist.PlayCount)
-    
-    return v.formatRow(left, right, selected, "")
+
+    right := v.artistColumns(v.formatCount(artist.AlbumCount), v.formatCount(artist.PlayCount))
+
+    return v.formatRow(left, right, selected, leading)
 }
 
-func (v *MainView) formatPlaylistLine(playlist models.Playlist, selected bool) string {
+func (v *MainView) formatPlaylistLine(playlist models.Playlist, selected bool, leading string) string {
     // Format with right-aligned counts and owner
     unit := "song"; if playlist.SongCount != 1 { unit = "songs" }
-    icon := "🔒"; if playlist.Public { icon = "🌐" }
-    left := icon + " " + playlist.Name
-    right := fmt.Sprintf("%d %s", playlist.SongCount, unit)
+    icon := v.glyphs().Private; if playlist.Public { icon = v.glyphs().Public }
+    left := withIcon(icon, playlist.Name)
+    right := fmt.Sprintf("%s %s", v.formatCount(playlist.SongCount), unit)
     if playlist.Owner != "" { right += fmt.Sprintf(" • by %s", playlist.Owner) }
-    return v.formatRow(left, right, selected, "")
+    return v.formatRow(left, right, selected, leading)
 }
 
 func (v *MainView) renderPlaylistsTab() string {
-	if v.state.LoadingPlaylists {
-		return "📋 Playlists\n\nLoading playlists..."
+	if v.state.LoadingPlaylists && len(v.state.Playlists) == 0 {
+		return withIcon(v.glyphs().Playlist, "Playlists") + "\n\n" + v.loadingText("Loading playlists...")
 	}
 
 	if v.state.LoadingError != "" {
-		return fmt.Sprintf("📋 Playlists\n\n❌ Error: %s\n\nPress 'r' to retry", v.state.LoadingError)
+		return v.renderLoadingError(withIcon(v.glyphs().Playlist, "Playlists"))
 	}
 
 	if len(v.state.Playlists) == 0 {
-		return "📋 Playlists\n\nNo playlists found.\n\nMake sure your Navidrome server is configured in the Config tab."
+		return withIcon(v.glyphs().Playlist, "Playlists\n\nNo playlists found.\n\nMake sure your Navidrome server is configured in the Config tab.")
 	}
 
 	var content strings.Builder
-	content.WriteString("📋 Playlists\n\n")
+	content.WriteString(withIcon(v.glyphs().Playlist, "Playlists"+v.sortLabel("playlists")))
+	if v.state.LoadingPlaylists {
+		content.WriteString(" (refreshing…)")
+	}
+	content.WriteString("\n\n")
 
     // Footer displays instructions
 
@@ -998,7 +1367,7 @@ func (v *MainView) renderPlaylistsTab() string {
 	endIdx := len(v.state.Playlists)
 
 	// For very large lists, show a window around the selected item
-	maxVisible := 25
+	maxVisible := v.listRows(25, 4) * v.listColumns() // Title and total lines
 	if len(v.state.Playlists) > maxVisible {
 		// Center the viewport around the selected item
 		viewportStart := v.state.SelectedPlaylistIndex - maxVisible/2
@@ -1012,12 +1381,9 @@ func (v *MainView) renderPlaylistsTab() string {
 		endIdx = viewportStart + maxVisible
 	}
 
-	for i := startIdx; i < endIdx; i++ {
-		playlist := v.state.Playlists[i]
-		line := v.formatPlaylistLine(playlist, i == v.state.SelectedPlaylistIndex)
-		content.WriteString(line)
-		content.WriteString("\n")
-	}
+	content.WriteString(v.withScrollbar(v.renderListRows(startIdx, endIdx, func(i int) string {
+		return v.formatPlaylistLine(v.state.Playlists[i], i == v.state.SelectedPlaylistIndex, v.markLeading(i))
+	}), len(v.state.Playlists), startIdx, endIdx-startIdx))
 
 	// Show total count
 	if len(v.state.Playlists) > 0 {
@@ -1034,7 +1400,7 @@ func (v *MainView) renderPlaylistsTab() string {
 
 func (v *MainView) renderQueueTab() string {
 	var content strings.Builder
-	content.WriteString("🔄 Queue\n\n")
+	content.WriteString(withIcon(v.glyphs().Queue, "Queue\n\n"))
 
 	if len(v.state.Queue) == 0 {
 		content.WriteString("Queue is empty.\n\n")
@@ -1046,9 +1412,9 @@ func (v *MainView) renderQueueTab() string {
 
 	// Show current playing track if any
 	if v.state.CurrentTrack != nil {
-		playStatus := "⏸"
+		playStatus := v.glyphs().Paused
 		if v.state.IsPlaying {
-			playStatus = "▶"
+			playStatus = v.glyphs().Playing
 		}
 		content.WriteString(fmt.Sprintf("Now Playing: %s %s - %s\n\n",
 			playStatus, v.state.CurrentTrack.Artist, v.state.CurrentTrack.Title))
@@ -1059,7 +1425,11 @@ func (v *MainView) renderQueueTab() string {
 	endIdx := len(v.state.Queue)
 
 	// For very large lists, show a window around the selected item
-	maxVisible := 25 // Show more items since we removed pagination
+	overhead := 4 // Title and total lines
+	if v.state.CurrentTrack != nil {
+		overhead += 2 // Now playing line
+	}
+	maxVisible := v.listRows(25, overhead) * v.listColumns()
 	if len(v.state.Queue) > maxVisible {
 		// Center the viewport around the selected item
 		viewportStart := v.state.SelectedQueueIndex - maxVisible/2
@@ -1073,14 +1443,11 @@ func (v *MainView) renderQueueTab() string {
 		endIdx = viewportStart + maxVisible
 	}
 
-	for i := startIdx; i < endIdx; i++ {
-		track := v.state.Queue[i]
-		line := v.formatQueueLine(track, i, i == v.state.SelectedQueueIndex)
-		content.WriteString(line)
-		content.WriteString("\n")
-	}
+	content.WriteString(v.withScrollbar(v.renderListRows(startIdx, endIdx, func(i int) string {
+		return v.formatQueueLine(v.state.Queue[i], i, i == v.state.SelectedQueueIndex)
+	}), len(v.state.Queue), startIdx, endIdx-startIdx))
 
-	// Show total count
+	// Show total count and how long the queue runs
 	if len(v.state.Queue) > 0 {
 		if len(v.state.Queue) > maxVisible {
 			content.WriteString(fmt.Sprintf("\nShowing %d-%d of %d tracks",
@@ -1088,6 +1455,11 @@ func (v *MainView) renderQueueTab() string {
 		} else {
 			content.WriteString(fmt.Sprintf("\n%d tracks total", len(v.state.Queue)))
 		}
+		total, remaining := v.state.QueueDuration()
+		content.WriteString(" • " + formatHoursMinutes(total))
+		if v.state.CurrentTrack != nil {
+			content.WriteString(", " + formatHoursMinutes(remaining) + " left")
+		}
 	}
 
 	return content.String()
@@ -1106,11 +1478,23 @@ func (v *MainView) formatQueueLine(track models.Track, index int, selected bool)
     leading := fmt.Sprintf("%2d.", index+1)
     playing := v.state.CurrentTrack != nil && track.ID == v.state.CurrentTrack.ID
     if playing {
-        if v.state.IsPlaying { leading = "▶" } else { leading = "⏸" }
+        if v.state.IsPlaying { leading = v.glyphs().Playing } else { leading = v.glyphs().Paused }
     }
 
     left := fmt.Sprintf("%s - %s (%s)", track.Artist, track.Title, track.Album)
+    if stars := v.ratingStars(v.state.Rating(track.ID, track.UserRating)); stars != "" {
+        right = stars + "  " + right
+    }
+    if v.state.CachedTrackIDs[track.ID] {
+        right = withIcon(v.glyphs().Cached, "cached  ") + right
+    }
+    if track.Unavailable {
+        right = withIcon(v.glyphs().Warning, "unavailable  ") + right
+    }
     line := v.formatRow(left, right, selected, leading)
+    if track.Unavailable && !selected {
+        return v.styles.ErrorMessage.Render(line)
+    }
     if playing && !selected {
         return v.styles.CurrentTrack.Render(line)
     }
@@ -1124,17 +1508,25 @@ func (v *MainView) renderConfigTab() string {
     }
 
     var sections []string
+    activeLine := -1 // Line of the active field, which the form scrolls to keep visible
+    addSection := func(title string, fields []models.ConfigFormField) {
+        section, active := v.renderConfigSection(title, fields, cf)
+        if active >= 0 {
+            activeLine = countLines(sections) + active
+        }
+        sections = append(sections, section)
+    }
 
     // Header (avoid emojis to keep borders aligned in all fonts)
     sections = append(sections, "Configuration")
     sections = append(sections, "")
 
     // Navidrome section
-    sections = append(sections, v.renderConfigSection("Navidrome Server Settings", []models.ConfigFormField{
+    addSection("Navidrome Server Settings", []models.ConfigFormField{
         models.ServerURLField,
         models.UsernameField,
         models.PasswordField,
-    }, cf))
+    })
 
     sections = append(sections, "")
 
@@ -1148,42 +1540,55 @@ func (v *MainView) renderConfigTab() string {
     } else {
         sections = append(sections, "[i] Server scrobbling status unavailable")
     }
+    if cf.Scanning {
+        sections = append(sections, fmt.Sprintf("[~] Scanning: %d items", cf.ScanCount))
+    }
     sections = append(sections, "")
 
     // Scrobbling section
-    sections = append(sections, v.renderConfigSection("Scrobbling Settings", []models.ConfigFormField{
+    addSection("Scrobbling Settings", []models.ConfigFormField{
         models.LastFMEnabledField,
         models.LastFMUsernameField,
         models.LastFMPasswordField,
         models.ListenBrainzEnabledField,
         models.ListenBrainzTokenField,
-    }, cf))
+    })
 
 	sections = append(sections, "")
 
 	// UI section
-	sections = append(sections, v.renderConfigSection("UI Settings", []models.ConfigFormField{
+	addSection("UI Settings", []models.ConfigFormField{
 		models.ShowArtworkField,
 		models.ArtworkQualityField,
 		models.ArtworkColorField,
 		models.ArtworkSizeField,
-	}, cf))
+		models.HomeRecentCountField,
+		models.HomeTopArtistsCountField,
+		models.HomeMostPlayedCountField,
+		models.HomeTopTracksCountField,
+		models.HomeRecentlyPlayedCountField,
+	})
 
 	sections = append(sections, "")
 
 	// Audio section
-	sections = append(sections, v.renderConfigSection("Audio Settings", []models.ConfigFormField{
+	addSection("Audio Settings", []models.ConfigFormField{
 		models.VolumeField,
 		models.AudioDeviceField,
 		models.BufferSizeField,
-	}, cf))
-
-	sections = append(sections, "")
+	})
+	if cf.AudioStatus != "" {
+		style := v.styles.InfoMessage
+		if strings.HasPrefix(cf.AudioStatus, "[!]") {
+			style = v.styles.ErrorMessage
+		}
+		sections = append(sections, style.Render(cf.AudioStatus))
+	}
 
-	// Status messages
+	// Status messages stay below the form while it scrolls
+	var status []string
 	if cf.ValidationError != "" {
-		sections = append(sections, v.styles.ErrorMessage.Render("❌ "+cf.ValidationError))
-		sections = append(sections, "")
+		status = append(status, "", v.styles.ErrorMessage.Render(withIcon(v.glyphs().Error, cf.ValidationError)))
 	}
 
 	if cf.ConnectionStatus != "" {
@@ -1193,40 +1598,56 @@ func (v *MainView) renderConfigTab() string {
 		} else if strings.Contains(cf.ConnectionStatus, "ℹ") {
 			style = v.styles.InfoMessage
 		}
-		sections = append(sections, style.Render(cf.ConnectionStatus))
-		sections = append(sections, "")
+		status = append(status, "", style.Render(cf.ConnectionStatus))
 	}
 
-    // Join all sections and ensure content fits within available height
-    fullContent := strings.Join(sections, "\n")
+	return v.scrollConfigForm(strings.Split(strings.Join(sections, "\n"), "\n"), activeLine, status)
+}
 
-    // Get the content height that was calculated in renderContent()
-    contentHeight := v.height - 12
-    if contentHeight < 3 {
-        contentHeight = 3
+// countLines returns how many lines sections occupy once joined with newlines
+func countLines(sections []string) int {
+    count := 0
+    for _, section := range sections {
+        count += strings.Count(section, "\n") + 1
     }
+    return count
+}
 
-    // Split content into lines and truncate if necessary
-    lines := strings.Split(fullContent, "\n")
-
-    // Account for border and padding overhead (approximately 4 lines)
-    maxContentLines := contentHeight - 4
-    if maxContentLines < 1 {
-        maxContentLines = 1
+// scrollConfigForm fits the config form into the content area, scrolling it like the
+// list viewports so the active field (and any input being edited) stays in view
+func (v *MainView) scrollConfigForm(form []string, activeLine int, status []string) string {
+    contentLines := v.currentLayout().ContentLines
+    maxLines := contentLines - countLines(status)
+    if maxLines < 1 {
+        maxLines = 1
     }
 
-    if len(lines) > maxContentLines {
-        // Truncate and add scroll indicator
-        lines = lines[:maxContentLines-1]
-        lines = append(lines, "... (content truncated - scroll to see more)")
+    startIdx := 0
+    endIdx := len(form)
+    if len(form) > maxLines {
+        viewportStart := activeLine - maxLines/2
+        if viewportStart < 0 {
+            viewportStart = 0
+        }
+        if viewportStart+maxLines > len(form) {
+            viewportStart = len(form) - maxLines
+        }
+        startIdx = viewportStart
+        endIdx = viewportStart + maxLines
     }
 
-    return strings.Join(lines, "\n")
+    content := v.withScrollbar(strings.Join(form[startIdx:endIdx], "\n"), len(form), startIdx, endIdx-startIdx)
+    if len(status) > 0 {
+        content += "\n" + strings.Join(status, "\n")
+    }
+    return fitLines(content, contentLines, "")
 }
 
-// renderConfigSection renders a section of configuration fields
-func (v *MainView) renderConfigSection(title string, fields []models.ConfigFormField, cf *models.ConfigFormState) string {
+// renderConfigSection renders a section of configuration fields, also returning the
+// line of the active field within the section (-1 when it is in another section)
+func (v *MainView) renderConfigSection(title string, fields []models.ConfigFormField, cf *models.ConfigFormState) (string, int) {
     var lines []string
+    active := -1
     // Section title
     lines = append(lines, v.styles.SectionTitle.Render(title))
 
@@ -1237,6 +1658,9 @@ func (v *MainView) renderConfigSection(title string, fields []models.ConfigFormF
 
     // Fields
     for _, field := range fields {
+        if field == cf.ActiveField {
+            active = len(lines)
+        }
         lines = append(lines, v.renderConfigFieldLine(field, cf, boxWidth))
         // Insert a spacer line between Last.fm and ListenBrainz groups
         if title == "Scrobbling Settings" && field == models.LastFMPasswordField {
@@ -1247,7 +1671,7 @@ func (v *MainView) renderConfigSection(title string, fields []models.ConfigFormF
     // Bottom border
     lines = append(lines, "└"+strings.Repeat("─", boxWidth)+"┘")
 
-    return strings.Join(lines, "\n")
+    return strings.Join(lines, "\n"), active
 }
 
 // renderConfigFieldLine renders a single configuration field within a fixed-width box
@@ -1314,51 +1738,73 @@ func (v *MainView) renderPlayer() string {
 		var status []string
 
 		if v.state.IsPlaying {
-			status = append(status, "▶ Playing")
+			status = append(status, withIcon(v.glyphs().Playing, "Playing"))
 		} else {
-			status = append(status, "⏸ Stopped")
+			status = append(status, withIcon(v.glyphs().Paused, "Stopped"))
 		}
 
-		status = append(status, fmt.Sprintf("Vol: %d%%", v.state.Volume))
+		status = append(status, v.renderVolume())
 		status = append(status, fmt.Sprintf("Queue: %d", len(v.state.Queue)))
 
 		if v.state.IsShuffleMode {
-			status = append(status, "🔀 SHUFFLE ON")
+			status = append(status, withIcon(v.glyphs().Shuffle, "SHUFFLE ON"))
 		} else {
-			status = append(status, "🔀 Shuffle off")
+			status = append(status, withIcon(v.glyphs().Shuffle, "Shuffle off"))
+		}
+
+		if v.state.Offline {
+			status = append(status, withIcon(v.glyphs().Offline, "Offline"), withIcon(v.glyphs().Warning, "Reconnecting…"))
 		}
 
 		statusStr := strings.Join(status, " | ")
-		playerContent := fmt.Sprintf("♪ No track loaded | %s\nSPACE: Play/Pause | Alt+←/→: Skip | Alt+S: Shuffle | Shift+↑/↓: Volume", statusStr)
+		playerContent := fmt.Sprintf(withIcon(v.glyphs().Note, "No track loaded | %s\nSPACE: Play/Pause | Alt+←/→: Skip | Alt+S: Shuffle | Shift+↑/↓: Volume (Ctrl fine) | M: Mute | %s"), statusStr, v.renderSessionInfo())
 		return playerStyle.Render(playerContent)
 	}
 
 	var parts []string
 
 	// Current track info
-	trackInfo := fmt.Sprintf("♪ %s - %s", v.state.CurrentTrack.Artist, v.state.CurrentTrack.Title)
+	trackInfo := fmt.Sprintf(withIcon(v.glyphs().Note, "%s - %s"), v.state.CurrentTrack.Artist, v.state.CurrentTrack.Title)
 	if v.state.CurrentTrack.Album != "" {
 		trackInfo += fmt.Sprintf(" (%s)", v.state.CurrentTrack.Album)
 	}
-	parts = append(parts, trackInfo)
+	if details := v.renderStreamDetails(); details != "" {
+		trackInfo += " | " + details
+	}
+	parts = append(parts, v.truncateToWidth(trackInfo, playerWidth-2))
 
 	// Playback status and controls
 	var controls []string
 	if v.state.IsPlaying {
-		controls = append(controls, "▶ Playing")
+		controls = append(controls, withIcon(v.glyphs().Playing, "Playing"))
 	} else {
-		controls = append(controls, "⏸ Paused")
+		controls = append(controls, withIcon(v.glyphs().Paused, "Paused"))
 	}
 
 	// Volume
-	controls = append(controls, fmt.Sprintf("Vol: %d%%", v.state.Volume))
+	controls = append(controls, v.renderVolume())
 
 	// Queue info
 	controls = append(controls, fmt.Sprintf("Queue: %d", len(v.state.Queue)))
 
 	// Shuffle indicator
 	if v.state.IsShuffleMode {
-		controls = append(controls, "🔀 Shuffle")
+		controls = append(controls, withIcon(v.glyphs().Shuffle, "Shuffle"))
+	}
+
+	// Stop after current track indicator
+	if v.state.StopAfterCurrent {
+		controls = append(controls, withIcon(v.glyphs().Stopped, "Stop after track"))
+	}
+
+	// Server unreachable - playing downloaded tracks
+	if v.state.Offline {
+		controls = append(controls, withIcon(v.glyphs().Offline, "Offline"), withIcon(v.glyphs().Warning, "Reconnecting…"))
+	}
+
+	// Playback speed when not normal
+	if v.state.PlaybackSpeed > 0 && math.Abs(v.state.PlaybackSpeed-1.0) > 0.01 {
+		controls = append(controls, fmt.Sprintf("%.1fx", v.state.PlaybackSpeed))
 	}
 
 	// Dynamic progress bar
@@ -1371,75 +1817,210 @@ func (v *MainView) renderPlayer() string {
 	parts = append(parts, controlStr)
 
 	// Keybindings hint
-	parts = append(parts, "SPACE: Play/Pause | Alt+←/→: Skip | Alt+S: Shuffle | ←/→: Scrub | Shift+↑/↓: Volume")
+	parts = append(parts, "SPACE: Play/Pause | Alt+←/→: Skip | Alt+S: Shuffle (Shift: reshuffle) | ←/→: Scrub (Shift fine, Ctrl 60s) | Shift+↑/↓: Volume (Ctrl fine) | M: Mute | "+v.renderSessionInfo())
 
 	playerContent := strings.Join(parts, "\n")
 	return playerStyle.Render(playerContent)
 }
 
-// renderLogArea creates the log area at the bottom showing recent events
-func (v *MainView) renderLogArea() string {
-	// Ensure log area has a valid width
-	logWidth := v.width
-	if logWidth <= 0 {
-		logWidth = 80 // Fallback width
+// renderMiniPlayer is the single-line player used in minimal mode
+func (v *MainView) renderMiniPlayer() string {
+	width := v.width
+	if width <= 0 {
+		width = 80
 	}
 
-	logStyle := v.styles.LogArea.Copy().Width(logWidth)
-
-	if len(v.state.LogMessages) == 0 {
-		return logStyle.Render("Ready • Press SPACE to play/pause, Alt+S for shuffle, or navigate with Tab")
+	status := v.glyphs().Paused
+	if v.state.IsPlaying {
+		status = v.glyphs().Playing
 	}
 
-	// Show up to 2 most recent log messages
-	var logLines []string
-	messageCount := len(v.state.LogMessages)
-
-	if messageCount > 0 {
-		// Show the most recent messages (up to 2)
-		startIndex := 0
-		if messageCount > 2 {
-			startIndex = messageCount - 2
-		}
-
-		for i := startIndex; i < messageCount; i++ {
-			msg := v.state.LogMessages[i]
-			// Truncate very long messages to fit nicely
-			if len(msg) > v.width-4 {
-				msg = msg[:v.width-7] + "..."
-			}
-			logLines = append(logLines, msg)
+	var line string
+	if track := v.state.CurrentTrack; track != nil {
+		line = fmt.Sprintf("%s %s - %s", status, track.Artist, track.Title)
+		if track.Duration > 0 {
+			pos := int(v.state.Position.Seconds())
+			line += fmt.Sprintf("  %d:%02d/%d:%02d", pos/60, pos%60, track.Duration/60, track.Duration%60)
 		}
+	} else {
+		line = withIcon(v.glyphs().Note, "No track loaded")
 	}
 
-	// Pad to always show 2 lines for consistent layout
-	for len(logLines) < 2 {
-		logLines = append(logLines, "")
+	vol := fmt.Sprintf("%d%%", v.state.Volume)
+	if v.state.Muted {
+		vol = "muted"
+	} else if v.state.Volume > 100 {
+		vol += " " + v.glyphs().Warning
+	}
+	line += fmt.Sprintf("  Vol %s  Q %d", vol, len(v.state.Queue))
+	if v.state.Offline {
+		line += "  " + v.glyphs().Offline + " " + v.glyphs().Warning
 	}
 
-	logContent := strings.Join(logLines, "\n")
-	return logStyle.Render(logContent)
+	return v.styles.Player.Copy().Width(width).Render(v.truncateToWidth(line, width-2))
 }
 
-// renderAlbumModalOverlay renders the album tracks modal overlay
-func (v *MainView) renderAlbumModalOverlay(background string) string {
-	if v.state.SelectedAlbum == nil {
-		return background
+// renderVolume shows the volume as a 10-segment bar plus percentage
+func (v *MainView) renderVolume() string {
+	if v.state.Muted {
+		return withIcon(v.glyphs().Muted, "Muted")
+	}
+
+	filled := (v.state.Volume + 5) / 10
+	if filled > 10 {
+		filled = 10
+	}
+	if filled < 0 {
+		filled = 0
+	}
+	bar := fmt.Sprintf("Vol: %s%s %d%%", strings.Repeat("▮", filled), strings.Repeat("▯", 10-filled), v.state.Volume)
+	if v.state.Volume > 100 {
+		// Boosted above 100%: the bar is full, so flag the level instead
+		return v.styles.WarningMessage.Render(bar + " " + v.glyphs().Warning)
+	}
+	return bar
+}
+
+// renderStreamDetails shows codec, bitrate and sample rate, and whether the server
+// is transcoding because of the user's maxBitRate
+func (v *MainView) renderStreamDetails() string {
+	track := v.state.CurrentTrack
+	info := v.state.StreamInfo
+
+	var details []string
+	codec := strings.ToUpper(track.Suffix)
+	transcoded := false
+	if info.Codec != "" && !strings.EqualFold(info.Codec, track.Suffix) {
+		codec = fmt.Sprintf("%s→%s", codec, strings.ToUpper(info.Codec))
+		transcoded = true
+	}
+	if codec != "" {
+		details = append(details, codec)
+	}
+
+	bitRate := track.BitRate
+	if info.BitRate > 0 {
+		bitRate = info.BitRate
+	}
+	if bitRate > 0 {
+		details = append(details, fmt.Sprintf("%dkbps", bitRate))
+	}
+	if info.SampleRate > 0 {
+		details = append(details, fmt.Sprintf("%.1fkHz", float64(info.SampleRate)/1000))
+	}
+	switch info.Channels {
+	case 0:
+	case 1:
+		details = append(details, "mono")
+	case 2:
+		details = append(details, "stereo")
+	default:
+		details = append(details, fmt.Sprintf("%dch", info.Channels))
+	}
+
+	if max := v.state.ServerMaxBitRate; max > 0 && track.BitRate > max {
+		details = append(details, fmt.Sprintf("transcoded (max %dkbps)", max))
+	} else if transcoded {
+		details = append(details, "transcoded")
+	} else if len(details) > 0 {
+		details = append(details, "original")
+	}
+
+	return strings.Join(details, " ")
+}
+
+// formatHoursMinutes formats a duration as "2h 14m", or "14m" under an hour
+func formatHoursMinutes(d time.Duration) string {
+	d = d.Round(time.Minute)
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	if hours > 0 {
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	}
+	return fmt.Sprintf("%dm", minutes)
+}
+
+// renderSessionInfo shows the wall clock and total listening time this session
+func (v *MainView) renderSessionInfo() string {
+	listened := v.state.SessionListenTime
+	hours := int(listened.Hours())
+	minutes := int(listened.Minutes()) % 60
+	return fmt.Sprintf(withIcon(v.glyphs().Clock, "%s | Listened: %dh%02dm"), time.Now().Format("15:04"), hours, minutes)
+}
+
+// renderLogArea creates the log area at the bottom showing recent events
+func (v *MainView) renderLogArea() string {
+	// Ensure log area has a valid width
+	logWidth := v.width
+	if logWidth <= 0 {
+		logWidth = 80 // Fallback width
+	}
+
+	logStyle := v.styles.LogArea.Copy().Width(logWidth)
+
+	if len(v.state.LogMessages) == 0 {
+		return logStyle.Render("Ready • Press SPACE to play/pause, Alt+S for shuffle, or navigate with Tab")
+	}
+
+	// Show the configured number of most recent log messages
+	lineCount := v.logLineCount()
+	var logLines []string
+	messageCount := len(v.state.LogMessages)
+
+	if messageCount > 0 {
+		startIndex := 0
+		if messageCount > lineCount {
+			startIndex = messageCount - lineCount
+		}
+
+		for i := startIndex; i < messageCount; i++ {
+			// Truncate very long messages to fit nicely
+			msg := v.truncateToWidth(v.state.LogMessages[i], logWidth-4)
+			logLines = append(logLines, msg)
+		}
+	}
+
+	// Pad to always show the same number of lines for consistent layout
+	for len(logLines) < lineCount {
+		logLines = append(logLines, "")
+	}
+
+	logContent := strings.Join(logLines, "\n")
+	return logStyle.Render(logContent)
+}
+
+// logLineCount returns how many log lines to show, from config.UI.LogLines
+func (v *MainView) logLineCount() int {
+	lines := 2
+	if v.state.ConfigForm != nil && v.state.ConfigForm.Config != nil && v.state.ConfigForm.Config.UI.LogLines > 0 {
+		lines = v.state.ConfigForm.Config.UI.LogLines
+	}
+	if lines > 10 {
+		lines = 10
+	}
+	return lines
+}
+
+// renderAlbumModalOverlay renders the album tracks modal overlay
+func (v *MainView) renderAlbumModalOverlay(background string) string {
+	if v.state.SelectedAlbum == nil {
+		return background
 	}
 
 	var content strings.Builder
 
 	// Modal header
-	content.WriteString(fmt.Sprintf("🎵 %s - %s (%d)\n\n",
+	content.WriteString(fmt.Sprintf(withIcon(v.glyphs().Track, "%s - %s (%d)\n\n"),
 		v.state.SelectedAlbum.Artist, v.state.SelectedAlbum.Name, v.state.SelectedAlbum.Year))
 
 	if v.state.LoadingModalContent {
-		content.WriteString("Loading tracks...")
+		content.WriteString(v.loadingText("Loading tracks..."))
 	} else if len(v.state.AlbumTracks) == 0 {
 		content.WriteString("No tracks found.")
 	} else {
 		// Instructions
-		content.WriteString("↑↓ Navigate • PgUp/PgDn Jump • Enter to play & queue remainder • A to add all • Esc to close\n\n")
+		play, queue := v.enterKeys()
+		content.WriteString("↑↓ Navigate • PgUp/PgDn Jump • " + play + " to play & queue remainder • " + queue + " queue track • " + v.queueKeys("a") + " (all) • A play next • P add to playlist • w/W download track/all • 0-5 rate • Esc to close\n\n")
 
 		// Track list with viewport scrolling for large albums
 		startIdx := 0
@@ -1460,12 +2041,13 @@ func (v *MainView) renderAlbumModalOverlay(background string) string {
 			endIdx = viewportStart + maxVisible
 		}
 
+		var rows strings.Builder
 		for i := startIdx; i < endIdx; i++ {
 			track := v.state.AlbumTracks[i]
-			line := v.formatModalTrackLine(track, i, i == v.state.SelectedModalIndex)
-			content.WriteString(line)
-			content.WriteString("\n")
+			rows.WriteString(v.formatModalTrackLine(track, i, i == v.state.SelectedModalIndex))
+			rows.WriteString("\n")
 		}
+		content.WriteString(v.withScrollbar(rows.String(), len(v.state.AlbumTracks), startIdx, endIdx-startIdx))
 
 		// Show scroll indicator if there are more tracks
 		if len(v.state.AlbumTracks) > maxVisible {
@@ -1491,16 +2073,16 @@ func (v *MainView) renderArtistModalOverlay(background string) string {
 	if v.state.SelectedArtist.AlbumCount != 1 {
 		albumText = "albums"
 	}
-	content.WriteString(fmt.Sprintf("🎤 %s (%d %s)\n\n",
+	content.WriteString(fmt.Sprintf(withIcon(v.glyphs().Artist, "%s (%d %s)\n\n"),
 		v.state.SelectedArtist.Name, v.state.SelectedArtist.AlbumCount, albumText))
 
 	if v.state.LoadingModalContent {
-		content.WriteString("Loading albums...")
+		content.WriteString(v.loadingText("Loading albums..."))
 	} else if len(v.state.ArtistAlbums) == 0 {
 		content.WriteString("No albums found.")
 	} else {
 		// Instructions
-		content.WriteString("↑↓ Navigate • Enter to view tracks • A/Alt+Enter to queue all • Esc to close\n\n")
+		content.WriteString("↑↓ Navigate • Enter to view tracks • " + v.queueKeys("a") + " (all) • R artist radio • w download album • 0-5 rate • Esc to close\n\n")
 
 		// Album list
 		for i, album := range v.state.ArtistAlbums {
@@ -1523,16 +2105,17 @@ func (v *MainView) renderPlaylistModalOverlay(background string) string {
 	var content strings.Builder
 
 	// Modal header - simplified to match album modal pattern
-	content.WriteString(fmt.Sprintf("📋 %s (%d tracks)\n\n",
-		v.state.SelectedPlaylist.Name, v.state.SelectedPlaylist.SongCount))
+	content.WriteString(fmt.Sprintf(withIcon(v.glyphs().Playlist, "%s (%s tracks)\n\n"),
+		v.state.SelectedPlaylist.Name, v.formatCount(v.state.SelectedPlaylist.SongCount)))
 
 	if v.state.LoadingModalContent {
-		content.WriteString("Loading tracks...")
+		content.WriteString(v.loadingText("Loading tracks..."))
 	} else if len(v.state.PlaylistTracks) == 0 {
 		content.WriteString("No tracks found.")
 	} else {
 		// Instructions
-		content.WriteString("↑↓ Navigate • PgUp/PgDn Jump • Enter to play & queue remainder • A to add all • Esc to close\n\n")
+		play, queue := v.enterKeys()
+		content.WriteString("↑↓ Navigate • PgUp/PgDn Jump • " + play + " to play & queue remainder • " + queue + " queue track • " + v.queueKeys("a") + " (all) • A play next • w/W download track/all • 0-5 rate • Esc to close\n\n")
 
 		// Track list with viewport scrolling for large playlists
 		startIdx := 0
@@ -1553,12 +2136,13 @@ func (v *MainView) renderPlaylistModalOverlay(background string) string {
 			endIdx = viewportStart + maxVisible
 		}
 
+		var rows strings.Builder
 		for i := startIdx; i < endIdx; i++ {
 			track := v.state.PlaylistTracks[i]
-			line := v.formatModalTrackLine(track, i, i == v.state.SelectedModalIndex)
-			content.WriteString(line)
-			content.WriteString("\n")
+			rows.WriteString(v.formatModalTrackLine(track, i, i == v.state.SelectedModalIndex))
+			rows.WriteString("\n")
 		}
+		content.WriteString(v.withScrollbar(rows.String(), len(v.state.PlaylistTracks), startIdx, endIdx-startIdx))
 
 		// Show scroll indicator if there are more tracks
 		if len(v.state.PlaylistTracks) > maxVisible {
@@ -1573,9 +2157,14 @@ func (v *MainView) renderPlaylistModalOverlay(background string) string {
 
 // formatModalTrackLine formats a track line for modal display
 func (v *MainView) formatModalTrackLine(track models.Track, index int, selected bool) string {
-	// Format: Track# Title [Duration]
+	// Format: Track# Title [Duration], or for podcast episodes Date Title [Duration]
 	trackNum := ""
-	if track.Track > 0 {
+	if track.IsPodcast {
+		trackNum = "           "
+		if !track.PublishDate.IsZero() {
+			trackNum = track.PublishDate.Local().Format("2006-01-02") + " "
+		}
+	} else if track.Track > 0 {
 		trackNum = fmt.Sprintf("%02d. ", track.Track)
 	} else {
 		trackNum = fmt.Sprintf("%2d. ", index+1)
@@ -1590,6 +2179,12 @@ func (v *MainView) formatModalTrackLine(track models.Track, index int, selected
 	}
 
 	line := fmt.Sprintf("%s%s - %s%s", trackNum, track.Artist, track.Title, duration)
+	if track.IsPodcast {
+		line = fmt.Sprintf("%s%s%s", trackNum, track.Title, duration)
+	}
+	if stars := v.ratingStars(v.state.Rating(track.ID, track.UserRating)); stars != "" {
+		line += " " + stars
+	}
 
 	if selected {
 		return v.styles.ActiveField.Render("> " + line)
@@ -1606,7 +2201,10 @@ func (v *MainView) formatModalAlbumLine(album models.Album, selected bool) strin
 		yearStr = fmt.Sprintf("[%d] ", album.Year)
 	}
 
-	line := fmt.Sprintf("%s%s (%d tracks)", yearStr, album.Name, album.TrackCount)
+	line := fmt.Sprintf("%s%s (%s tracks)", yearStr, album.Name, v.formatCount(album.TrackCount))
+	if stars := v.ratingStars(v.state.Rating(album.ID, album.UserRating)); stars != "" {
+		line += " " + stars
+	}
 
 	if selected {
 		return v.styles.ActiveField.Render("> " + line)
@@ -1648,29 +2246,41 @@ func (v *MainView) renderSearchModalOverlay(background string) string {
 	var content strings.Builder
 
 	// Modal header
-	content.WriteString("🔍 Global Search\n\n")
+	content.WriteString(withIcon(v.glyphs().Search, "Global Search\n\n"))
 
 	// Search input box
-	content.WriteString(fmt.Sprintf("Search: %s█\n\n", v.state.SearchQuery))
+	content.WriteString(fmt.Sprintf("Search: %s█\n", v.state.SearchQuery))
+
+	// Scope selector
+	var scopes []string
+	for scope := models.SearchScopeAll; scope <= models.SearchScopeTracks; scope++ {
+		if scope == v.state.SearchScope {
+			scopes = append(scopes, v.styles.ActiveField.Render("["+scope.String()+"]"))
+		} else {
+			scopes = append(scopes, " "+scope.String()+" ")
+		}
+	}
+	content.WriteString("Scope: " + strings.Join(scopes, " ") + "  (Tab to change)\n\n")
 
 	if v.state.LoadingSearchResults {
-		content.WriteString("Searching...")
+		content.WriteString(v.loadingText("Searching..."))
 	} else if len(v.state.SearchQuery) == 0 {
 		content.WriteString("Type to search across artists, albums, and tracks\n")
-		content.WriteString("↑↓ Navigate • Enter to select • Esc to close")
+		content.WriteString("↑↓ Navigate • Enter to select • Tab scope • Esc to close")
 	} else {
 		results := v.state.SearchResults
 
 		if len(results.Artists) == 0 && len(results.Albums) == 0 && len(results.Tracks) == 0 {
 			content.WriteString("No results found")
 		} else {
-			content.WriteString("↑↓ Navigate • Enter: Play & queue remaining • Shift+Enter: Queue only • Esc to close\n\n")
+			play, queue := v.enterKeys()
+			content.WriteString("↑↓ Navigate • " + play + ": Play & queue remaining • " + queue + ": Queue only • Ctrl+P: Add to playlist • Esc to close\n\n")
 
 			currentIndex := 0
 
 			// Artists section
 			if len(results.Artists) > 0 {
-				content.WriteString("🎤 Artists:\n")
+				content.WriteString(withIcon(v.glyphs().Artist, "Artists:\n"))
 				for _, artist := range results.Artists {
 					selected := currentIndex == v.state.SelectedSearchIndex
 					line := v.formatSearchArtistLine(artist, selected)
@@ -1678,8 +2288,8 @@ func (v *MainView) renderSearchModalOverlay(background string) string {
 					content.WriteString("\n")
 					currentIndex++
 				}
-				// Add MORE option if we have exactly 5 artists (indicating more might be available)
-				if len(results.Artists) == 5 {
+				// Add MORE option if the last page was full (indicating more might be available)
+				if results.MoreArtists {
 					selected := currentIndex == v.state.SelectedSearchIndex
 					line := "  " + "→ MORE artists..."
 					if selected {
@@ -1694,7 +2304,7 @@ func (v *MainView) renderSearchModalOverlay(background string) string {
 
 			// Albums section
 			if len(results.Albums) > 0 {
-				content.WriteString("💿 Albums:\n")
+				content.WriteString(withIcon(v.glyphs().Album, "Albums:\n"))
 				for _, album := range results.Albums {
 					selected := currentIndex == v.state.SelectedSearchIndex
 					line := v.formatSearchAlbumLine(album, selected)
@@ -1702,8 +2312,8 @@ func (v *MainView) renderSearchModalOverlay(background string) string {
 					content.WriteString("\n")
 					currentIndex++
 				}
-				// Add MORE option if we have exactly 5 albums
-				if len(results.Albums) == 5 {
+				// Add MORE option if the last page was full
+				if results.MoreAlbums {
 					selected := currentIndex == v.state.SelectedSearchIndex
 					line := "  " + "→ MORE albums..."
 					if selected {
@@ -1718,7 +2328,7 @@ func (v *MainView) renderSearchModalOverlay(background string) string {
 
 			// Tracks section
 			if len(results.Tracks) > 0 {
-				content.WriteString("🎵 Tracks:\n")
+				content.WriteString(withIcon(v.glyphs().Track, "Tracks:\n"))
 				for _, track := range results.Tracks {
 					selected := currentIndex == v.state.SelectedSearchIndex
 					line := v.formatSearchTrackLine(track, selected)
@@ -1726,8 +2336,8 @@ func (v *MainView) renderSearchModalOverlay(background string) string {
 					content.WriteString("\n")
 					currentIndex++
 				}
-				// Add MORE option if we have exactly 5 tracks
-				if len(results.Tracks) == 5 {
+				// Add MORE option if the last page was full
+				if results.MoreTracks {
 					selected := currentIndex == v.state.SelectedSearchIndex
 					line := "  " + "→ MORE tracks..."
 					if selected {
@@ -1749,7 +2359,7 @@ func (v *MainView) renderSearchModalOverlay(background string) string {
 func (v *MainView) formatSearchArtistLine(artist models.Artist, selected bool) string {
 	starred := ""
 	if artist.StarredAt != nil {
-		starred = "★ "
+		starred = v.glyphs().Star + " "
 	}
 
 	line := fmt.Sprintf("%s%s (%d albums)", starred, artist.Name, artist.AlbumCount)
@@ -1768,7 +2378,7 @@ func (v *MainView) formatSearchAlbumLine(album models.Album, selected bool) stri
 		year = fmt.Sprintf("[%d] ", album.Year)
 	}
 
-	line := fmt.Sprintf("%s%s - %s (%d tracks)", year, album.Artist, album.Name, album.TrackCount)
+	line := fmt.Sprintf("%s%s - %s (%s tracks)", year, album.Artist, album.Name, v.formatCount(album.TrackCount))
 
 	if selected {
 		return v.styles.ActiveField.Render("> " + line)
@@ -1810,7 +2420,7 @@ func (v *MainView) renderSortModalOverlay(background string) string {
 	case "playlists":
 		contextName = "Playlists"
 	}
-	content.WriteString(fmt.Sprintf("🔧 Sort %s\n\n", contextName))
+	content.WriteString(fmt.Sprintf(withIcon(v.glyphs().Sort, "Sort %s\n\n"), contextName))
 
 	// Instructions
 	content.WriteString("↑↓ Navigate • Enter to apply sort • Esc to cancel\n\n")
@@ -1841,6 +2451,536 @@ func (v *MainView) renderSortModalOverlay(background string) string {
 	return v.overlayModal(background, content.String(), 50, 15)
 }
 
+// renderNowPlayingModalOverlay renders what everyone on the server is streaming
+func (v *MainView) renderNowPlayingModalOverlay(background string) string {
+	var content strings.Builder
+
+	content.WriteString(withIcon(v.glyphs().Server, "Now Playing on Server\n\n"))
+	content.WriteString("R Refresh • Esc to close • Updates every 15s\n\n")
+
+	if v.state.LoadingNowPlaying && len(v.state.NowPlaying) == 0 {
+		content.WriteString(v.loadingText("Loading..."))
+	} else if len(v.state.NowPlaying) == 0 {
+		content.WriteString("Nobody is playing anything right now")
+	} else {
+		for _, entry := range v.state.NowPlaying {
+			ago := "just now"
+			if entry.MinutesAgo > 0 {
+				ago = fmt.Sprintf("%dm ago", entry.MinutesAgo)
+			}
+			user := entry.Username
+			if entry.PlayerName != "" {
+				user = fmt.Sprintf("%s [%s]", entry.Username, entry.PlayerName)
+			}
+			line := fmt.Sprintf("%s — %s — %s (%s)", user, entry.Track.Artist, entry.Track.Title, ago)
+			content.WriteString("  " + v.truncateToWidth(line, 66))
+			content.WriteString("\n")
+		}
+	}
+
+	return v.overlayModal(background, content.String(), 76, 20)
+}
+
+// renderHistoryModalOverlay renders the locally recorded play history
+func (v *MainView) renderHistoryModalOverlay(background string) string {
+	var content strings.Builder
+
+	content.WriteString(withIcon(v.glyphs().History, "Recently Played\n\n"))
+	content.WriteString("↑↓ Navigate • Enter to replay • A add to queue • Esc to close\n\n")
+
+	history := v.state.PlayHistory
+	if len(history) == 0 {
+		content.WriteString("Nothing played yet")
+	} else {
+		startIdx := 0
+		endIdx := len(history)
+		maxVisible := 14
+		if len(history) > maxVisible {
+			viewportStart := v.state.SelectedHistoryIndex - maxVisible/2
+			if viewportStart < 0 {
+				viewportStart = 0
+			}
+			if viewportStart+maxVisible > len(history) {
+				viewportStart = len(history) - maxVisible
+			}
+			startIdx = viewportStart
+			endIdx = viewportStart + maxVisible
+		}
+
+		var rows strings.Builder
+		for i := startIdx; i < endIdx; i++ {
+			entry := history[i]
+			when := entry.PlayedAt.Format("15:04")
+			if time.Since(entry.PlayedAt) > 24*time.Hour {
+				when = entry.PlayedAt.Format("Jan 02")
+			}
+			line := fmt.Sprintf("%-6s %s - %s", when, entry.Track.Artist, entry.Track.Title)
+			line = v.truncateToWidth(line, 64)
+			if i == v.state.SelectedHistoryIndex {
+				line = v.styles.ActiveField.Render("> " + line)
+			} else {
+				line = "  " + line
+			}
+			rows.WriteString(line)
+			rows.WriteString("\n")
+		}
+		content.WriteString(v.withScrollbar(rows.String(), len(history), startIdx, endIdx-startIdx))
+	}
+
+	return v.overlayModal(background, content.String(), 76, 22)
+}
+
+// renderLoadingError renders a tab's load failure, pointing at the Config tab when
+// the server rejected our credentials
+func (v *MainView) renderLoadingError(title string) string {
+	if v.state.AuthError != "" {
+		return fmt.Sprintf("%s\n\n%s: %s\n\nUpdate your credentials in the Config tab, then press 'r' to retry", title, withIcon(v.glyphs().Locked, "Authentication failed"), v.state.AuthError)
+	}
+	return fmt.Sprintf("%s\n\n%s: %s\n\nPress 'r' to retry", title, withIcon(v.glyphs().Error, "Error"), v.state.LoadingError)
+}
+
+// renderDevicePickerOverlay renders the audio output picker for the Config tab
+func (v *MainView) renderDevicePickerOverlay(background string) string {
+	var content strings.Builder
+
+	content.WriteString(withIcon(v.glyphs().Speaker, "Audio Device\n\n"))
+	content.WriteString("↑↓ Navigate • Enter to select • Esc to cancel\n\n")
+
+	devices := v.state.AudioDevices
+	startIdx := 0
+	endIdx := len(devices)
+	maxVisible := 12
+	if len(devices) > maxVisible {
+		viewportStart := v.state.SelectedDeviceIndex - maxVisible/2
+		if viewportStart < 0 {
+			viewportStart = 0
+		}
+		if viewportStart+maxVisible > len(devices) {
+			viewportStart = len(devices) - maxVisible
+		}
+		startIdx = viewportStart
+		endIdx = viewportStart + maxVisible
+	}
+
+	var rows strings.Builder
+	for i := startIdx; i < endIdx; i++ {
+		device := devices[i]
+		line := device.Description
+		if line == "" {
+			line = device.Name
+		}
+		line = v.truncateToWidth(line, 60)
+		if i == v.state.SelectedDeviceIndex {
+			line = v.styles.ActiveField.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		rows.WriteString(line)
+		rows.WriteString("\n")
+	}
+	content.WriteString(v.withScrollbar(rows.String(), len(devices), startIdx, endIdx-startIdx))
+
+	return v.overlayModal(background, content.String(), 72, 20)
+}
+
+// renderRandomPickerOverlay renders the track count choice for a random session
+func (v *MainView) renderRandomPickerOverlay(background string) string {
+	var content strings.Builder
+
+	content.WriteString(withIcon(v.glyphs().Shuffle, "Play Random Tracks\n\n"))
+	content.WriteString("↑↓ Navigate • Enter to start • Esc to cancel\n\n")
+	content.WriteString("Replaces the queue with random tracks from the whole library\n\n")
+
+	for i, count := range models.RandomSessionCounts {
+		line := fmt.Sprintf("%d tracks", count)
+		if i == v.state.SelectedRandomIndex {
+			line = v.styles.ActiveField.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+
+	return v.overlayModal(background, content.String(), 66, 14)
+}
+
+// renderFitPickerOverlay renders the fit-to-time prompt: target minutes and source
+func (v *MainView) renderFitPickerOverlay(background string) string {
+	var content strings.Builder
+
+	content.WriteString(withIcon(v.glyphs().Clock, "Fit to Time\n\n"))
+	content.WriteString("Type minutes or ↑↓ ±5 • ←→ source • Enter to start • Esc to cancel\n\n")
+	content.WriteString("Replaces the queue with tracks that fill the time\n\n")
+
+	content.WriteString(v.styles.ActiveField.Render(fmt.Sprintf("> %d minutes", v.state.FitMinutes)))
+	content.WriteString("\n\n")
+	random, top := "[Random]", " Most played "
+	if v.state.FitFromTopTracks {
+		random, top = " Random ", "[Most played]"
+	}
+	content.WriteString("  From: " + random + " " + top + "\n")
+
+	return v.overlayModal(background, content.String(), 72, 14)
+}
+
+// renderFolderBrowserOverlay renders the folder browser: the path descended so far
+// and the current directory's subfolders and files
+func (v *MainView) renderFolderBrowserOverlay(background string) string {
+	var content strings.Builder
+
+	path := []string{"Library"}
+	for _, dir := range v.state.FolderPath {
+		path = append(path, dir.Name)
+	}
+	content.WriteString(withIcon(v.glyphs().Folder, v.truncateToWidth(strings.Join(path, " / "), 64)+"\n\n"))
+	play, queue := v.enterKeys()
+	content.WriteString("↑↓ Navigate • Enter open folder • " + play + " play file • " + queue + " queue file\n")
+	content.WriteString("Backspace up • " + v.queueKeys("a") + " (folder) • Esc to close\n\n")
+
+	entries := v.state.FolderEntries
+	if v.state.LoadingFolder && len(entries) == 0 {
+		content.WriteString(v.loadingText("Loading folder..."))
+		return v.overlayModal(background, content.String(), 72, 24)
+	}
+	if len(entries) == 0 {
+		content.WriteString("Empty folder.")
+		return v.overlayModal(background, content.String(), 72, 24)
+	}
+
+	startIdx := 0
+	endIdx := len(entries)
+	maxVisible := 14
+	if len(entries) > maxVisible {
+		viewportStart := v.state.SelectedFolderIndex - maxVisible/2
+		if viewportStart < 0 {
+			viewportStart = 0
+		}
+		if viewportStart+maxVisible > len(entries) {
+			viewportStart = len(entries) - maxVisible
+		}
+		startIdx = viewportStart
+		endIdx = viewportStart + maxVisible
+	}
+
+	var rows strings.Builder
+	for i := startIdx; i < endIdx; i++ {
+		entry := entries[i]
+		line := entry.Name
+		if entry.Kind == models.FolderTrack {
+			if entry.Track.Duration > 0 {
+				line = fmt.Sprintf("%s (%d:%02d)", line, entry.Track.Duration/60, entry.Track.Duration%60)
+			}
+			line = withIcon(v.glyphs().Note, line)
+		} else {
+			line = withIcon(v.glyphs().Folder, line+"/")
+		}
+		line = v.truncateToWidth(line, 64)
+		if i == v.state.SelectedFolderIndex {
+			line = v.styles.ActiveField.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		rows.WriteString(line)
+		rows.WriteString("\n")
+	}
+	content.WriteString(v.withScrollbar(rows.String(), len(entries), startIdx, endIdx-startIdx))
+
+	return v.overlayModal(background, content.String(), 72, 24)
+}
+
+// renderConfirmOverlay renders a yes/no question
+func (v *MainView) renderConfirmOverlay(background string) string {
+	var content strings.Builder
+
+	content.WriteString(withIcon(v.glyphs().Warning, "Confirm\n\n"))
+	content.WriteString(lipgloss.NewStyle().Width(52).Render(v.state.ConfirmPrompt))
+	content.WriteString("\n\ny/Enter yes • n/Esc no")
+
+	return v.overlayModal(background, content.String(), 60, 10)
+}
+
+// renderPodcastsOverlay renders the podcast browser: the channels, or the open
+// channel's episodes, with the selected row's description underneath
+func (v *MainView) renderPodcastsOverlay(background string) string {
+	var content strings.Builder
+
+	title := "Podcasts"
+	open := v.state.OpenPodcast >= 0 && v.state.OpenPodcast < len(v.state.PodcastChannels)
+	if open {
+		title += " / " + v.state.PodcastChannels[v.state.OpenPodcast].Title
+	}
+	content.WriteString(withIcon(v.glyphs().Podcast, v.truncateToWidth(title, 64)+"\n\n"))
+	if open {
+		play, queue := v.enterKeys()
+		content.WriteString("↑↓ Navigate • " + play + " play episode • " + queue + " queue episode\n")
+		content.WriteString("Backspace to channels • r reload • Esc to close\n\n")
+	} else {
+		content.WriteString("↑↓ Navigate • Enter open channel • r reload • Esc to close\n\n")
+	}
+
+	channels := v.state.PodcastChannels
+	if v.state.LoadingPodcasts && len(channels) == 0 {
+		content.WriteString(v.loadingText("Loading podcasts..."))
+		return v.overlayModal(background, content.String(), 72, 24)
+	}
+	if len(channels) == 0 {
+		content.WriteString("No podcasts. Subscribe to some in the server's web UI.")
+		return v.overlayModal(background, content.String(), 72, 24)
+	}
+
+	count := len(channels)
+	if open {
+		count = len(channels[v.state.OpenPodcast].Episodes)
+		if count == 0 {
+			content.WriteString("No downloaded episodes yet.")
+			return v.overlayModal(background, content.String(), 72, 24)
+		}
+	}
+
+	startIdx := 0
+	endIdx := count
+	maxVisible := 10
+	if count > maxVisible {
+		viewportStart := v.state.SelectedPodcastIndex - maxVisible/2
+		if viewportStart < 0 {
+			viewportStart = 0
+		}
+		if viewportStart+maxVisible > count {
+			viewportStart = count - maxVisible
+		}
+		startIdx = viewportStart
+		endIdx = viewportStart + maxVisible
+	}
+
+	var rows strings.Builder
+	description := ""
+	for i := startIdx; i < endIdx; i++ {
+		selected := i == v.state.SelectedPodcastIndex
+		if open {
+			episode := channels[v.state.OpenPodcast].Episodes[i]
+			if selected {
+				description = episode.Description
+				if v.state.OpenPodcast == 0 && channels[0].ID == "" {
+					description = episode.Artist + " • " + description // Newest episodes span channels
+				}
+			}
+			episode.Title = v.truncateToWidth(episode.Title, 44)
+			rows.WriteString(v.formatModalTrackLine(episode, i, selected))
+			rows.WriteString("\n")
+			continue
+		}
+
+		channel := channels[i]
+		line := v.truncateToWidth(fmt.Sprintf("%s (%s episodes)", channel.Title, v.formatCount(len(channel.Episodes))), 64)
+		if selected {
+			description = channel.Description
+			line = v.styles.ActiveField.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		rows.WriteString(line)
+		rows.WriteString("\n")
+	}
+	content.WriteString(v.withScrollbar(rows.String(), count, startIdx, endIdx-startIdx))
+
+	if description = strings.Trim(description, " •"); description != "" {
+		wrapped := lipgloss.NewStyle().Width(64).Render(description)
+		content.WriteString("\n" + v.styles.HelpText.Render(fitLines(wrapped, 4, "…")))
+	}
+
+	return v.overlayModal(background, content.String(), 72, 24)
+}
+
+// renderEqualizerOverlay renders the equalizer presets and the gains of the
+// highlighted one, with the selected band marked on the custom row
+func (v *MainView) renderEqualizerOverlay(background string) string {
+	var content strings.Builder
+
+	content.WriteString(withIcon(v.glyphs().Speaker, "Equalizer\n\n"))
+	content.WriteString("↑↓ Navigate • Enter to apply • Esc to close\n")
+	content.WriteString("Custom: ←→ band • +/- gain\n\n")
+
+	audio := v.state.ConfigForm.Config.Audio
+	names := append(append([]string(nil), config.EqualizerPresetNames...), config.EqualizerCustom)
+	for i, name := range names {
+		line := strings.ToUpper(name[:1]) + name[1:]
+		if name == audio.Equalizer {
+			line += " " + v.glyphs().Check
+		}
+		if i == v.state.SelectedEqualizerIndex {
+			line = v.styles.ActiveField.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+	content.WriteString("\n")
+
+	custom := v.state.SelectedEqualizerIndex >= len(config.EqualizerPresetNames)
+	bands := config.EqualizerPresets["flat"]
+	if custom {
+		if len(audio.EqualizerBands) == len(config.EqualizerFrequencies) {
+			bands = audio.EqualizerBands
+		}
+	} else {
+		bands = config.EqualizerPresets[config.EqualizerPresetNames[v.state.SelectedEqualizerIndex]]
+	}
+	for i, gain := range bands {
+		freq := fmt.Sprintf("%d", config.EqualizerFrequencies[i])
+		if config.EqualizerFrequencies[i] >= 1000 {
+			freq = fmt.Sprintf("%dk", config.EqualizerFrequencies[i]/1000)
+		}
+		bar := strings.Repeat("█", int(math.Abs(gain)))
+		if gain < 0 {
+			bar = fmt.Sprintf("%12s│", bar)
+		} else {
+			bar = fmt.Sprintf("%12s│%s", "", bar)
+		}
+		line := fmt.Sprintf("%5s Hz %+4.0f dB %s", freq, gain, bar)
+		if custom && i == v.state.SelectedEqualizerBand {
+			line = v.styles.ActiveField.Render(line)
+		}
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+
+	return v.overlayModal(background, content.String(), 66, 28)
+}
+
+// renderPlaylistPickerOverlay renders the "add to playlist" picker
+func (v *MainView) renderPlaylistPickerOverlay(background string) string {
+	var content strings.Builder
+
+	trackCount := len(v.state.PlaylistPickerTracks)
+	if trackCount == 1 {
+		track := v.state.PlaylistPickerTracks[0]
+		content.WriteString(fmt.Sprintf(withIcon(v.glyphs().Add, "Add \"%s\" to playlist\n\n"), v.truncateToWidth(track.Title, 36)))
+	} else {
+		content.WriteString(fmt.Sprintf(withIcon(v.glyphs().Add, "Add %d tracks to playlist\n\n"), trackCount))
+	}
+	if v.state.NamingNewPlaylist {
+		content.WriteString("Type a name • Enter to create • Esc to go back\n\n")
+		content.WriteString(fmt.Sprintf("New playlist: %s█", v.state.NewPlaylistName))
+		return v.overlayModal(background, content.String(), 56, 20)
+	}
+	content.WriteString("↑↓ Navigate • Enter to add • Esc to cancel\n\n")
+
+	if v.state.LoadingPlaylists {
+		content.WriteString(v.loadingText("Loading playlists..."))
+	} else {
+		// Window around the selection, like the list tabs; the last row creates a playlist
+		rowCount := len(v.state.Playlists) + 1
+		startIdx := 0
+		endIdx := rowCount
+		maxVisible := 10
+		if rowCount > maxVisible {
+			viewportStart := v.state.SelectedPickerIndex - maxVisible/2
+			if viewportStart < 0 {
+				viewportStart = 0
+			}
+			if viewportStart+maxVisible > rowCount {
+				viewportStart = rowCount - maxVisible
+			}
+			startIdx = viewportStart
+			endIdx = viewportStart + maxVisible
+		}
+
+		var rows strings.Builder
+		if len(v.state.Playlists) == 0 {
+			rows.WriteString("  No playlists found\n")
+		}
+		for i := startIdx; i < endIdx; i++ {
+			line := withIcon(v.glyphs().Add, "New playlist…")
+			if i < len(v.state.Playlists) {
+				playlist := v.state.Playlists[i]
+				line = fmt.Sprintf("%s (%s tracks)", v.truncateToWidth(playlist.Name, 32), v.formatCount(playlist.SongCount))
+			}
+			if i == v.state.SelectedPickerIndex {
+				line = v.styles.ActiveField.Render("> " + line)
+			} else {
+				line = "  " + line
+			}
+			rows.WriteString(line)
+			rows.WriteString("\n")
+		}
+		content.WriteString(v.withScrollbar(rows.String(), rowCount, startIdx, endIdx-startIdx))
+	}
+
+	return v.overlayModal(background, content.String(), 56, 20)
+}
+
+// renderLogModalOverlay renders the scrollable log history modal
+func (v *MainView) renderLogModalOverlay(background string) string {
+	var content strings.Builder
+
+	modalWidth := v.width - 8
+	if modalWidth < 40 {
+		modalWidth = 40
+	}
+	modalHeight := v.height - 4
+	if modalHeight < 12 {
+		modalHeight = 12
+	}
+
+	content.WriteString(withIcon(v.glyphs().Log, "Log History\n\n"))
+
+	// Filter line / instructions
+	if v.state.EditingLogFilter {
+		content.WriteString(fmt.Sprintf("Filter: %s█\n", v.state.LogFilter))
+		content.WriteString("Enter to apply • Esc to clear\n\n")
+	} else {
+		if v.state.LogFilter != "" {
+			content.WriteString(fmt.Sprintf("Filter: %s\n", v.state.LogFilter))
+		} else {
+			content.WriteString("\n")
+		}
+		content.WriteString("↑↓/PgUp/PgDn Scroll • / Filter • Esc to close\n\n")
+	}
+
+	messages := v.state.FilteredLogMessages()
+	if len(messages) == 0 {
+		content.WriteString("No log messages")
+		return v.overlayModal(background, content.String(), modalWidth, modalHeight)
+	}
+
+	// Window around the selected message, like the list tabs
+	startIdx := 0
+	endIdx := len(messages)
+	maxVisible := modalHeight - 12
+	if maxVisible < 3 {
+		maxVisible = 3
+	}
+	if len(messages) > maxVisible {
+		viewportStart := v.state.SelectedLogIndex - maxVisible/2
+		if viewportStart < 0 {
+			viewportStart = 0
+		}
+		if viewportStart+maxVisible > len(messages) {
+			viewportStart = len(messages) - maxVisible
+		}
+		startIdx = viewportStart
+		endIdx = viewportStart + maxVisible
+	}
+
+	lineWidth := modalWidth - 8
+	for i := startIdx; i < endIdx; i++ {
+		line := v.truncateToWidth(messages[i], lineWidth-2)
+		if i == v.state.SelectedLogIndex {
+			line = v.styles.ActiveField.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+
+	content.WriteString(fmt.Sprintf("\nShowing %d-%d of %d messages", startIdx+1, endIdx, len(messages)))
+
+	return v.overlayModal(background, content.String(), modalWidth, modalHeight)
+}
+
 // getAvailableSortOptions returns sort options available for the current context (view helper)
 func (v *MainView) getAvailableSortOptions() []models.SortOption {
 	var available []models.SortOption
@@ -1858,7 +2998,7 @@ func (v *MainView) getAvailableSortOptions() []models.SortOption {
 // renderAlbumArtwork renders ASCII artwork for the currently selected album
 func (v *MainView) renderAlbumArtwork() string {
 	if v.state.LoadingArtwork {
-		return "\n\n⏳ Loading artwork..."
+		return "\n\n" + v.loadingText("Loading artwork...")
 	}
 
 	if v.state.CurrentArtwork == "" {
@@ -1871,7 +3011,7 @@ func (v *MainView) renderAlbumArtwork() string {
 	// Add selected album info if we have it
 	if len(v.state.Albums) > v.state.SelectedAlbumIndex {
 		album := v.state.Albums[v.state.SelectedAlbumIndex]
-		content.WriteString(fmt.Sprintf("🎨 %s - %s", album.Artist, album.Name))
+		content.WriteString(fmt.Sprintf(withIcon(v.glyphs().Art, "%s - %s"), album.Artist, album.Name))
 		if album.Year > 0 {
 			content.WriteString(fmt.Sprintf(" (%d)", album.Year))
 		}
@@ -3,11 +3,16 @@ package navidrome
 import (
 	"context"
 	"crypto/md5"
-	"encoding/json"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -19,6 +24,7 @@ type Client struct {
 	password   string
 	token      string
 	salt       string
+	staticAuth bool // token/salt were supplied up front; never rehash
 	httpClient *http.Client
 }
 
@@ -37,6 +43,27 @@ func NewClient(serverURL, username, password string) *Client {
 	}
 }
 
+// NewClientWithToken creates a client that authenticates with a pre-computed
+// Subsonic token (md5(password + salt)) so the raw password is never needed
+func NewClientWithToken(serverURL, username, token, salt string) *Client {
+	client := NewClient(serverURL, username, "")
+	client.token = token
+	client.salt = salt
+	client.staticAuth = true
+	return client
+}
+
+// GenerateToken computes a Subsonic auth token and random salt for password
+func GenerateToken(password string) (token, salt string, err error) {
+	saltBytes := make([]byte, 8)
+	if _, err := rand.Read(saltBytes); err != nil {
+		return "", "", fmt.Errorf("generating salt: %w", err)
+	}
+	salt = hex.EncodeToString(saltBytes)
+	hash := md5.Sum([]byte(password + salt))
+	return fmt.Sprintf("%x", hash), salt, nil
+}
+
 // SetTimeout sets the HTTP client timeout
 func (c *Client) SetTimeout(timeout time.Duration) {
 	c.httpClient.Timeout = timeout
@@ -45,11 +72,15 @@ func (c *Client) SetTimeout(timeout time.Duration) {
 // Ping tests the connection and authenticates with the server
 func (c *Client) Ping(ctx context.Context) error {
 	params := url.Values{}
-	params.Add("u", c.username)
-	params.Add("p", c.password)
-	params.Add("c", "navitone-cli")
-	params.Add("v", "1.16.1") // Subsonic API version
-	params.Add("f", "json")
+	if c.staticAuth {
+		params, _ = c.authenticate()
+	} else {
+		params.Add("u", c.username)
+		params.Add("p", c.password)
+		params.Add("c", "navitone-cli")
+		params.Add("v", "1.16.1") // Subsonic API version
+		params.Add("f", "json")
+	}
 
 	reqURL := fmt.Sprintf("%s/rest/ping?%s", c.baseURL, params.Encode())
 
@@ -68,43 +99,19 @@ func (c *Client) Ping(ctx context.Context) error {
 		return fmt.Errorf("ping failed with status: %d", resp.StatusCode)
 	}
 
-	var pingResp struct {
-		SubsonicResponse struct {
-			Status string `json:"status"`
-			Error  *struct {
-				Code    int    `json:"code"`
-				Message string `json:"message"`
-			} `json:"error,omitempty"`
-		} `json:"subsonic-response"`
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("reading ping response: %w", err)
-	}
-
-	if err := json.Unmarshal(body, &pingResp); err != nil {
-		return fmt.Errorf("parsing ping response: %w", err)
-	}
-
-	if pingResp.SubsonicResponse.Status != "ok" {
-		if pingResp.SubsonicResponse.Error != nil {
-			return fmt.Errorf("ping error: %s", pingResp.SubsonicResponse.Error.Message)
-		}
-		return fmt.Errorf("ping failed with status: %s", pingResp.SubsonicResponse.Status)
-	}
-
-	return nil
+	return parseResponse(resp, "ping", nil)
 }
 
 // authenticate generates authentication parameters for API requests
 func (c *Client) authenticate() (url.Values, error) {
-	// Generate salt
-	c.salt = fmt.Sprintf("%d", time.Now().UnixNano())
+	if !c.staticAuth {
+		// Generate salt
+		c.salt = fmt.Sprintf("%d", time.Now().UnixNano())
 
-	// Generate token (MD5 hash of password + salt)
-	hash := md5.Sum([]byte(c.password + c.salt))
-	c.token = fmt.Sprintf("%x", hash)
+		// Generate token (MD5 hash of password + salt)
+		hash := md5.Sum([]byte(c.password + c.salt))
+		c.token = fmt.Sprintf("%x", hash)
+	}
 
 	params := url.Values{}
 	params.Add("u", c.username)
@@ -151,10 +158,35 @@ func (c *Client) GetAlbums(ctx context.Context, limit, offset int) (*AlbumsRespo
 	return c.GetAlbumsByType(ctx, "newest", limit, offset)
 }
 
-// GetAlbumsByType gets albums sorted by different criteria
+// GetAlbumsByType gets albums sorted by different criteria. Types: "newest", "frequent",
+// "recent", "random", "alphabeticalByName", "alphabeticalByArtist", "highest" (by rating),
+// "starred" (starred only) and "byYear", which lists all years newest first (use
+// GetAlbumsByYear for a specific range).
 func (c *Client) GetAlbumsByType(ctx context.Context, albumType string, limit, offset int) (*AlbumsResponse, error) {
+	if albumType == "byYear" {
+		return c.GetAlbumsByYear(ctx, maxAlbumYear, 0, limit, offset)
+	}
+
 	params := url.Values{}
-	params.Add("type", albumType) // Types: "newest", "frequent", "recent", "random", "alphabeticalByName", "alphabeticalByArtist"
+	params.Add("type", albumType)
+	return c.getAlbumList(ctx, params, limit, offset)
+}
+
+// maxAlbumYear is the upper bound used when listing every year with byYear
+const maxAlbumYear = 9999
+
+// GetAlbumsByYear gets albums released between fromYear and toYear; the list is in
+// descending order when fromYear is greater than toYear
+func (c *Client) GetAlbumsByYear(ctx context.Context, fromYear, toYear, limit, offset int) (*AlbumsResponse, error) {
+	params := url.Values{}
+	params.Add("type", "byYear")
+	params.Add("fromYear", strconv.Itoa(fromYear))
+	params.Add("toYear", strconv.Itoa(toYear))
+	return c.getAlbumList(ctx, params, limit, offset)
+}
+
+// getAlbumList calls getAlbumList2 with the given type params plus paging
+func (c *Client) getAlbumList(ctx context.Context, params url.Values, limit, offset int) (*AlbumsResponse, error) {
 	if limit > 0 {
 		params.Add("size", fmt.Sprintf("%d", limit))
 	}
@@ -168,21 +200,9 @@ func (c *Client) GetAlbumsByType(ctx context.Context, albumType string, limit, o
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading albums response: %w", err)
-	}
-
 	var albumsResp AlbumsResponse
-	if err := json.Unmarshal(body, &albumsResp); err != nil {
-		return nil, fmt.Errorf("parsing albums response: %w", err)
-	}
-
-	if albumsResp.SubsonicResponse.Status != "ok" {
-		if albumsResp.SubsonicResponse.Error != nil {
-			return nil, fmt.Errorf("albums error: %s", albumsResp.SubsonicResponse.Error.Message)
-		}
-		return nil, fmt.Errorf("albums failed with status: %s", albumsResp.SubsonicResponse.Status)
+	if err := parseResponse(resp, "albums", &albumsResp); err != nil {
+		return nil, err
 	}
 
 	return &albumsResp, nil
@@ -198,21 +218,9 @@ func (c *Client) GetArtists(ctx context.Context) (*ArtistsResponse, error) {
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading artists response: %w", err)
-	}
-
 	var artistsResp ArtistsResponse
-	if err := json.Unmarshal(body, &artistsResp); err != nil {
-		return nil, fmt.Errorf("parsing artists response: %w", err)
-	}
-
-	if artistsResp.SubsonicResponse.Status != "ok" {
-		if artistsResp.SubsonicResponse.Error != nil {
-			return nil, fmt.Errorf("artists error: %s", artistsResp.SubsonicResponse.Error.Message)
-		}
-		return nil, fmt.Errorf("artists failed with status: %s", artistsResp.SubsonicResponse.Status)
+	if err := parseResponse(resp, "artists", &artistsResp); err != nil {
+		return nil, err
 	}
 
 	return &artistsResp, nil
@@ -231,21 +239,9 @@ func (c *Client) GetSongs(ctx context.Context, limit, offset int) (*SongsRespons
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading songs response: %w", err)
-	}
-
 	var songsResp RandomSongsResponse
-	if err := json.Unmarshal(body, &songsResp); err != nil {
-		return nil, fmt.Errorf("parsing songs response: %w", err)
-	}
-
-	if songsResp.SubsonicResponse.Status != "ok" {
-		if songsResp.SubsonicResponse.Error != nil {
-			return nil, fmt.Errorf("songs error: %s", songsResp.SubsonicResponse.Error.Message)
-		}
-		return nil, fmt.Errorf("songs failed with status: %s", songsResp.SubsonicResponse.Status)
+	if err := parseResponse(resp, "songs", &songsResp); err != nil {
+		return nil, err
 	}
 
 	// Convert to expected format
@@ -278,11 +274,6 @@ func (c *Client) GetTopTracks(ctx context.Context, limit int) (*SongsResponse, e
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading top tracks response: %w", err)
-	}
-
 	var topSongsResp struct {
 		SubsonicResponse struct {
 			BaseResponse
@@ -290,13 +281,8 @@ func (c *Client) GetTopTracks(ctx context.Context, limit int) (*SongsResponse, e
 		} `json:"subsonic-response"`
 	}
 
-	if err := json.Unmarshal(body, &topSongsResp); err != nil {
-		// If parsing fails, fallback to random songs
-		return c.GetSongs(ctx, limit, 0)
-	}
-
-	if topSongsResp.SubsonicResponse.Status != "ok" {
-		// If status is not ok, fallback to random songs
+	if err := parseResponse(resp, "top tracks", &topSongsResp); err != nil {
+		// If parsing fails or status is not ok, fallback to random songs
 		return c.GetSongs(ctx, limit, 0)
 	}
 
@@ -314,10 +300,55 @@ func (c *Client) GetTopTracks(ctx context.Context, limit int) (*SongsResponse, e
 	return convertedResp, nil
 }
 
-// GetAlbumTracks retrieves tracks from a specific album
+// GetAlbumTracks retrieves tracks from a specific album, in disc and track order
 func (c *Client) GetAlbumTracks(ctx context.Context, albumID string) (*SongsResponse, error) {
+	directory, err := c.getDirectory(ctx, albumID, "album tracks")
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to expected format
+	convertedResp := &SongsResponse{}
+	convertedResp.SubsonicResponse.BaseResponse = directory.SubsonicResponse.BaseResponse
+	convertedResp.SubsonicResponse.SongsByGenre = SongsList{Song: directory.SubsonicResponse.Directory.Child}
+	SortAlbumSongs(convertedResp.SubsonicResponse.SongsByGenre.Song)
+	return convertedResp, nil
+}
+
+// SortAlbumSongs puts an album's songs in play order: by disc, then track number.
+// getMusicDirectory doesn't promise any order, and multi-disc albums can come back
+// interleaved. Songs without a disc number count as disc 1; songs without a track
+// number go after the numbered ones on their disc, keeping the server's order.
+func SortAlbumSongs(songs []Song) {
+	disc := func(s Song) int {
+		return max(1, s.DiscNumber)
+	}
+	sort.SliceStable(songs, func(i, j int) bool {
+		a, b := songs[i], songs[j]
+		if disc(a) != disc(b) {
+			return disc(a) < disc(b)
+		}
+		if (a.Track == 0) != (b.Track == 0) {
+			return b.Track == 0
+		}
+		return a.Track < b.Track
+	})
+}
+
+// GetMusicDirectory lists a folder's subdirectories and songs by folder ID (from
+// GetIndexes or a parent directory); album IDs work too
+func (c *Client) GetMusicDirectory(ctx context.Context, id string) (*Directory, error) {
+	directory, err := c.getDirectory(ctx, id, "music directory")
+	if err != nil {
+		return nil, err
+	}
+	return &directory.SubsonicResponse.Directory, nil
+}
+
+// getDirectory fetches getMusicDirectory for id; what names it in errors
+func (c *Client) getDirectory(ctx context.Context, id, what string) (*DirectoryResponse, error) {
 	params := url.Values{}
-	params.Add("id", albumID)
+	params.Add("id", id)
 
 	resp, err := c.makeRequest(ctx, "getMusicDirectory", params)
 	if err != nil {
@@ -325,45 +356,49 @@ func (c *Client) GetAlbumTracks(ctx context.Context, albumID string) (*SongsResp
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading album tracks response: %w", err)
+	var directoryResp DirectoryResponse
+	if err := parseResponse(resp, what, &directoryResp); err != nil {
+		return nil, err
 	}
+	return &directoryResp, nil
+}
 
-	var directoryResp struct {
-		SubsonicResponse struct {
-			BaseResponse
-			Directory struct {
-				ID    string `json:"id"`
-				Name  string `json:"name"`
-				Child []Song `json:"child"`
-			} `json:"directory"`
-		} `json:"subsonic-response"`
+// GetMusicFolders returns the library's top-level music folders
+func (c *Client) GetMusicFolders(ctx context.Context) ([]MusicFolder, error) {
+	params := url.Values{}
+
+	resp, err := c.makeRequest(ctx, "getMusicFolders", params)
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	if err := json.Unmarshal(body, &directoryResp); err != nil {
-		return nil, fmt.Errorf("parsing album tracks response: %w", err)
+	var foldersResp MusicFoldersResponse
+	if err := parseResponse(resp, "music folders", &foldersResp); err != nil {
+		return nil, err
 	}
+	return foldersResp.SubsonicResponse.MusicFolders.MusicFolder, nil
+}
 
-	if directoryResp.SubsonicResponse.Status != "ok" {
-		if directoryResp.SubsonicResponse.Error != nil {
-			return nil, fmt.Errorf("album tracks error: %s", directoryResp.SubsonicResponse.Error.Message)
-		}
-		return nil, fmt.Errorf("album tracks failed with status: %s", directoryResp.SubsonicResponse.Status)
+// GetIndexes returns the top-level directories of a music folder (by its ID from
+// GetMusicFolders), or of all folders when folderID is empty
+func (c *Client) GetIndexes(ctx context.Context, folderID string) (*Indexes, error) {
+	params := url.Values{}
+	if folderID != "" {
+		params.Add("musicFolderId", folderID)
 	}
 
-	// Convert to expected format
-	convertedResp := &SongsResponse{
-		SubsonicResponse: struct {
-			BaseResponse
-			SongsByGenre SongsList `json:"songsByGenre"`
-		}{
-			BaseResponse: directoryResp.SubsonicResponse.BaseResponse,
-			SongsByGenre: SongsList{Song: directoryResp.SubsonicResponse.Directory.Child},
-		},
+	resp, err := c.makeRequest(ctx, "getIndexes", params)
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	return convertedResp, nil
+	var indexesResp IndexesResponse
+	if err := parseResponse(resp, "indexes", &indexesResp); err != nil {
+		return nil, err
+	}
+	return &indexesResp.SubsonicResponse.Indexes, nil
 }
 
 // GetArtistTracks retrieves all tracks from an artist by getting all their albums and tracks
@@ -412,11 +447,6 @@ func (c *Client) GetArtistAlbums(ctx context.Context, artistID string) (*AlbumsR
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading artist albums response: %w", err)
-	}
-
 	var artistResp struct {
 		SubsonicResponse struct {
 			BaseResponse
@@ -424,15 +454,8 @@ func (c *Client) GetArtistAlbums(ctx context.Context, artistID string) (*AlbumsR
 		} `json:"subsonic-response"`
 	}
 
-	if err := json.Unmarshal(body, &artistResp); err != nil {
-		return nil, fmt.Errorf("parsing artist albums response: %w", err)
-	}
-
-	if artistResp.SubsonicResponse.Status != "ok" {
-		if artistResp.SubsonicResponse.Error != nil {
-			return nil, fmt.Errorf("artist albums error: %s", artistResp.SubsonicResponse.Error.Message)
-		}
-		return nil, fmt.Errorf("artist albums failed with status: %s", artistResp.SubsonicResponse.Status)
+	if err := parseResponse(resp, "artist albums", &artistResp); err != nil {
+		return nil, err
 	}
 
 	// Convert to expected format
@@ -449,14 +472,75 @@ func (c *Client) GetArtistAlbums(ctx context.Context, artistID string) (*AlbumsR
 	return convertedResp, nil
 }
 
+// GetSimilarArtists returns up to count artists in the library similar to the
+// given one. The server gets them from its external agents (Last.fm), so the list
+// is empty when none are configured.
+func (c *Client) GetSimilarArtists(ctx context.Context, id string, count int) ([]Artist, error) {
+	params := url.Values{}
+	params.Add("id", id)
+	params.Add("count", fmt.Sprintf("%d", count))
+
+	resp, err := c.makeRequest(ctx, "getArtistInfo2", params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var infoResp ArtistInfoResponse
+	if err := parseResponse(resp, "similar artists", &infoResp); err != nil {
+		return nil, err
+	}
+	return infoResp.SubsonicResponse.ArtistInfo2.SimilarArtist, nil
+}
+
+// GetArtistTopSongs returns up to count of an artist's most popular songs; like
+// similar artists, this relies on the server's external agents
+func (c *Client) GetArtistTopSongs(ctx context.Context, artist string, count int) ([]Song, error) {
+	params := url.Values{}
+	params.Add("artist", artist)
+	params.Add("count", fmt.Sprintf("%d", count))
+
+	resp, err := c.makeRequest(ctx, "getTopSongs", params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var topResp TopSongsResponse
+	if err := parseResponse(resp, "top songs", &topResp); err != nil {
+		return nil, err
+	}
+	return topResp.SubsonicResponse.TopSongs.Song, nil
+}
+
 // GetStreamURL returns the streaming URL for a song with proper parameters for full track access
 func (c *Client) GetStreamURL(songID string) string {
+	// Request original format without transcoding
+	return c.streamURL(songID, "raw", 0)
+}
+
+// GetTranscodedStreamURL returns a streaming URL asking the server to transcode to format
+// (e.g. "mp3" for sources the client cannot decode itself)
+func (c *Client) GetTranscodedStreamURL(songID, format string) string {
+	return c.streamURL(songID, format, 0)
+}
+
+// GetStreamURLAt returns a streaming URL that starts offset into the song. Navidrome only
+// honors timeOffset for transcoded streams, so the audio is transcoded to MP3.
+func (c *Client) GetStreamURLAt(songID string, offset time.Duration) string {
+	return c.streamURL(songID, "mp3", offset)
+}
+
+// streamURL builds a stream URL for songID in the given format, optionally starting at offset
+func (c *Client) streamURL(songID, format string, offset time.Duration) string {
 	params, _ := c.authenticate()
 	params.Add("id", songID)
 	// According to Subsonic API: maxBitRate=0 means no limit imposed
 	params.Add("maxBitRate", "0")
-	// Request original format without transcoding  
-	params.Add("format", "raw")
+	params.Add("format", format)
+	if offset > 0 {
+		params.Add("timeOffset", strconv.Itoa(int(offset.Seconds())))
+	}
 	// Enable content length estimation for better streaming
 	params.Add("estimateContentLength", "true")
 	return fmt.Sprintf("%s/rest/stream?%s", c.baseURL, params.Encode())
@@ -469,6 +553,52 @@ func (c *Client) GetDownloadURL(songID string) string {
 	return fmt.Sprintf("%s/rest/download?%s", c.baseURL, params.Encode())
 }
 
+// DownloadTrack saves the original file for songID to destPath. The file is written to
+// a temp file next to destPath and renamed into place, so a failed or cancelled
+// download never leaves a partial file behind. Only ctx bounds the transfer.
+func (c *Client) DownloadTrack(ctx context.Context, songID, destPath string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetDownloadURL(songID), nil)
+	if err != nil {
+		return fmt.Errorf("creating download request: %w", err)
+	}
+
+	// Large files outlast the API client's timeout
+	resp, err := (&http.Client{Transport: c.httpClient.Transport}).Do(req)
+	if err != nil {
+		return fmt.Errorf("download request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download failed with status: %d", resp.StatusCode)
+	}
+	// Subsonic reports errors (e.g. missing download permission) as a JSON body
+	if strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		if err := parseResponse(resp, "download", nil); err != nil {
+			return err
+		}
+		return fmt.Errorf("download returned no audio")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(destPath), ".download-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
+
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("downloading track: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), destPath)
+}
+
 // GetStreamURLWithFallback returns streaming URL with fallback to download URL
 func (c *Client) GetStreamURLWithFallback(songID string) string {
 	// First try the stream endpoint with full parameters
@@ -494,21 +624,9 @@ func (c *Client) GetUser(ctx context.Context, username string) (*UserResponse, e
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading user response: %w", err)
-	}
-
 	var userResp UserResponse
-	if err := json.Unmarshal(body, &userResp); err != nil {
-		return nil, fmt.Errorf("parsing user response: %w", err)
-	}
-
-	if userResp.SubsonicResponse.Status != "ok" {
-		if userResp.SubsonicResponse.Error != nil {
-			return nil, fmt.Errorf("user error: %s", userResp.SubsonicResponse.Error.Message)
-		}
-		return nil, fmt.Errorf("user request failed with status: %s", userResp.SubsonicResponse.Status)
+	if err := parseResponse(resp, "user", &userResp); err != nil {
+		return nil, err
 	}
 
 	return &userResp, nil
@@ -536,17 +654,26 @@ func (c *Client) CheckUserPermissions(ctx context.Context) error {
 
 // Search performs a search across artists, albums, and songs
 func (c *Client) Search(ctx context.Context, query string, artistCount, albumCount, songCount int) (*SearchResponse, error) {
+	return c.SearchPaged(ctx, query, artistCount, 0, albumCount, 0, songCount, 0)
+}
+
+// SearchPaged performs a search3 query with per-category counts and offsets.
+// A count of 0 excludes that category from the results.
+func (c *Client) SearchPaged(ctx context.Context, query string, artistCount, artistOffset, albumCount, albumOffset, songCount, songOffset int) (*SearchResponse, error) {
 	params := url.Values{}
 	params.Add("query", query)
 	
-	if artistCount > 0 {
-		params.Add("artistCount", fmt.Sprintf("%d", artistCount))
+	params.Add("artistCount", fmt.Sprintf("%d", artistCount))
+	params.Add("albumCount", fmt.Sprintf("%d", albumCount))
+	params.Add("songCount", fmt.Sprintf("%d", songCount))
+	if artistOffset > 0 {
+		params.Add("artistOffset", fmt.Sprintf("%d", artistOffset))
 	}
-	if albumCount > 0 {
-		params.Add("albumCount", fmt.Sprintf("%d", albumCount))
+	if albumOffset > 0 {
+		params.Add("albumOffset", fmt.Sprintf("%d", albumOffset))
 	}
-	if songCount > 0 {
-		params.Add("songCount", fmt.Sprintf("%d", songCount))
+	if songOffset > 0 {
+		params.Add("songOffset", fmt.Sprintf("%d", songOffset))
 	}
 
 	resp, err := c.makeRequest(ctx, "search3", params)
@@ -555,21 +682,9 @@ func (c *Client) Search(ctx context.Context, query string, artistCount, albumCou
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading search response: %w", err)
-	}
-
 	var searchResp SearchResponse
-	if err := json.Unmarshal(body, &searchResp); err != nil {
-		return nil, fmt.Errorf("parsing search response: %w", err)
-	}
-
-	if searchResp.SubsonicResponse.Status != "ok" {
-		if searchResp.SubsonicResponse.Error != nil {
-			return nil, fmt.Errorf("search error: %s", searchResp.SubsonicResponse.Error.Message)
-		}
-		return nil, fmt.Errorf("search failed with status: %s", searchResp.SubsonicResponse.Status)
+	if err := parseResponse(resp, "search", &searchResp); err != nil {
+		return nil, err
 	}
 
 	return &searchResp, nil
@@ -627,21 +742,9 @@ func (c *Client) GetPlaylists(ctx context.Context) (*PlaylistsResponse, error) {
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading playlists response: %w", err)
-	}
-
 	var playlistsResp PlaylistsResponse
-	if err := json.Unmarshal(body, &playlistsResp); err != nil {
-		return nil, fmt.Errorf("parsing playlists response: %w", err)
-	}
-
-	if playlistsResp.SubsonicResponse.Status != "ok" {
-		if playlistsResp.SubsonicResponse.Error != nil {
-			return nil, fmt.Errorf("playlists error: %s", playlistsResp.SubsonicResponse.Error.Message)
-		}
-		return nil, fmt.Errorf("playlists failed with status: %s", playlistsResp.SubsonicResponse.Status)
+	if err := parseResponse(resp, "playlists", &playlistsResp); err != nil {
+		return nil, err
 	}
 
 	return &playlistsResp, nil
@@ -658,22 +761,222 @@ func (c *Client) GetPlaylistTracks(ctx context.Context, playlistID string) (*Pla
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	var playlistResp PlaylistResponse
+	if err := parseResponse(resp, "playlist tracks", &playlistResp); err != nil {
+		return nil, err
+	}
+
+	return &playlistResp, nil
+}
+
+
+// CreatePlaylist creates a playlist holding songIDs and returns it. Subsonic servers
+// allow several playlists with the same name, so callers check for clashes first.
+func (c *Client) CreatePlaylist(ctx context.Context, name string, songIDs []string) (*Playlist, error) {
+	params := url.Values{}
+	params.Add("name", name)
+	for _, id := range songIDs {
+		params.Add("songId", id)
+	}
+
+	resp, err := c.makeRequest(ctx, "createPlaylist", params)
 	if err != nil {
-		return nil, fmt.Errorf("reading playlist tracks response: %w", err)
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	var playlistResp PlaylistResponse
-	if err := json.Unmarshal(body, &playlistResp); err != nil {
-		return nil, fmt.Errorf("parsing playlist tracks response: %w", err)
+	if err := parseResponse(resp, "create playlist", &playlistResp); err != nil {
+		return nil, err
 	}
+	return &playlistResp.SubsonicResponse.Playlist, nil
+}
 
-	if playlistResp.SubsonicResponse.Status != "ok" {
-		if playlistResp.SubsonicResponse.Error != nil {
-			return nil, fmt.Errorf("playlist tracks error: %s", playlistResp.SubsonicResponse.Error.Message)
-		}
-		return nil, fmt.Errorf("playlist tracks failed with status: %s", playlistResp.SubsonicResponse.Status)
+// UpdatePlaylist appends songs to an existing playlist
+func (c *Client) UpdatePlaylist(ctx context.Context, playlistID string, songIDsToAdd []string) error {
+	params := url.Values{}
+	params.Add("playlistId", playlistID)
+	for _, id := range songIDsToAdd {
+		params.Add("songIdToAdd", id)
 	}
 
-	return &playlistResp, nil
+	resp, err := c.makeRequest(ctx, "updatePlaylist", params)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return parseResponse(resp, "update playlist", nil)
+}
+
+// SetRating rates a song, album or artist from 1 to 5 stars; 0 clears the rating
+func (c *Client) SetRating(ctx context.Context, id string, rating int) error {
+	if rating < 0 || rating > 5 {
+		return fmt.Errorf("rating must be between 0 and 5, got %d", rating)
+	}
+
+	params := url.Values{}
+	params.Add("id", id)
+	params.Add("rating", strconv.Itoa(rating))
+
+	resp, err := c.makeRequest(ctx, "setRating", params)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return parseResponse(resp, "set rating", nil)
+}
+
+// GetNowPlaying retrieves what all users are currently streaming
+func (c *Client) GetNowPlaying(ctx context.Context) (*NowPlayingResponse, error) {
+	params := url.Values{}
+
+	resp, err := c.makeRequest(ctx, "getNowPlaying", params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var nowPlayingResp NowPlayingResponse
+	if err := parseResponse(resp, "now playing", &nowPlayingResp); err != nil {
+		return nil, err
+	}
+
+	return &nowPlayingResp, nil
+}
+
+// CreateShare creates a public share link for the given song, album or playlist IDs.
+// Sharing must be enabled on the server (Navidrome's EnableSharing).
+func (c *Client) CreateShare(ctx context.Context, ids []string) (*Share, error) {
+	params := url.Values{}
+	for _, id := range ids {
+		params.Add("id", id)
+	}
+
+	resp, err := c.makeRequest(ctx, "createShare", params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var shareResp ShareResponse
+	if err := parseResponse(resp, "create share", &shareResp); err != nil {
+		return nil, err
+	}
+
+	shares := shareResp.SubsonicResponse.Shares.Share
+	if len(shares) == 0 || shares[0].URL == "" {
+		return nil, fmt.Errorf("server returned no share link")
+	}
+	return &shares[0], nil
+}
+
+// GetPodcasts lists the podcast channels the server subscribes to, with their
+// episodes when includeEpisodes is set. Servers without podcast support return an
+// error wrapping ErrNotImplemented or an *APIError.
+func (c *Client) GetPodcasts(ctx context.Context, includeEpisodes bool) ([]PodcastChannel, error) {
+	params := url.Values{}
+	params.Add("includeEpisodes", strconv.FormatBool(includeEpisodes))
+
+	resp, err := c.makeRequest(ctx, "getPodcasts", params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var podcastsResp PodcastsResponse
+	if err := parseResponse(resp, "podcasts", &podcastsResp); err != nil {
+		return nil, err
+	}
+	return podcastsResp.SubsonicResponse.Podcasts.Channel, nil
+}
+
+// GetNewestPodcasts returns the most recently published episodes across all channels
+func (c *Client) GetNewestPodcasts(ctx context.Context, count int) ([]PodcastEpisode, error) {
+	params := url.Values{}
+	params.Add("count", strconv.Itoa(count))
+
+	resp, err := c.makeRequest(ctx, "getNewestPodcasts", params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var newestResp NewestPodcastsResponse
+	if err := parseResponse(resp, "newest podcasts", &newestResp); err != nil {
+		return nil, err
+	}
+	return newestResp.SubsonicResponse.NewestPodcasts.Episode, nil
+}
+
+// GetBookmarks returns the user's saved playback positions
+func (c *Client) GetBookmarks(ctx context.Context) ([]Bookmark, error) {
+	resp, err := c.makeRequest(ctx, "getBookmarks", url.Values{})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var bookmarksResp BookmarksResponse
+	if err := parseResponse(resp, "bookmarks", &bookmarksResp); err != nil {
+		return nil, err
+	}
+	return bookmarksResp.SubsonicResponse.Bookmarks.Bookmark, nil
+}
+
+// CreateBookmark saves a playback position in a song or episode, replacing the
+// user's earlier bookmark for it
+func (c *Client) CreateBookmark(ctx context.Context, id string, position time.Duration) error {
+	params := url.Values{}
+	params.Add("id", id)
+	params.Add("position", strconv.FormatInt(position.Milliseconds(), 10))
+
+	resp, err := c.makeRequest(ctx, "createBookmark", params)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return parseResponse(resp, "create bookmark", nil)
+}
+
+// DeleteBookmark removes the user's saved position in a song or episode
+func (c *Client) DeleteBookmark(ctx context.Context, id string) error {
+	params := url.Values{}
+	params.Add("id", id)
+
+	resp, err := c.makeRequest(ctx, "deleteBookmark", params)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return parseResponse(resp, "delete bookmark", nil)
+}
+
+// StartScan asks the server to start a library scan (requires admin rights)
+func (c *Client) StartScan(ctx context.Context) (*ScanStatus, error) {
+	return c.scanRequest(ctx, "startScan")
+}
+
+// GetScanStatus retrieves the progress of the current library scan
+func (c *Client) GetScanStatus(ctx context.Context) (*ScanStatus, error) {
+	return c.scanRequest(ctx, "getScanStatus")
+}
+
+// scanRequest calls a scan endpoint and returns the reported scan status
+func (c *Client) scanRequest(ctx context.Context, endpoint string) (*ScanStatus, error) {
+	resp, err := c.makeRequest(ctx, endpoint, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var scanResp ScanStatusResponse
+	if err := parseResponse(resp, "scan", &scanResp); err != nil {
+		return nil, err
+	}
+
+	return &scanResp.SubsonicResponse.ScanStatus, nil
 }
@@ -0,0 +1,64 @@
+package navidrome
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestGetAlbumTracksPlayOrder checks that a two-disc album the server lists out of
+// order comes back disc by disc, in track order
+func TestGetAlbumTracksPlayOrder(t *testing.T) {
+	// Disc, track pairs in the order the server sends them
+	shuffled := [][2]int{{2, 3}, {1, 2}, {2, 1}, {1, 4}, {1, 1}, {2, 2}, {1, 3}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/getMusicDirectory" {
+			http.NotFound(w, r)
+			return
+		}
+		children := ""
+		for i, song := range shuffled {
+			if i > 0 {
+				children += ","
+			}
+			children += fmt.Sprintf(`{"id":"d%dt%d","title":"Song","discNumber":%d,"track":%d}`, song[0], song[1], song[0], song[1])
+		}
+		fmt.Fprintf(w, `{"subsonic-response":{"status":"ok","directory":{"id":"album","name":"Album","child":[%s]}}}`, children)
+	}))
+	defer server.Close()
+
+	resp, err := NewClient(server.URL, "user", "pass").GetAlbumTracks(context.Background(), "album")
+	if err != nil {
+		t.Fatalf("GetAlbumTracks: %v", err)
+	}
+
+	want := []string{"d1t1", "d1t2", "d1t3", "d1t4", "d2t1", "d2t2", "d2t3"}
+	songs := resp.SubsonicResponse.SongsByGenre.Song
+	if len(songs) != len(want) {
+		t.Fatalf("got %d songs, want %d", len(songs), len(want))
+	}
+	for i, song := range songs {
+		if song.ID != want[i] {
+			t.Errorf("position %d: got %s, want %s", i, song.ID, want[i])
+		}
+	}
+}
+
+func TestSortAlbumSongs(t *testing.T) {
+	songs := []Song{
+		{ID: "untracked", DiscNumber: 1},
+		{ID: "d2t1", DiscNumber: 2, Track: 1},
+		{ID: "nodisc-t2", Track: 2},
+		{ID: "d1t1", DiscNumber: 1, Track: 1},
+	}
+	SortAlbumSongs(songs)
+
+	want := []string{"d1t1", "nodisc-t2", "untracked", "d2t1"}
+	for i, song := range songs {
+		if song.ID != want[i] {
+			t.Errorf("position %d: got %s, want %s", i, song.ID, want[i])
+		}
+	}
+}
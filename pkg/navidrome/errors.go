@@ -0,0 +1,114 @@
+package navidrome
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Subsonic API error codes
+const (
+	ErrorCodeGeneric           = 0  // A generic error
+	ErrorCodeMissingParameter  = 10 // Required parameter is missing
+	ErrorCodeClientTooOld      = 20 // Incompatible REST protocol version, client must upgrade
+	ErrorCodeServerTooOld      = 30 // Incompatible REST protocol version, server must upgrade
+	ErrorCodeWrongCredentials  = 40 // Wrong username or password
+	ErrorCodeTokenNotSupported = 41 // Token authentication not supported for LDAP users
+	ErrorCodeConflictingAuth   = 43 // Multiple conflicting authentication mechanisms (OpenSubsonic)
+	ErrorCodeInvalidAPIKey     = 44 // Invalid API key (OpenSubsonic)
+	ErrorCodeNotAuthorized     = 50 // User is not authorized for the given operation
+	ErrorCodeTrialExpired      = 60 // The trial period for the Subsonic server is over
+	ErrorCodeNotFound          = 70 // The requested data was not found
+)
+
+// ErrNotImplemented is returned for endpoints the server answers with HTTP 501, as
+// Navidrome does for the parts of the Subsonic API it doesn't offer (e.g. podcasts)
+var ErrNotImplemented = errors.New("not implemented by this server")
+
+// APIError is a failed Subsonic response, keeping the numeric code so callers can
+// branch on it (e.g. ErrorCodeNotFound)
+type APIError struct {
+	Code    int
+	Message string
+	Request string // Which call failed, e.g. "albums"
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s error %d: %s", e.Request, e.Code, e.Message)
+}
+
+// IsAPIError reports whether err is or wraps an *APIError with the given code
+func IsAPIError(err error, code int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+// AuthError is returned when the server rejects the client's credentials, so callers
+// can tell an expired or changed password apart from network and server errors.
+// It wraps the underlying *APIError.
+type AuthError struct {
+	APIError
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("authentication rejected (code %d): %s", e.Code, e.Message)
+}
+
+// Unwrap exposes the underlying APIError to errors.As
+func (e *AuthError) Unwrap() error {
+	return &e.APIError
+}
+
+// IsAuthError reports whether err is or wraps an *AuthError
+func IsAuthError(err error) bool {
+	var authErr *AuthError
+	return errors.As(err, &authErr)
+}
+
+// asError converts a Subsonic error for the named request into an *APIError, or an
+// *AuthError for credential failures
+func (e *SubsonicError) asError(request string) error {
+	apiErr := APIError{Code: e.Code, Message: e.Message, Request: request}
+	switch e.Code {
+	case ErrorCodeWrongCredentials, ErrorCodeTokenNotSupported, ErrorCodeConflictingAuth, ErrorCodeInvalidAPIKey:
+		return &AuthError{APIError: apiErr}
+	}
+	return &apiErr
+}
+
+// parseResponse reads a Subsonic JSON response, returning an *APIError when its status
+// is not "ok", and otherwise decodes it into v (if non-nil). request names the call
+// in error messages.
+func parseResponse(resp *http.Response, request string, v interface{}) error {
+	if resp.StatusCode == http.StatusNotImplemented {
+		return fmt.Errorf("%s: %w", request, ErrNotImplemented)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading %s response: %w", request, err)
+	}
+
+	var envelope struct {
+		SubsonicResponse BaseResponse `json:"subsonic-response"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return fmt.Errorf("parsing %s response: %w", request, err)
+	}
+
+	if envelope.SubsonicResponse.Status != "ok" {
+		if envelope.SubsonicResponse.Error != nil {
+			return envelope.SubsonicResponse.Error.asError(request)
+		}
+		return fmt.Errorf("%s failed with status: %s", request, envelope.SubsonicResponse.Status)
+	}
+
+	if v == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("parsing %s response: %w", request, err)
+	}
+	return nil
+}
@@ -27,6 +27,8 @@ type Album struct {
 	Created     time.Time `json:"created"`
 	Year        int       `json:"year,omitempty"`
 	Genre       string    `json:"genre,omitempty"`
+	UserRating  int       `json:"userRating,omitempty"` // 1-5 stars, 0 when unrated
+	MusicBrainzID string  `json:"musicBrainzId,omitempty"` // Release MBID (OpenSubsonic)
 }
 
 // Artist represents an artist from Navidrome
@@ -69,6 +71,27 @@ type Song struct {
 	PlayCount   int       `json:"playCount,omitempty"`
 	DiscNumber  int       `json:"discNumber,omitempty"`
 	Starred     *time.Time `json:"starred,omitempty"`
+	UserRating  int       `json:"userRating,omitempty"` // 1-5 stars, 0 when unrated
+	MusicBrainzID string  `json:"musicBrainzId,omitempty"` // Recording MBID (OpenSubsonic)
+	Artists     []ArtistRef `json:"artists,omitempty"`     // Every credited artist (OpenSubsonic)
+}
+
+// ArtistRef is an artist credited on a song
+type ArtistRef struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	MusicBrainzID string `json:"musicBrainzId,omitempty"`
+}
+
+// ArtistMBIDs returns the MusicBrainz IDs of the song's credited artists, skipping untagged ones
+func (s Song) ArtistMBIDs() []string {
+	var ids []string
+	for _, artist := range s.Artists {
+		if artist.MusicBrainzID != "" {
+			ids = append(ids, artist.MusicBrainzID)
+		}
+	}
+	return ids
 }
 
 // Playlist represents a playlist from Navidrome
@@ -174,6 +197,132 @@ type RandomSongsResponse struct {
 	} `json:"subsonic-response"`
 }
 
+// NowPlayingEntry represents a song currently being streamed by a user
+type NowPlayingEntry struct {
+	Song
+	Username   string `json:"username"`
+	MinutesAgo int    `json:"minutesAgo"`
+	PlayerID   int    `json:"playerId"`
+	PlayerName string `json:"playerName,omitempty"`
+}
+
+// NowPlayingResponse represents the response from getNowPlaying
+type NowPlayingResponse struct {
+	SubsonicResponse struct {
+		BaseResponse
+		NowPlaying struct {
+			Entry []NowPlayingEntry `json:"entry,omitempty"`
+		} `json:"nowPlaying"`
+	} `json:"subsonic-response"`
+}
+
+// Share is a public link to songs, albums or playlists
+type Share struct {
+	ID          string `json:"id"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+	Expires     string `json:"expires,omitempty"`
+}
+
+// ShareResponse represents the response from createShare
+type ShareResponse struct {
+	SubsonicResponse struct {
+		BaseResponse
+		Shares struct {
+			Share []Share `json:"share,omitempty"`
+		} `json:"shares"`
+	} `json:"subsonic-response"`
+}
+
+// ArtistInfoResponse represents the response from getArtistInfo2
+type ArtistInfoResponse struct {
+	SubsonicResponse struct {
+		BaseResponse
+		ArtistInfo2 struct {
+			SimilarArtist []Artist `json:"similarArtist,omitempty"`
+		} `json:"artistInfo2"`
+	} `json:"subsonic-response"`
+}
+
+// TopSongsResponse represents the response from getTopSongs
+type TopSongsResponse struct {
+	SubsonicResponse struct {
+		BaseResponse
+		TopSongs SongsList `json:"topSongs"`
+	} `json:"subsonic-response"`
+}
+
+// MusicFolder is a top-level library folder configured on the server
+type MusicFolder struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// MusicFoldersResponse represents the response from getMusicFolders
+type MusicFoldersResponse struct {
+	SubsonicResponse struct {
+		BaseResponse
+		MusicFolders struct {
+			MusicFolder []MusicFolder `json:"musicFolder,omitempty"`
+		} `json:"musicFolders"`
+	} `json:"subsonic-response"`
+}
+
+// Index is one letter of the folder index; its "artists" are the top-level
+// directories of the music folders
+type Index struct {
+	Name   string   `json:"name"`
+	Artist []Artist `json:"artist,omitempty"`
+}
+
+// Indexes is the top level of the folder tree: directories grouped by letter, and
+// any files sitting directly in the music folders
+type Indexes struct {
+	Index []Index `json:"index,omitempty"`
+	Child []Song  `json:"child,omitempty"`
+}
+
+// IndexesResponse represents the response from getIndexes
+type IndexesResponse struct {
+	SubsonicResponse struct {
+		BaseResponse
+		Indexes Indexes `json:"indexes"`
+	} `json:"subsonic-response"`
+}
+
+// Directory is a folder on the server; children with IsDir set are subdirectories,
+// the rest are songs
+type Directory struct {
+	ID     string `json:"id"`
+	Parent string `json:"parent,omitempty"`
+	Name   string `json:"name"`
+	Child  []Song `json:"child,omitempty"`
+}
+
+// DirectoryResponse represents the response from getMusicDirectory
+type DirectoryResponse struct {
+	SubsonicResponse struct {
+		BaseResponse
+		Directory Directory `json:"directory"`
+	} `json:"subsonic-response"`
+}
+
+// ScanStatus represents the state of a library scan
+type ScanStatus struct {
+	Scanning    bool   `json:"scanning"`
+	Count       int64  `json:"count"`
+	FolderCount int64  `json:"folderCount,omitempty"`
+	LastScan    string `json:"lastScan,omitempty"`
+}
+
+// ScanStatusResponse represents the response from startScan and getScanStatus
+type ScanStatusResponse struct {
+	SubsonicResponse struct {
+		BaseResponse
+		ScanStatus ScanStatus `json:"scanStatus"`
+	} `json:"subsonic-response"`
+}
+
 // User represents a user from Navidrome
 type User struct {
 	Username             string `json:"username"`
@@ -208,3 +357,71 @@ type ScrobblingCapabilities struct {
     UserScrobblingEnabled     bool
     SupportedServices         []string
 }
+
+// PodcastEpisode is one episode of a podcast channel. Only episodes the server has
+// downloaded (Status "completed") can be streamed, using StreamID.
+type PodcastEpisode struct {
+	ID          string `json:"id"`
+	StreamID    string `json:"streamId,omitempty"`
+	ChannelID   string `json:"channelId"`
+	Title       string `json:"title"`
+	Artist      string `json:"artist,omitempty"`
+	Album       string `json:"album,omitempty"`
+	Description string `json:"description,omitempty"`
+	PublishDate string `json:"publishDate,omitempty"` // ISO 8601
+	Status      string `json:"status"`                // "new", "downloading", "completed", "error", "deleted" or "skipped"
+	Duration    int    `json:"duration,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+	Suffix      string `json:"suffix,omitempty"`
+	BitRate     int    `json:"bitRate,omitempty"`
+	CoverArt    string `json:"coverArt,omitempty"`
+}
+
+// PodcastChannel is a podcast the server subscribes to
+type PodcastChannel struct {
+	ID          string           `json:"id"`
+	URL         string           `json:"url"`
+	Title       string           `json:"title"`
+	Description string           `json:"description,omitempty"`
+	CoverArt    string           `json:"coverArt,omitempty"`
+	Status      string           `json:"status"`
+	Episode     []PodcastEpisode `json:"episode,omitempty"`
+}
+
+// PodcastsResponse represents the response from getPodcasts
+type PodcastsResponse struct {
+	SubsonicResponse struct {
+		BaseResponse
+		Podcasts struct {
+			Channel []PodcastChannel `json:"channel,omitempty"`
+		} `json:"podcasts"`
+	} `json:"subsonic-response"`
+}
+
+// NewestPodcastsResponse represents the response from getNewestPodcasts
+type NewestPodcastsResponse struct {
+	SubsonicResponse struct {
+		BaseResponse
+		NewestPodcasts struct {
+			Episode []PodcastEpisode `json:"episode,omitempty"`
+		} `json:"newestPodcasts"`
+	} `json:"subsonic-response"`
+}
+
+// Bookmark is a saved playback position in a song or podcast episode
+type Bookmark struct {
+	Position int64  `json:"position"` // Milliseconds
+	Username string `json:"username"`
+	Comment  string `json:"comment,omitempty"`
+	Entry    Song   `json:"entry"`
+}
+
+// BookmarksResponse represents the response from getBookmarks
+type BookmarksResponse struct {
+	SubsonicResponse struct {
+		BaseResponse
+		Bookmarks struct {
+			Bookmark []Bookmark `json:"bookmark,omitempty"`
+		} `json:"bookmarks"`
+	} `json:"subsonic-response"`
+}
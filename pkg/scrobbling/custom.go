@@ -0,0 +1,167 @@
+package scrobbling
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultCustomPayload is Maloja's newscrobble format, used when no payload is configured
+const DefaultCustomPayload = `{"artist": {artist}, "title": {title}, "album": {album}, "duration": {duration}, "time": {timestamp}, "key": {api_key}}`
+
+// CustomScrobbler POSTs a JSON payload built from a template to a user-specified
+// endpoint, for self-hosted services such as Maloja
+type CustomScrobbler struct {
+	name          string
+	url           string
+	nowPlayingURL string
+	apiKey        string
+	payload       string
+	httpClient    *http.Client
+}
+
+// NewCustomScrobbler creates a custom endpoint scrobbler. An empty name or
+// payload falls back to "Custom" and DefaultCustomPayload.
+func NewCustomScrobbler(name, scrobbleURL, nowPlayingURL, apiKey, payload string) *CustomScrobbler {
+	if name == "" {
+		name = "Custom"
+	}
+	if strings.TrimSpace(payload) == "" {
+		payload = DefaultCustomPayload
+	}
+	return &CustomScrobbler{
+		name:          name,
+		url:           scrobbleURL,
+		nowPlayingURL: nowPlayingURL,
+		apiKey:        apiKey,
+		payload:       payload,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// SetTimeout sets the HTTP client timeout
+func (c *CustomScrobbler) SetTimeout(timeout time.Duration) {
+	c.httpClient.Timeout = timeout
+}
+
+// Name returns the configured service name
+func (c *CustomScrobbler) Name() string {
+	return c.name
+}
+
+// SubmitScrobble posts a completed track play to the scrobble URL
+func (c *CustomScrobbler) SubmitScrobble(ctx context.Context, track ScrobbleTrack) error {
+	return c.post(ctx, c.url, track)
+}
+
+// UpdateNowPlaying posts to the now playing URL, or does nothing if none is set
+func (c *CustomScrobbler) UpdateNowPlaying(ctx context.Context, track ScrobbleTrack) error {
+	if c.nowPlayingURL == "" {
+		return nil
+	}
+	return c.post(ctx, c.nowPlayingURL, track)
+}
+
+// TestConnection checks the URL and payload template and that the server
+// answers. The API key is only checked when a scrobble is actually sent.
+func (c *CustomScrobbler) TestConnection(ctx context.Context) error {
+	u, err := url.Parse(c.url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an http:// or https:// address")
+	}
+	if _, err := c.render(ScrobbleTrack{}); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.Scheme+"://"+u.Host, nil)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("User-Agent", "navitone-cli/1.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("server unreachable: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// render fills the payload template. Placeholders are replaced with JSON
+// values, so they go in the template unquoted.
+func (c *CustomScrobbler) render(track ScrobbleTrack) ([]byte, error) {
+	artistMBIDs := track.ArtistMBIDs
+	if artistMBIDs == nil {
+		artistMBIDs = []string{}
+	}
+	values := map[string]interface{}{
+		"{artist}":         track.Artist,
+		"{title}":          track.Title,
+		"{album}":          track.Album,
+		"{duration}":       track.Duration,
+		"{timestamp}":      track.Timestamp,
+		"{track_number}":   track.TrackNumber,
+		"{recording_mbid}": track.RecordingMBID,
+		"{release_mbid}":   track.ReleaseMBID,
+		"{artist_mbids}":   artistMBIDs,
+		"{api_key}":        c.apiKey,
+	}
+
+	var pairs []string
+	for placeholder, value := range values {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("encoding %s: %w", placeholder, err)
+		}
+		pairs = append(pairs, placeholder, string(encoded))
+	}
+	body := []byte(strings.NewReplacer(pairs...).Replace(c.payload))
+
+	if !json.Valid(body) {
+		return nil, fmt.Errorf("payload is not valid JSON once placeholders are filled in")
+	}
+	return body, nil
+}
+
+// post sends the rendered payload to endpoint
+func (c *CustomScrobbler) post(ctx context.Context, endpoint string, track ScrobbleTrack) error {
+	body, err := c.render(track)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "navitone-cli/1.0")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Token "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("submission request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if len(msg) > 0 {
+			return fmt.Errorf("submission failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+		}
+		return fmt.Errorf("submission failed with status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/md5"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,19 +12,44 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 const LastFMAPIURL = "https://ws.audioscrobbler.com/2.0/"
 
+// Last.fm API error codes worth telling apart
+const (
+	LastFMErrAuthFailed     = 4  // Wrong username or password
+	LastFMErrInvalidAPIKey  = 10 // API key not recognised
+	LastFMErrServiceOffline = 11
+	LastFMErrUnavailable    = 16 // Temporary error, try again
+	LastFMErrSuspendedKey   = 26
+	LastFMErrRateLimited    = 29
+)
+
+// LastFMError is an error reported by the Last.fm API
+type LastFMError struct {
+	Code    int
+	Message string
+}
+
+func (e *LastFMError) Error() string {
+	return fmt.Sprintf("Last.fm error %d: %s", e.Code, e.Message)
+}
+
 // LastFMClient handles submissions to Last.fm
 type LastFMClient struct {
 	apiKey     string
 	secret     string
 	username   string
 	password   string
-	sessionKey string
 	httpClient *http.Client
+
+	// Scrobbles, now playing updates and retries share the client, so the session
+	// key is only touched under mu
+	mu         sync.Mutex
+	sessionKey string
 }
 
 // NewLastFMClient creates a new Last.fm client
@@ -44,6 +70,55 @@ func (c *LastFMClient) SetTimeout(timeout time.Duration) {
 	c.httpClient.Timeout = timeout
 }
 
+// Name returns the service name
+func (c *LastFMClient) Name() string {
+	return "Last.fm"
+}
+
+// session returns the current session key, "" before authenticating
+func (c *LastFMClient) session() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sessionKey
+}
+
+// ensureSession returns the session key, authenticating on first use so callers
+// don't have to
+func (c *LastFMClient) ensureSession(ctx context.Context) (string, error) {
+	if key := c.session(); key != "" {
+		return key, nil
+	}
+	if err := c.Authenticate(ctx); err != nil {
+		return "", fmt.Errorf("authentication failed: %w", err)
+	}
+	return c.session(), nil
+}
+
+// TestConnection logs in with the configured username and password
+func (c *LastFMClient) TestConnection(ctx context.Context) error {
+	switch {
+	case c.apiKey == "" || c.secret == "":
+		return fmt.Errorf("api_key and secret are missing from [scrobbling.lastfm] in config.toml")
+	case c.username == "" || c.password == "":
+		return fmt.Errorf("username and password are required")
+	}
+
+	err := c.Authenticate(ctx)
+	var apiErr *LastFMError
+	if err == nil || !errors.As(err, &apiErr) {
+		return err
+	}
+	switch apiErr.Code {
+	case LastFMErrAuthFailed:
+		return fmt.Errorf("wrong username or password")
+	case LastFMErrInvalidAPIKey, LastFMErrSuspendedKey:
+		return fmt.Errorf("API key rejected - check api_key and secret")
+	case LastFMErrServiceOffline, LastFMErrUnavailable, LastFMErrRateLimited:
+		return fmt.Errorf("Last.fm is unavailable right now - try again later")
+	}
+	return apiErr
+}
+
 // Authenticate performs authentication with Last.fm to get a session key
 func (c *LastFMClient) Authenticate(ctx context.Context) error {
 	// Get auth token first
@@ -58,7 +133,9 @@ func (c *LastFMClient) Authenticate(ctx context.Context) error {
 		return fmt.Errorf("getting session key: %w", err)
 	}
 
+	c.mu.Lock()
 	c.sessionKey = sessionKey
+	c.mu.Unlock()
 	return nil
 }
 
@@ -85,7 +162,7 @@ func (c *LastFMClient) getAuthToken(ctx context.Context) (string, error) {
 	}
 
 	if tokenResp.Error != 0 {
-		return "", fmt.Errorf("Last.fm error %d: %s", tokenResp.Error, tokenResp.Message)
+		return "", &LastFMError{Code: tokenResp.Error, Message: tokenResp.Message}
 	}
 
 	return tokenResp.Token, nil
@@ -122,22 +199,23 @@ func (c *LastFMClient) getMobileSession(ctx context.Context, token string) (stri
 	}
 
 	if sessionResp.Error != 0 {
-		return "", fmt.Errorf("Last.fm error %d: %s", sessionResp.Error, sessionResp.Message)
+		return "", &LastFMError{Code: sessionResp.Error, Message: sessionResp.Message}
 	}
 
 	return sessionResp.Session.Key, nil
 }
 
-// Scrobble submits a completed track play to Last.fm
-func (c *LastFMClient) Scrobble(ctx context.Context, track ScrobbleTrack) error {
-	if c.sessionKey == "" {
-		return fmt.Errorf("not authenticated - call Authenticate() first")
+// SubmitScrobble submits a completed track play to Last.fm
+func (c *LastFMClient) SubmitScrobble(ctx context.Context, track ScrobbleTrack) error {
+	sessionKey, err := c.ensureSession(ctx)
+	if err != nil {
+		return err
 	}
 
 	params := map[string]string{
 		"method":    "track.scrobble",
 		"api_key":   c.apiKey,
-		"sk":        c.sessionKey,
+		"sk":        sessionKey,
 		"artist":    track.Artist,
 		"track":     track.Title,
 		"timestamp": strconv.FormatInt(track.Timestamp, 10),
@@ -152,24 +230,25 @@ func (c *LastFMClient) Scrobble(ctx context.Context, track ScrobbleTrack) error
 	if track.TrackNumber > 0 {
 		params["trackNumber"] = strconv.Itoa(track.TrackNumber)
 	}
-	if track.MBID != "" {
-		params["mbid"] = track.MBID
+	if track.RecordingMBID != "" {
+		params["mbid"] = track.RecordingMBID
 	}
 
-	_, err := c.makeRequest(ctx, params, true)
+	_, err = c.makeRequest(ctx, params, true)
 	return err
 }
 
 // UpdateNowPlaying updates the "now playing" status on Last.fm
 func (c *LastFMClient) UpdateNowPlaying(ctx context.Context, track ScrobbleTrack) error {
-	if c.sessionKey == "" {
-		return fmt.Errorf("not authenticated - call Authenticate() first")
+	sessionKey, err := c.ensureSession(ctx)
+	if err != nil {
+		return err
 	}
 
 	params := map[string]string{
 		"method":  "track.updateNowPlaying",
 		"api_key": c.apiKey,
-		"sk":      c.sessionKey,
+		"sk":      sessionKey,
 		"artist":  track.Artist,
 		"track":   track.Title,
 	}
@@ -183,24 +262,25 @@ func (c *LastFMClient) UpdateNowPlaying(ctx context.Context, track ScrobbleTrack
 	if track.TrackNumber > 0 {
 		params["trackNumber"] = strconv.Itoa(track.TrackNumber)
 	}
-	if track.MBID != "" {
-		params["mbid"] = track.MBID
+	if track.RecordingMBID != "" {
+		params["mbid"] = track.RecordingMBID
 	}
 
-	_, err := c.makeRequest(ctx, params, true)
+	_, err = c.makeRequest(ctx, params, true)
 	return err
 }
 
 // GetUserInfo gets information about the authenticated user (for testing)
 func (c *LastFMClient) GetUserInfo(ctx context.Context) (*UserInfo, error) {
-	if c.sessionKey == "" {
+	sessionKey := c.session()
+	if sessionKey == "" {
 		return nil, fmt.Errorf("not authenticated - call Authenticate() first")
 	}
 
 	params := map[string]string{
 		"method":  "user.getInfo",
 		"api_key": c.apiKey,
-		"sk":      c.sessionKey,
+		"sk":      sessionKey,
 		"user":    c.username,
 	}
 
@@ -220,7 +300,7 @@ func (c *LastFMClient) GetUserInfo(ctx context.Context) (*UserInfo, error) {
 	}
 
 	if userResp.Error != 0 {
-		return nil, fmt.Errorf("Last.fm error %d: %s", userResp.Error, userResp.Message)
+		return nil, &LastFMError{Code: userResp.Error, Message: userResp.Message}
 	}
 
 	return &userResp.User, nil
@@ -262,6 +342,14 @@ func (c *LastFMClient) makeRequest(ctx context.Context, params map[string]string
 	}
 
 	if resp.StatusCode != http.StatusOK {
+		// Auth failures come back as 403 with the usual error body
+		var errResp struct {
+			Error   int    `json:"error"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &errResp) == nil && errResp.Error != 0 {
+			return nil, &LastFMError{Code: errResp.Error, Message: errResp.Message}
+		}
 		return nil, fmt.Errorf("request failed with status: %d, body: %s", resp.StatusCode, string(body))
 	}
 
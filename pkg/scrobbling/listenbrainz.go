@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -11,6 +12,9 @@ import (
 
 const ListenBrainzAPIURL = "https://api.listenbrainz.org"
 
+// ErrInvalidToken is returned by ValidateToken when ListenBrainz rejects the user token
+var ErrInvalidToken = errors.New("invalid ListenBrainz token")
+
 // ListenBrainzClient handles submissions to ListenBrainz
 type ListenBrainzClient struct {
 	token      string
@@ -32,6 +36,68 @@ func (c *ListenBrainzClient) SetTimeout(timeout time.Duration) {
 	c.httpClient.Timeout = timeout
 }
 
+// Name returns the service name
+func (c *ListenBrainzClient) Name() string {
+	return "ListenBrainz"
+}
+
+// SubmitScrobble submits a completed track play as a single listen
+func (c *ListenBrainzClient) SubmitScrobble(ctx context.Context, track ScrobbleTrack) error {
+	return c.SubmitListen(ctx, Listen{
+		ListenedAt:    int(track.Timestamp),
+		TrackMetadata: trackMetadata(track),
+	})
+}
+
+// UpdateNowPlaying submits the track as playing now
+func (c *ListenBrainzClient) UpdateNowPlaying(ctx context.Context, track ScrobbleTrack) error {
+	return c.SubmitPlayingNow(ctx, trackMetadata(track))
+}
+
+// TestConnection validates the user token
+func (c *ListenBrainzClient) TestConnection(ctx context.Context) error {
+	if c.token == "" {
+		return fmt.Errorf("user token is required")
+	}
+	err := c.ValidateToken(ctx)
+	if errors.Is(err, ErrInvalidToken) {
+		return fmt.Errorf("token rejected - copy it again from listenbrainz.org/settings")
+	}
+	return err
+}
+
+// trackMetadata converts a ScrobbleTrack to ListenBrainz metadata
+func trackMetadata(track ScrobbleTrack) TrackMetadata {
+	metadata := TrackMetadata{
+		ArtistName:  track.Artist,
+		TrackName:   track.Title,
+		ReleaseName: track.Album,
+		AdditionalInfo: map[string]interface{}{
+			"duration_ms": track.Duration * 1000,
+		},
+	}
+
+	if track.TrackNumber > 0 {
+		metadata.AdditionalInfo["tracknumber"] = track.TrackNumber
+	}
+	addMBIDs(metadata.AdditionalInfo, track)
+	return metadata
+}
+
+// addMBIDs adds the track's MusicBrainz IDs to a ListenBrainz additional_info map,
+// leaving out the ones the library isn't tagged with
+func addMBIDs(info map[string]interface{}, track ScrobbleTrack) {
+	if track.RecordingMBID != "" {
+		info["recording_mbid"] = track.RecordingMBID
+	}
+	if track.ReleaseMBID != "" {
+		info["release_mbid"] = track.ReleaseMBID
+	}
+	if len(track.ArtistMBIDs) > 0 {
+		info["artist_mbids"] = track.ArtistMBIDs
+	}
+}
+
 // Listen represents a single listening event
 type Listen struct {
 	ListenedAt    int                    `json:"listened_at"`
@@ -145,11 +211,14 @@ func (c *ListenBrainzClient) ValidateToken(ctx context.Context) error {
 		}
 		
 		if !result.Valid {
-			return fmt.Errorf("token is invalid: %s", result.Message)
+			return fmt.Errorf("%w: %s", ErrInvalidToken, result.Message)
 		}
 		
 		return nil
 	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		return ErrInvalidToken
+	}
 
 	return fmt.Errorf("token validation failed with status: %d", resp.StatusCode)
 }
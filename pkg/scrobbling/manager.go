@@ -14,8 +14,7 @@ import (
 // Manager handles scrobbling to multiple services
 type Manager struct {
     config         *config.Config
-    lastfm         *LastFMClient
-    listenbrainz   *ListenBrainzClient
+    scrobblers     []Scrobbler
     queuedScrobbles []QueuedScrobble
     mutex          sync.RWMutex
     ctx            context.Context
@@ -35,32 +34,63 @@ func NewManager(cfg *config.Config) *Manager {
         cancel:          cancel,
     }
 
+    manager.applyConfig(cfg)
+
+    // Start retry worker
+    go manager.retryWorker()
+
+    return manager
+}
+
+// Reconfigure rebuilds the scrobbling method and service clients from cfg so
+// changes saved at runtime take effect without restarting
+func (m *Manager) Reconfigure(cfg *config.Config) {
+    m.mutex.Lock()
+    defer m.mutex.Unlock()
+    m.applyConfig(cfg)
+}
+
+// applyConfig sets method and clients from cfg; caller must hold the lock
+// or own the manager exclusively
+func (m *Manager) applyConfig(cfg *config.Config) {
+    m.config = cfg
+
     // Determine method from config, default to auto
     switch ScrobblingMethod(cfg.Scrobbling.Method) {
     case MethodServer, MethodClient, MethodDisabled, MethodAuto:
-        manager.method = ScrobblingMethod(cfg.Scrobbling.Method)
+        m.method = ScrobblingMethod(cfg.Scrobbling.Method)
     default:
-        manager.method = MethodAuto
+        m.method = MethodAuto
     }
 
-	// Initialize clients if enabled
-	if cfg.Scrobbling.LastFM.Enabled {
-		manager.lastfm = NewLastFMClient(
-			cfg.Scrobbling.LastFM.APIKey,
-			cfg.Scrobbling.LastFM.Secret,
-			cfg.Scrobbling.LastFM.Username,
-			cfg.Scrobbling.LastFM.Password,
-		)
-	}
+	m.scrobblers = buildScrobblers(cfg)
+}
 
-	if cfg.Scrobbling.ListenBrainz.Enabled {
-		manager.listenbrainz = NewListenBrainzClient(cfg.Scrobbling.ListenBrainz.Token)
+// buildScrobblers creates a client for each client-side service enabled in cfg
+func buildScrobblers(cfg *config.Config) []Scrobbler {
+	var scrobblers []Scrobbler
+	sc := cfg.Scrobbling
+	if sc.LastFM.Enabled {
+		scrobblers = append(scrobblers, NewLastFMClient(
+			sc.LastFM.APIKey,
+			sc.LastFM.Secret,
+			sc.LastFM.Username,
+			sc.LastFM.Password,
+		))
 	}
-
-    // Start retry worker
-    go manager.retryWorker()
-
-    return manager
+	if sc.ListenBrainz.Enabled {
+		scrobblers = append(scrobblers, NewListenBrainzClient(sc.ListenBrainz.Token))
+	}
+	if sc.Custom.Enabled {
+		scrobblers = append(scrobblers, NewCustomScrobbler(
+			sc.Custom.Name,
+			sc.Custom.URL,
+			sc.Custom.NowPlayingURL,
+			sc.Custom.APIKey,
+			sc.Custom.Payload,
+		))
+	}
+	return scrobblers
 }
 
 // AttachNavidromeClient allows server-side scrobbling via Navidrome
@@ -78,41 +108,12 @@ func (m *Manager) Close() {
 // Scrobble submits a scrobble to all enabled services
 func (m *Manager) Scrobble(track ScrobbleTrack) []ScrobbleResult {
     // Client-side scrobbling only; server routing handled by SubmitScrobble
-    var results []ScrobbleResult
-    var wg sync.WaitGroup
+	results := m.each(func(s Scrobbler) ScrobbleResult {
+		return m.scrobbleTo(s, track)
+	})
 
-	resultsChan := make(chan ScrobbleResult, 2)
-
-	// Scrobble to Last.fm
-	if m.lastfm != nil {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			result := m.scrobbleLastFM(track)
-			resultsChan <- result
-		}()
-	}
-
-	// Scrobble to ListenBrainz
-	if m.listenbrainz != nil {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			result := m.scrobbleListenBrainz(track)
-			resultsChan <- result
-		}()
-	}
-
-	// Wait for all scrobbles and collect results
-	go func() {
-		wg.Wait()
-		close(resultsChan)
-	}()
-
-	for result := range resultsChan {
-		results = append(results, result)
-		
-		// Queue failed scrobbles for retry
+	// Queue failed scrobbles for retry
+	for _, result := range results {
 		if !result.Success {
 			m.queueForRetry(result.Track, result.Service)
 		}
@@ -124,41 +125,43 @@ func (m *Manager) Scrobble(track ScrobbleTrack) []ScrobbleResult {
 // UpdateNowPlaying updates now playing status on all enabled services
 func (m *Manager) UpdateNowPlaying(track ScrobbleTrack) []ScrobbleResult {
     // Client-side now playing only; server routing handled by NowPlaying
-    var results []ScrobbleResult
-    var wg sync.WaitGroup
-
-	resultsChan := make(chan ScrobbleResult, 2)
+	return m.each(func(s Scrobbler) ScrobbleResult {
+		result := ScrobbleResult{
+			Service:   s.Name() + " (Now Playing)",
+			Track:     track,
+			Timestamp: time.Now().Unix(),
+		}
+		if err := s.UpdateNowPlaying(m.ctx, track); err != nil {
+			result.Error = err
+			return result
+		}
+		result.Success = true
+		return result
+	})
+}
 
-	// Update Last.fm
-	if m.lastfm != nil {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			result := m.updateNowPlayingLastFM(track)
-			resultsChan <- result
-		}()
-	}
+// each runs fn against every enabled scrobbler concurrently and collects the results
+func (m *Manager) each(fn func(Scrobbler) ScrobbleResult) []ScrobbleResult {
+	m.mutex.RLock()
+	scrobblers := m.scrobblers
+	m.mutex.RUnlock()
 
-	// Update ListenBrainz
-	if m.listenbrainz != nil {
+	var wg sync.WaitGroup
+	resultsChan := make(chan ScrobbleResult, len(scrobblers))
+	for _, s := range scrobblers {
 		wg.Add(1)
-		go func() {
+		go func(s Scrobbler) {
 			defer wg.Done()
-			result := m.updateNowPlayingListenBrainz(track)
-			resultsChan <- result
-		}()
+			resultsChan <- fn(s)
+		}(s)
 	}
+	wg.Wait()
+	close(resultsChan)
 
-	// Wait for all updates and collect results
-	go func() {
-		wg.Wait()
-		close(resultsChan)
-	}()
-
+	var results []ScrobbleResult
 	for result := range resultsChan {
 		results = append(results, result)
 	}
-
 	return results
 }
 
@@ -242,117 +245,15 @@ func (m *Manager) SubmitScrobble(songID string, track ScrobbleTrack) []ScrobbleR
     return m.Scrobble(track)
 }
 
-// scrobbleLastFM handles Last.fm scrobbling
-func (m *Manager) scrobbleLastFM(track ScrobbleTrack) ScrobbleResult {
+// scrobbleTo submits a completed play to one scrobbler
+func (m *Manager) scrobbleTo(s Scrobbler, track ScrobbleTrack) ScrobbleResult {
 	result := ScrobbleResult{
-		Service:   "Last.fm",
+		Service:   s.Name(),
 		Track:     track,
 		Timestamp: time.Now().Unix(),
 	}
 
-	// Authenticate if needed
-	if m.lastfm.sessionKey == "" {
-		if err := m.lastfm.Authenticate(m.ctx); err != nil {
-			result.Error = fmt.Errorf("authentication failed: %w", err)
-			return result
-		}
-	}
-
-	// Submit scrobble
-	if err := m.lastfm.Scrobble(m.ctx, track); err != nil {
-		result.Error = err
-		return result
-	}
-
-	result.Success = true
-	return result
-}
-
-// scrobbleListenBrainz handles ListenBrainz scrobbling
-func (m *Manager) scrobbleListenBrainz(track ScrobbleTrack) ScrobbleResult {
-	result := ScrobbleResult{
-		Service:   "ListenBrainz",
-		Track:     track,
-		Timestamp: time.Now().Unix(),
-	}
-
-	// Convert to ListenBrainz format
-	listen := Listen{
-		ListenedAt: int(track.Timestamp),
-		TrackMetadata: TrackMetadata{
-			ArtistName:  track.Artist,
-			TrackName:   track.Title,
-			ReleaseName: track.Album,
-			AdditionalInfo: map[string]interface{}{
-				"duration_ms": track.Duration * 1000,
-			},
-		},
-	}
-
-	if track.TrackNumber > 0 {
-		listen.TrackMetadata.AdditionalInfo["tracknumber"] = track.TrackNumber
-	}
-
-	// Submit listen
-	if err := m.listenbrainz.SubmitListen(m.ctx, listen); err != nil {
-		result.Error = err
-		return result
-	}
-
-	result.Success = true
-	return result
-}
-
-// updateNowPlayingLastFM handles Last.fm now playing updates
-func (m *Manager) updateNowPlayingLastFM(track ScrobbleTrack) ScrobbleResult {
-	result := ScrobbleResult{
-		Service:   "Last.fm (Now Playing)",
-		Track:     track,
-		Timestamp: time.Now().Unix(),
-	}
-
-	// Authenticate if needed
-	if m.lastfm.sessionKey == "" {
-		if err := m.lastfm.Authenticate(m.ctx); err != nil {
-			result.Error = fmt.Errorf("authentication failed: %w", err)
-			return result
-		}
-	}
-
-	// Update now playing
-	if err := m.lastfm.UpdateNowPlaying(m.ctx, track); err != nil {
-		result.Error = err
-		return result
-	}
-
-	result.Success = true
-	return result
-}
-
-// updateNowPlayingListenBrainz handles ListenBrainz playing now updates
-func (m *Manager) updateNowPlayingListenBrainz(track ScrobbleTrack) ScrobbleResult {
-	result := ScrobbleResult{
-		Service:   "ListenBrainz (Playing Now)",
-		Track:     track,
-		Timestamp: time.Now().Unix(),
-	}
-
-	// Convert to ListenBrainz format
-	metadata := TrackMetadata{
-		ArtistName:  track.Artist,
-		TrackName:   track.Title,
-		ReleaseName: track.Album,
-		AdditionalInfo: map[string]interface{}{
-			"duration_ms": track.Duration * 1000,
-		},
-	}
-
-	if track.TrackNumber > 0 {
-		metadata.AdditionalInfo["tracknumber"] = track.TrackNumber
-	}
-
-	// Submit playing now
-	if err := m.listenbrainz.SubmitPlayingNow(m.ctx, metadata); err != nil {
+	if err := s.SubmitScrobble(m.ctx, track); err != nil {
 		result.Error = err
 		return result
 	}
@@ -413,13 +314,13 @@ func (m *Manager) retryQueuedScrobbles() {
 			continue
 		}
 
-		// Attempt retry
-		var result ScrobbleResult
-		switch queued.Service {
-		case "Last.fm":
-			result = m.scrobbleLastFM(queued.Track)
-		case "ListenBrainz":
-			result = m.scrobbleListenBrainz(queued.Track)
+		// Attempt retry with the same service, unless it has since been disabled
+		result := ScrobbleResult{Error: fmt.Errorf("%s not configured", queued.Service)}
+		for _, s := range m.scrobblers {
+			if s.Name() == queued.Service {
+				result = m.scrobbleTo(s, queued.Track)
+				break
+			}
 		}
 
 		if result.Success {
@@ -456,3 +357,23 @@ func (m *Manager) GetQueueStats() (int, int) {
 	
 	return total, failed
 }
+
+// TestConnections checks the credentials of each client-side service enabled in
+// the current config, including edits not yet saved, without touching the
+// clients used for scrobbling
+func (m *Manager) TestConnections(ctx context.Context) []ServiceStatus {
+	m.mutex.RLock()
+	cfg := m.config
+	m.mutex.RUnlock()
+
+	var statuses []ServiceStatus
+	for _, s := range buildScrobblers(cfg) {
+		status := ServiceStatus{Service: s.Name(), OK: true, Message: "connected"}
+		if err := s.TestConnection(ctx); err != nil {
+			status.OK = false
+			status.Message = err.Error()
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
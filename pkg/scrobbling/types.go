@@ -1,5 +1,7 @@
 package scrobbling
 
+import "context"
+
 // ScrobbleTrack represents a track for scrobbling
 type ScrobbleTrack struct {
 	Artist      string // Required
@@ -8,7 +10,11 @@ type ScrobbleTrack struct {
 	Duration    int    // Duration in seconds
 	TrackNumber int    // Track number on album
 	Timestamp   int64  // Unix timestamp when track was played
-	MBID        string // MusicBrainz ID (optional)
+
+	// MusicBrainz IDs (optional); omitted from submissions when empty
+	RecordingMBID string
+	ReleaseMBID   string
+	ArtistMBIDs   []string
 }
 
 // ScrobblingMethod selects how scrobbling should be performed
@@ -32,19 +38,21 @@ type UserInfo struct {
 	} `json:"registered"`
 }
 
-// ScrobbleService defines the interface for scrobbling services
-type ScrobbleService interface {
-	// Scrobble submits a completed track play
-	Scrobble(track ScrobbleTrack) error
-	
-	// UpdateNowPlaying updates the current playing status
-	UpdateNowPlaying(track ScrobbleTrack) error
-	
-	// Name returns the service name
+// Scrobbler is a client-side scrobbling service. The Manager submits to every
+// scrobbler enabled in the config, so a new service only needs to implement this.
+type Scrobbler interface {
+	// Name returns the service name used in logs and results
 	Name() string
-	
-	// IsEnabled returns whether the service is enabled
-	IsEnabled() bool
+
+	// UpdateNowPlaying announces the track that just started
+	UpdateNowPlaying(ctx context.Context, track ScrobbleTrack) error
+
+	// SubmitScrobble submits a completed track play
+	SubmitScrobble(ctx context.Context, track ScrobbleTrack) error
+
+	// TestConnection checks the service's credentials, returning an error that
+	// says what is wrong in terms the user can act on
+	TestConnection(ctx context.Context) error
 }
 
 // ScrobbleResult represents the result of a scrobble operation
@@ -64,3 +72,10 @@ type QueuedScrobble struct {
 	LastTry   int64
 	MaxRetries int
 }
+
+// ServiceStatus is the outcome of checking one scrobbling service's credentials
+type ServiceStatus struct {
+	Service string
+	OK      bool
+	Message string // Who we authenticated as, or what is wrong
+}